@@ -5,17 +5,63 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
 )
 
+// newTestAuthHandler returns an auth handler backed by the test user store
+func newTestAuthHandler() *handlers.AuthHandler {
+	return handlers.NewAuthHandler(newTestUserStore(), auth.NewTokenManager("your-secret-key", "zplus-saas"))
+}
+
+// newTestUserStore returns an in-memory user store seeded with demo accounts
+func newTestUserStore() *store.MemoryUserStore {
+	users := store.NewMemoryUserStore()
+	users.AddUser("system", &models.User{
+		ID:          "sys-admin-1",
+		TenantID:    "system",
+		Email:       "admin@zplus.com",
+		FirstName:   "System",
+		LastName:    "Admin",
+		Roles:       []string{"system_admin"},
+		Permissions: []string{"system:manage", "tenants:read", "tenants:write", "users:read", "users:write"},
+		IsAdmin:     true,
+		Status:      "active",
+	}, "admin123")
+	users.AddUser("demo-corp", &models.User{
+		ID:          "tenant-admin-1",
+		TenantID:    "demo-corp",
+		Email:       "admin@demo-corp.zplus.com",
+		FirstName:   "Demo",
+		LastName:    "Admin",
+		Roles:       []string{"tenant_admin"},
+		Permissions: []string{"users:read", "users:write", "customers:read", "customers:write"},
+		Status:      "active",
+	}, "demo123")
+	users.AddUser("demo-corp", &models.User{
+		ID:          "customer-1",
+		TenantID:    "demo-corp",
+		Email:       "john@demo-corp.zplus.com",
+		FirstName:   "John",
+		LastName:    "Doe",
+		Roles:       []string{"user"},
+		Permissions: []string{"customers:read", "products:read"},
+		Status:      "active",
+	}, "user123")
+
+	return users
+}
+
 func TestLoginLogoutFlow(t *testing.T) {
 	// Create a new Fiber app for testing
 	app := fiber.New()
-	authHandler := handlers.NewAuthHandler()
+	authHandler := newTestAuthHandler()
 
 	// Register routes
 	app.Post("/login", authHandler.Login)
@@ -86,7 +132,7 @@ func TestLoginLogoutFlow(t *testing.T) {
 
 func TestLoginWithInvalidCredentials(t *testing.T) {
 	app := fiber.New()
-	authHandler := handlers.NewAuthHandler()
+	authHandler := newTestAuthHandler()
 	app.Post("/login", authHandler.Login)
 
 	loginReq := models.LoginRequest{
@@ -113,7 +159,7 @@ func TestLoginWithInvalidCredentials(t *testing.T) {
 
 func TestLogoutWithoutToken(t *testing.T) {
 	app := fiber.New()
-	authHandler := handlers.NewAuthHandler()
+	authHandler := newTestAuthHandler()
 	app.Post("/logout", authHandler.Logout)
 
 	req, _ := http.NewRequest("POST", "/logout", nil)
@@ -131,7 +177,7 @@ func TestLogoutWithoutToken(t *testing.T) {
 
 func TestSessionManagement(t *testing.T) {
 	app := fiber.New()
-	authHandler := handlers.NewAuthHandler()
+	authHandler := newTestAuthHandler()
 
 	app.Post("/login", authHandler.Login)
 	app.Get("/sessions", authHandler.GetSessions)
@@ -199,4 +245,300 @@ func TestSessionManagement(t *testing.T) {
 	}
 
 	fmt.Println("✓ Session removed successfully after logout")
-}
\ No newline at end of file
+}
+func TestGetUsersScopedToTenant(t *testing.T) {
+	app := fiber.New()
+	authHandler := newTestAuthHandler()
+	app.Post("/login", authHandler.Login)
+	app.Get("/users", authHandler.RequireAuth, authHandler.GetUsers)
+
+	// Anonymous callers and regular users are rejected
+	if status, _ := sessionRequest(t, app, "GET", "/users?tenant_slug=demo-corp", ""); status != 401 {
+		t.Fatalf("Expected status 401 without a token, got %d", status)
+	}
+	john := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+	if status, _ := sessionRequest(t, app, "GET", "/users", john); status != 403 {
+		t.Fatalf("Expected status 403 for a regular user, got %d", status)
+	}
+
+	// Tenant admins list their own tenant only
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+	if status, _ := sessionRequest(t, app, "GET", "/users?tenant_slug=other-corp", admin); status != 403 {
+		t.Fatalf("Expected status 403 for another tenant, got %d", status)
+	}
+	status, body := sessionRequest(t, app, "GET", "/users", admin)
+	if status != 200 {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	users := body["users"].([]interface{})
+	if body["count"] != float64(2) {
+		t.Fatalf("Expected 2 demo-corp users, got %v", body["count"])
+	}
+	for _, user := range users {
+		if tenant := user.(map[string]interface{})["tenant_id"]; tenant != "demo-corp" {
+			t.Fatalf("Expected only demo-corp users, got tenant %v", tenant)
+		}
+	}
+
+	// System admins choose the tenant
+	systemAdmin := loginAs(t, app, "admin@zplus.com", "admin123", "system", "laptop")
+	if status, _ := sessionRequest(t, app, "GET", "/users", systemAdmin); status != 400 {
+		t.Fatalf("Expected status 400 without tenant_slug, got %d", status)
+	}
+	if status, body := sessionRequest(t, app, "GET", "/users?tenant_slug=demo-corp", systemAdmin); status != 200 || body["count"] != float64(2) {
+		t.Fatalf("Expected the demo-corp users, got %d %v", status, body["count"])
+	}
+
+	fmt.Println("✓ Users listed per tenant")
+}
+
+func TestLoginWithDisabledAccount(t *testing.T) {
+	users := newTestUserStore()
+	users.AddUser("demo-corp", &models.User{
+		ID:       "disabled-1",
+		TenantID: "demo-corp",
+		Email:    "disabled@demo-corp.zplus.com",
+		Roles:    []string{"user"},
+		Status:   "inactive",
+	}, "disabled123")
+
+	app := fiber.New()
+	authHandler := handlers.NewAuthHandler(users, auth.NewTokenManager("your-secret-key", "zplus-saas"))
+	app.Post("/login", authHandler.Login)
+
+	loginBody, _ := json.Marshal(models.LoginRequest{
+		Email:      "disabled@demo-corp.zplus.com",
+		Password:   "disabled123",
+		TenantSlug: "demo-corp",
+	})
+	req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(loginBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("Login request failed: %v", err)
+	}
+	if resp.StatusCode != 403 {
+		t.Fatalf("Expected status 403 for disabled account, got %d", resp.StatusCode)
+	}
+
+	fmt.Println("✓ Disabled account properly rejected")
+}
+
+// loginForTest logs in the demo tenant admin and returns the login response
+func loginForTest(t *testing.T, app *fiber.App) models.LoginResponse {
+	loginBody, _ := json.Marshal(models.LoginRequest{
+		Email:      "admin@demo-corp.zplus.com",
+		Password:   "demo123",
+		TenantSlug: "demo-corp",
+	})
+	req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(loginBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("Login request failed: %v", err)
+	}
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected login status 200, got %d", resp.StatusCode)
+	}
+
+	var loginResp models.LoginResponse
+	json.NewDecoder(resp.Body).Decode(&loginResp)
+	return loginResp
+}
+
+// refreshForTest exchanges a refresh token and returns the response status and body
+func refreshForTest(t *testing.T, app *fiber.App, refreshToken string) (int, models.LoginResponse, models.ErrorResponse) {
+	refreshBody, _ := json.Marshal(map[string]string{"refresh_token": refreshToken})
+	req, _ := http.NewRequest("POST", "/refresh", bytes.NewBuffer(refreshBody))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("Refresh request failed: %v", err)
+	}
+
+	var loginResp models.LoginResponse
+	var errorResp models.ErrorResponse
+	if resp.StatusCode == 200 {
+		json.NewDecoder(resp.Body).Decode(&loginResp)
+	} else {
+		json.NewDecoder(resp.Body).Decode(&errorResp)
+	}
+	return resp.StatusCode, loginResp, errorResp
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	app := fiber.New()
+	authHandler := newTestAuthHandler()
+	app.Post("/login", authHandler.Login)
+	app.Post("/logout", authHandler.Logout)
+	app.Post("/refresh", authHandler.RefreshToken)
+
+	loginResp := loginForTest(t, app)
+	if loginResp.RefreshToken == "" || loginResp.RefreshToken == loginResp.Token {
+		t.Fatal("Expected a distinct refresh token in login response")
+	}
+	if loginResp.ExpiresIn != int(auth.DefaultAccessTokenTTL.Seconds()) {
+		t.Fatalf("Expected access token lifetime %d, got %d", int(auth.DefaultAccessTokenTTL.Seconds()), loginResp.ExpiresIn)
+	}
+
+	// Refresh tokens cannot be used as access tokens
+	logoutReq, _ := http.NewRequest("POST", "/logout", nil)
+	logoutReq.Header.Set("Authorization", "Bearer "+loginResp.RefreshToken)
+	logoutResp, _ := app.Test(logoutReq, 5000)
+	if logoutResp.StatusCode != 401 {
+		t.Fatalf("Expected status 401 when using refresh token as access token, got %d", logoutResp.StatusCode)
+	}
+
+	status, refreshed, _ := refreshForTest(t, app, loginResp.RefreshToken)
+	if status != 200 {
+		t.Fatalf("Expected refresh status 200, got %d", status)
+	}
+	if refreshed.RefreshToken == loginResp.RefreshToken {
+		t.Fatal("Expected refresh token to be rotated")
+	}
+
+	status, rotated, _ := refreshForTest(t, app, refreshed.RefreshToken)
+	if status != 200 {
+		t.Fatalf("Expected rotated refresh token to be accepted, got %d", status)
+	}
+
+	fmt.Println("✓ Refresh tokens rotated on every use")
+
+	// Access tokens are rejected once the login is logged out
+	logoutReq, _ = http.NewRequest("POST", "/logout", nil)
+	logoutReq.Header.Set("Authorization", "Bearer "+rotated.Token)
+	logoutResp, _ = app.Test(logoutReq, 5000)
+	if logoutResp.StatusCode != 200 {
+		t.Fatalf("Expected logout status 200, got %d", logoutResp.StatusCode)
+	}
+
+	status, _, _ = refreshForTest(t, app, rotated.RefreshToken)
+	if status != 401 {
+		t.Fatalf("Expected refresh after logout to fail with 401, got %d", status)
+	}
+
+	fmt.Println("✓ Refresh token family revoked on logout")
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	app := fiber.New()
+	authHandler := newTestAuthHandler()
+	app.Post("/login", authHandler.Login)
+	app.Post("/logout", authHandler.Logout)
+	app.Post("/refresh", authHandler.RefreshToken)
+
+	loginResp := loginForTest(t, app)
+
+	status, refreshed, _ := refreshForTest(t, app, loginResp.RefreshToken)
+	if status != 200 {
+		t.Fatalf("Expected refresh status 200, got %d", status)
+	}
+
+	// Replaying the original refresh token is detected as reuse
+	status, _, errorResp := refreshForTest(t, app, loginResp.RefreshToken)
+	if status != 401 || errorResp.Code != "TOKEN_REUSED" {
+		t.Fatalf("Expected 401 TOKEN_REUSED, got %d %s", status, errorResp.Code)
+	}
+
+	// The legitimate refresh token of the family is revoked as well
+	status, _, _ = refreshForTest(t, app, refreshed.RefreshToken)
+	if status != 401 {
+		t.Fatalf("Expected revoked family refresh to fail with 401, got %d", status)
+	}
+
+	// And so are its access tokens
+	logoutReq, _ := http.NewRequest("POST", "/logout", nil)
+	logoutReq.Header.Set("Authorization", "Bearer "+refreshed.Token)
+	logoutResp, _ := app.Test(logoutReq, 5000)
+	if logoutResp.StatusCode != 401 {
+		t.Fatalf("Expected access token of revoked family to be rejected, got %d", logoutResp.StatusCode)
+	}
+
+	fmt.Println("✓ Refresh token reuse revokes the token family")
+}
+
+func TestRefreshTokensCarryCurrentRole(t *testing.T) {
+	users := newTestUserStore()
+	tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
+	authHandler := handlers.NewAuthHandler(users, tokenManager)
+	app := fiber.New()
+	app.Post("/login", authHandler.Login)
+	app.Post("/refresh", authHandler.RefreshToken)
+
+	loginResp := loginForTest(t, app)
+	if claims, _ := tokenManager.ValidateToken(loginResp.Token); claims == nil || claims.Role != "tenant_admin" {
+		t.Fatalf("Expected a tenant_admin login, got %+v", claims)
+	}
+
+	// tenant_admin is taken away after the login
+	users.SetUserRoles("demo-corp", "tenant-admin-1", []string{"sales"})
+	status, refreshed, _ := refreshForTest(t, app, loginResp.RefreshToken)
+	if status != 200 {
+		t.Fatalf("Expected refresh status 200, got %d", status)
+	}
+	claims, err := tokenManager.ValidateToken(refreshed.Token)
+	if err != nil || claims.Role != "sales" {
+		t.Fatalf("Expected the refreshed token to carry the current role, got %+v %v", claims, err)
+	}
+
+	// A disabled account cannot refresh and its login is revoked
+	users.SetUserStatus("demo-corp", "tenant-admin-1", "suspended")
+	if status, _, errorResp := refreshForTest(t, app, refreshed.RefreshToken); status != 403 || errorResp.Code != "ACCOUNT_DISABLED" {
+		t.Fatalf("Expected 403 ACCOUNT_DISABLED, got %d %s", status, errorResp.Code)
+	}
+	users.SetUserStatus("demo-corp", "tenant-admin-1", "active")
+	if status, _, _ := refreshForTest(t, app, refreshed.RefreshToken); status != 401 {
+		t.Fatalf("Expected the login of the disabled account to stay revoked, got %d", status)
+	}
+
+	t.Log("✓ Refreshed tokens carry the roles the account has now")
+}
+
+func TestConcurrentRefreshExchangesOnce(t *testing.T) {
+	for name, store := range map[string]auth.Store{
+		"memory": auth.NewMemoryStore(),
+		"redis":  newTestRedisStore(t, testRedisAddr(t)),
+	} {
+		tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
+		tokenManager.SetStore(store)
+		tokens, err := tokenManager.GenerateTokenPair("user-1", "demo-corp", "user")
+		if err != nil {
+			t.Fatalf("%s: failed to generate tokens: %v", name, err)
+		}
+
+		// Every exchange of the same refresh token races the others
+		const exchanges = 8
+		var wg sync.WaitGroup
+		results := make(chan error, exchanges)
+		for i := 0; i < exchanges; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := tokenManager.RefreshTokens(tokens.RefreshToken, func(claims *auth.Claims) (string, error) {
+					return claims.Role, nil
+				})
+				results <- err
+			}()
+		}
+		wg.Wait()
+		close(results)
+
+		succeeded := 0
+		for err := range results {
+			if err == nil {
+				succeeded++
+			}
+		}
+		if succeeded > 1 {
+			t.Fatalf("%s: expected at most one exchange to succeed, got %d", name, succeeded)
+		}
+		if revoked, _ := auth.NewTokenFamilies(store).IsRevoked(tokens.FamilyID); succeeded == 1 && exchanges > 1 && !revoked {
+			t.Fatalf("%s: expected the other exchanges to revoke the family as reuse", name)
+		}
+	}
+
+	t.Log("✓ Concurrent exchanges of one refresh token cannot both succeed")
+}
@@ -3,17 +3,34 @@ module github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth
 go 1.21
 
 require (
+	github.com/beevik/etree v1.1.0
 	github.com/gofiber/fiber/v2 v2.52.0
 	github.com/ilmsadmin/Zplus-SaaS/pkg v0.0.0
+	github.com/russellhaering/goxmldsig v1.4.0
 	golang.org/x/crypto v0.31.0
+	gorm.io/gorm v1.30.0
+)
+
+require (
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/jackc/pgx/v5 v5.6.0 // indirect
+	github.com/jackc/puddle/v2 v2.2.2 // indirect
+	github.com/jinzhu/inflection v1.0.0 // indirect
+	github.com/jinzhu/now v1.1.5 // indirect
+	github.com/jonboulle/clockwork v0.2.2 // indirect
+	golang.org/x/sync v0.10.0 // indirect
+	golang.org/x/text v0.21.0 // indirect
+	gorm.io/driver/postgres v1.6.0 // indirect
 )
 
 replace github.com/ilmsadmin/Zplus-SaaS/pkg => ../../../pkg
 
 require (
 	github.com/andybalholm/brotli v1.0.5 // indirect
-	github.com/golang-jwt/jwt/v4 v4.5.2 // indirect
-	github.com/google/uuid v1.6.0 // indirect
+	github.com/golang-jwt/jwt/v4 v4.5.2
+	github.com/google/uuid v1.6.0
+	github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared v0.0.0
 	github.com/klauspost/compress v1.17.0 // indirect
 	github.com/mattn/go-colorable v0.1.13 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
@@ -24,3 +41,5 @@ require (
 	github.com/valyala/tcplisten v1.0.0 // indirect
 	golang.org/x/sys v0.28.0 // indirect
 )
+
+replace github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared => ../shared
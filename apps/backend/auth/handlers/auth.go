@@ -1,104 +1,53 @@
 package handlers
 
 import (
+	"errors"
 	"fmt"
+	"log"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
 	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
 )
 
+// Errors of newLogin
+var (
+	errTokenGeneration = errors.New("token generation failed")
+	errSessionCreation = errors.New("session creation failed")
+)
+
+// Errors of the role lookup during a refresh
+var (
+	errRefreshUserNotFound    = errors.New("user not found")
+	errRefreshAccountDisabled = errors.New("account disabled")
+)
+
 // AuthHandler handles authentication endpoints
 type AuthHandler struct {
 	tokenManager *auth.TokenManager
-	users        map[string]*models.User // Mock user store
+	users        store.UserStore
+	loginLimiter *auth.LoginLimiter
+	audit        AuditRecorder
 }
 
-// NewAuthHandler creates a new authentication handler
-func NewAuthHandler() *AuthHandler {
-	// Initialize with mock users for different levels
-	tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
-	
-	// Create mock users with hashed passwords
-	users := make(map[string]*models.User)
-	
-	// System Admin
-	systemAdmin := &models.User{
-		ID:        "sys-admin-1",
-		TenantID:  "system",
-		Email:     "admin@zplus.com",
-		FirstName: "System",
-		LastName:  "Admin",
-		Roles:     []string{"system_admin"},
-		IsAdmin:   true,
-		Status:    "active",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		Permissions: []string{
-			"system:manage",
-			"tenants:read",
-			"tenants:write",
-			"users:read",
-			"users:write",
-		},
-	}
-	systemAdmin.HashPassword("admin123")
-	users["admin@zplus.com|system"] = systemAdmin
-	
-	// Tenant Admin (Demo Corp)
-	tenantAdmin := &models.User{
-		ID:        "tenant-admin-1",
-		TenantID:  "demo-corp",
-		Email:     "admin@demo-corp.zplus.com",
-		FirstName: "Demo",
-		LastName:  "Admin",
-		Roles:     []string{"tenant_admin"},
-		IsAdmin:   false,
-		Status:    "active",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		Permissions: []string{
-			"users:read",
-			"users:write",
-			"customers:read",
-			"customers:write",
-			"employees:read",
-			"employees:write",
-			"products:read",
-			"products:write",
-		},
-	}
-	tenantAdmin.HashPassword("demo123")
-	users["admin@demo-corp.zplus.com|demo-corp"] = tenantAdmin
-	
-	// Customer User (CRM)
-	customerUser := &models.User{
-		ID:        "customer-1",
-		TenantID:  "demo-corp",
-		Email:     "john@demo-corp.zplus.com",
-		FirstName: "John",
-		LastName:  "Doe",
-		Roles:     []string{"user"},
-		IsAdmin:   false,
-		Status:    "active",
-		CreatedAt: time.Now(),
-		UpdatedAt: time.Now(),
-		Permissions: []string{
-			"customers:read",
-			"products:read",
-		},
-	}
-	customerUser.HashPassword("user123")
-	users["john@demo-corp.zplus.com|demo-corp"] = customerUser
-	
+// NewAuthHandler creates a new authentication handler backed by the given user store.
+// Failed logins are tracked in memory until SetLoginLimiter configures a shared store.
+func NewAuthHandler(users store.UserStore, tokenManager *auth.TokenManager) *AuthHandler {
 	return &AuthHandler{
 		tokenManager: tokenManager,
 		users:        users,
+		loginLimiter: auth.NewLoginLimiter(auth.NewMemoryStore(), auth.DefaultLockoutPolicy),
 	}
 }
 
+// SetLoginLimiter sets the limiter tracking failed logins
+func (h *AuthHandler) SetLoginLimiter(loginLimiter *auth.LoginLimiter) {
+	h.loginLimiter = loginLimiter
+}
+
 // Login handles user login
 func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	var req models.LoginRequest
@@ -123,27 +72,48 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
 	req.TenantSlug = strings.ToLower(strings.TrimSpace(req.TenantSlug))
 
-	// Find user by email and tenant
-	userKey := fmt.Sprintf("%s|%s", req.Email, req.TenantSlug)
-	user, exists := h.users[userKey]
-	if !exists {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
-			Error:   "Invalid credentials",
-			Code:    "INVALID_CREDENTIALS",
-			Message: "Email or password is incorrect",
-		})
+	// Refuse attempts on locked accounts and from blocked IPs before checking the password
+	account := auth.LoginAccount(req.TenantSlug, req.Email)
+	if retryAfter, err := h.loginLimiter.Check(account, c.IP()); err != nil {
+		return lockoutError(c, retryAfter, err)
 	}
 
-	// Check password
-	if !user.CheckPassword(req.Password) {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
-			Error:   "Invalid credentials",
-			Code:    "INVALID_CREDENTIALS",
-			Message: "Email or password is incorrect",
-		})
+	// Authenticate user against the tenant (or system) user store
+	user, err := h.users.Authenticate(req.TenantSlug, req.Email, req.Password)
+	if err != nil {
+		switch err {
+		case store.ErrInvalidCredentials, store.ErrTenantNotFound:
+			if lockedFor, err := h.recordLoginFailure(account, c.IP()); err != nil {
+				return lockoutError(c, lockedFor, err)
+			}
+			return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+				Error:   "Invalid credentials",
+				Code:    "INVALID_CREDENTIALS",
+				Message: "Email or password is incorrect",
+			})
+		case store.ErrTenantInactive:
+			return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+				Error:   "Tenant inactive",
+				Code:    "TENANT_INACTIVE",
+				Message: "This organization is not active. Please contact support",
+			})
+		default:
+			return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+				Error:   "Authentication failed",
+				Code:    "SERVER_ERROR",
+				Message: "Unable to verify credentials",
+			})
+		}
 	}
 
 	// Check if user is active
+	if user.Status == "pending" {
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Email not verified",
+			Code:    "EMAIL_NOT_VERIFIED",
+			Message: "Please verify your email address before logging in",
+		})
+	}
 	if user.Status != "active" {
 		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
 			Error:   "Account disabled",
@@ -152,48 +122,107 @@ func (h *AuthHandler) Login(c *fiber.Ctx) error {
 		})
 	}
 
-	// Determine role for JWT
-	role := "user"
-	if user.IsAdmin && user.TenantID == "system" {
-		role = "system_admin"
-	} else if len(user.Roles) > 0 {
-		role = user.Roles[0]
+	// Tenants enforcing single sign-on only accept passwords of their admins, so they
+	// can still log in when the identity provider is misconfigured
+	enforced, err := h.ssoEnforced(req.TenantSlug)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Authentication failed",
+			Code:    "SERVER_ERROR",
+			Message: "Unable to verify credentials",
+		})
+	}
+	if enforced && !hasRole(user, "tenant_admin") {
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Single sign-on required",
+			Code:    "SSO_REQUIRED",
+			Message: "Your organization requires logging in through its identity provider",
+		})
 	}
 
-	// Generate JWT token
-	token, err := h.tokenManager.GenerateToken(user.ID, user.TenantID, role)
+	// A second factor is needed when MFA is enabled or required for the user's role
+	challenge, err := h.mfaChallenge(user, account)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error:   "Token generation failed",
-			Code:    "TOKEN_ERROR",
-			Message: "Unable to generate authentication token",
+			Error:   "MFA challenge failed",
+			Code:    "MFA_ERROR",
+			Message: "Unable to start multi-factor authentication",
 		})
 	}
+	if challenge != nil {
+		return c.JSON(challenge)
+	}
+
+	response, err := h.newLogin(c, user, account)
+	if err != nil {
+		return loginError(c, err)
+	}
+	return c.JSON(response)
+}
+
+// newLogin issues tokens and a session for an authenticated user and clears the
+// failed attempts of the login account
+func (h *AuthHandler) newLogin(c *fiber.Ctx, user *models.User, account string) (*models.LoginResponse, error) {
+	// Generate access and refresh tokens
+	tokens, err := h.tokenManager.GenerateTokenPair(user.ID, user.TenantID, tokenRole(user))
+	if err != nil {
+		return nil, errTokenGeneration
+	}
 
-	// Extract token ID from the generated token for session creation
-	claims, _ := h.tokenManager.ValidateToken(token)
-	
 	// Create session
 	ipAddress := c.IP()
 	userAgent := c.Get("User-Agent")
-	h.tokenManager.CreateSession(claims.TokenID, user.ID, user.TenantID, user.Email, ipAddress, userAgent)
+	if _, err := h.tokenManager.CreateSession(tokens.AccessClaims.TokenID, tokens.FamilyID, user.ID, user.TenantID, user.Email, ipAddress, userAgent); err != nil {
+		return nil, errSessionCreation
+	}
 
-	// Generate refresh token (same for now, in production use different logic)
-	refreshToken, err := h.tokenManager.GenerateToken(user.ID, user.TenantID, role)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error:   "Token generation failed",
-			Code:    "TOKEN_ERROR",
-			Message: "Unable to generate refresh token",
-		})
+	// Record last login time
+	if err := h.users.RecordLogin(user); err != nil {
+		log.Printf("failed to record login for user %s: %v", user.ID, err)
+	}
+	if err := h.loginLimiter.RecordSuccess(account); err != nil {
+		log.Printf("failed to reset login failures of %s: %v", account, err)
 	}
 
-	// Return successful login response
-	return c.JSON(models.LoginResponse{
-		Token:        token,
-		RefreshToken: refreshToken,
+	return &models.LoginResponse{
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		User:         user,
-		ExpiresIn:    86400, // 24 hours in seconds
+		ExpiresIn:    tokens.ExpiresIn,
+	}, nil
+}
+
+// tokenRole returns the role claim of a user's tokens: tenant_admin when the user holds
+// it, else the first of their roles by name. The claim is informational, access is
+// checked against the stored account.
+func tokenRole(user *models.User) string {
+	if user.IsAdmin && user.TenantID == store.SystemTenantSlug {
+		return store.SystemAdminRole
+	}
+	if hasRole(user, "tenant_admin") {
+		return "tenant_admin"
+	}
+	if len(user.Roles) > 0 {
+		roles := append([]string(nil), user.Roles...)
+		sort.Strings(roles)
+		return roles[0]
+	}
+	return "user"
+}
+
+// loginError converts newLogin errors into responses
+func loginError(c *fiber.Ctx, err error) error {
+	if err == errSessionCreation {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Session creation failed",
+			Code:    "SESSION_ERROR",
+			Message: "Unable to create session",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Error:   "Token generation failed",
+		Code:    "TOKEN_ERROR",
+		Message: "Unable to generate authentication token",
 	})
 }
 
@@ -258,57 +287,98 @@ func (h *AuthHandler) RefreshToken(c *fiber.Ctx) error {
 		})
 	}
 
-	// Validate refresh token
-	claims, err := h.tokenManager.ValidateToken(req.RefreshToken)
-	if err != nil {
-		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
-			Error:   "Invalid refresh token",
-			Code:    "INVALID_TOKEN",
-			Message: "Refresh token is invalid or expired",
-		})
-	}
-
-	// Generate new access token
-	newToken, err := h.tokenManager.GenerateToken(claims.UserID, claims.TenantID, claims.Role)
-	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
-			Error:   "Token generation failed",
-			Code:    "TOKEN_ERROR",
-			Message: "Unable to generate new access token",
-		})
-	}
-
-	// Find user for response
+	// Rotate refresh token. The new tokens carry the role of the account as it is now,
+	// so roles removed since the login are not renewed.
 	var user *models.User
-	for _, u := range h.users {
-		if u.ID == claims.UserID && u.TenantID == claims.TenantID {
-			user = u
-			break
+	tokens, err := h.tokenManager.RefreshTokens(req.RefreshToken, func(claims *auth.Claims) (string, error) {
+		found, err := h.users.GetUser(claims.TenantID, claims.UserID)
+		if err != nil {
+			return "", errRefreshUserNotFound
 		}
-	}
-
-	if user == nil {
+		if found.Status != "active" {
+			if err := h.tokenManager.RevokeTokenFamily(claims.FamilyID); err != nil {
+				log.Printf("failed to revoke token family %s: %v", claims.FamilyID, err)
+			}
+			return "", errRefreshAccountDisabled
+		}
+		user = found
+		return tokenRole(found), nil
+	})
+	switch err {
+	case nil:
+	case auth.ErrRefreshTokenReused:
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Error:   "Refresh token reused",
+			Code:    "TOKEN_REUSED",
+			Message: "Refresh token was already used. All sessions from this login have been revoked",
+		})
+	case errRefreshUserNotFound:
 		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
 			Error:   "User not found",
 			Code:    "USER_NOT_FOUND",
 			Message: "User associated with token not found",
 		})
+	case errRefreshAccountDisabled:
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Account disabled",
+			Code:    "ACCOUNT_DISABLED",
+			Message: "Your account has been disabled. Please contact support",
+		})
+	default:
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Error:   "Invalid refresh token",
+			Code:    "INVALID_TOKEN",
+			Message: "Refresh token is invalid or expired",
+		})
 	}
 
 	return c.JSON(models.LoginResponse{
-		Token:        newToken,
-		RefreshToken: req.RefreshToken, // Keep the same refresh token
+		Token:        tokens.AccessToken,
+		RefreshToken: tokens.RefreshToken,
 		User:         user,
-		ExpiresIn:    86400, // 24 hours in seconds
+		ExpiresIn:    tokens.ExpiresIn,
 	})
 }
 
-// GetUsers returns all mock users (for testing)
+// GetUsers returns the users of the caller's tenant to its tenant admins. System admins
+// choose the tenant with the tenant_slug query parameter. It must run after RequireAuth.
 func (h *AuthHandler) GetUsers(c *fiber.Ctx) error {
-	users := make([]*models.User, 0, len(h.users))
-	for _, user := range h.users {
-		users = append(users, user)
+	tenantSlug := strings.ToLower(strings.TrimSpace(c.Query("tenant_slug")))
+	switch {
+	case isSystemAdmin(c, h.users):
+		if tenantSlug == "" {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Error:   "Missing tenant",
+				Code:    "VALIDATION_ERROR",
+				Message: "tenant_slug query parameter is required",
+			})
+		}
+	case isTenantAdmin(c, h.users):
+		claims := getClaims(c)
+		if tenantSlug != "" && tenantSlug != claims.TenantID {
+			return forbidden(c, "Tenant administrators can only list their own tenant")
+		}
+		tenantSlug = claims.TenantID
+	default:
+		return forbidden(c, "Administrator access required")
 	}
+
+	users, err := h.users.ListUsers(tenantSlug)
+	if err != nil {
+		if err == store.ErrTenantNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Error:   "Tenant not found",
+				Code:    "TENANT_NOT_FOUND",
+				Message: fmt.Sprintf("Tenant '%s' not found", tenantSlug),
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Failed to list users",
+			Code:    "SERVER_ERROR",
+			Message: err.Error(),
+		})
+	}
+
 	return c.JSON(fiber.Map{
 		"users": users,
 		"count": len(users),
@@ -317,7 +387,14 @@ func (h *AuthHandler) GetUsers(c *fiber.Ctx) error {
 
 // GetSessions returns all active sessions (for admin debugging)
 func (h *AuthHandler) GetSessions(c *fiber.Ctx) error {
-	sessions := h.tokenManager.GetAllSessions()
+	sessions, err := h.tokenManager.GetAllSessions()
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Failed to list sessions",
+			Code:    "SERVER_ERROR",
+			Message: err.Error(),
+		})
+	}
 	return c.JSON(fiber.Map{
 		"sessions": sessions,
 		"count":    len(sessions),
@@ -0,0 +1,186 @@
+package handlers
+
+import (
+	"log"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	sharedmodels "github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// AuditRecorder appends entries to the audit log
+type AuditRecorder interface {
+	Record(entry *sharedmodels.AuditLog) error
+}
+
+// SetAuditRecorder sets the audit log impersonation is recorded in. Impersonation is
+// refused until an audit log is configured.
+func (h *AuthHandler) SetAuditRecorder(audit AuditRecorder) {
+	h.audit = audit
+}
+
+// Impersonate issues a short-lived token letting a system user act as a tenant user.
+// It must run after RequireAuth and RequireSystemAdmin.
+func (h *AuthHandler) Impersonate(c *fiber.Ctx) error {
+	claims := getClaims(c)
+	if claims.IsImpersonation() {
+		return impersonationForbidden(c)
+	}
+	if h.audit == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(models.ErrorResponse{
+			Error:   "Impersonation unavailable",
+			Code:    "AUDIT_UNAVAILABLE",
+			Message: "Impersonation requires an audit log",
+		})
+	}
+
+	var req models.ImpersonateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Please provide valid JSON data",
+		})
+	}
+	req.Reason = strings.TrimSpace(req.Reason)
+	if req.TenantID == "" || req.UserID == "" || req.Reason == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Missing required fields",
+			Code:    "VALIDATION_ERROR",
+			Message: "tenant_id, user_id and reason are required",
+		})
+	}
+	if req.TenantID == store.SystemTenantSlug {
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Forbidden",
+			Code:    "FORBIDDEN",
+			Message: "System users cannot be impersonated",
+		})
+	}
+
+	user, err := h.users.GetUser(req.TenantID, req.UserID)
+	if err != nil {
+		if err == store.ErrUserNotFound || err == store.ErrTenantNotFound {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Error:   "User not found",
+				Code:    "USER_NOT_FOUND",
+				Message: "User does not exist in this organization",
+			})
+		}
+		return sessionError(c, err)
+	}
+	if user.Status != "active" {
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Account disabled",
+			Code:    "ACCOUNT_DISABLED",
+			Message: "Only active users can be impersonated",
+		})
+	}
+
+	ttl := auth.DefaultImpersonationTTL
+	if accessTTL := h.tokenManager.AccessTokenTTL(); accessTTL < ttl {
+		ttl = accessTTL
+	}
+	token, tokenClaims, err := h.tokenManager.GenerateImpersonationToken(claims.UserID, user.ID, user.TenantID, tokenRole(user), ttl)
+	if err != nil {
+		return loginError(c, errTokenGeneration)
+	}
+
+	// The token is only handed out once the impersonation is on record
+	entry := impersonationAudit(c, tokenClaims, sharedmodels.AuditImpersonationStarted)
+	entry.Details = map[string]string{"reason": req.Reason, "email": user.Email}
+	if err := h.audit.Record(entry); err != nil {
+		log.Printf("failed to record impersonation of user %s by %s: %v", user.ID, claims.UserID, err)
+		return c.Status(fiber.StatusServiceUnavailable).JSON(models.ErrorResponse{
+			Error:   "Impersonation unavailable",
+			Code:    "AUDIT_UNAVAILABLE",
+			Message: "Unable to record the impersonation in the audit log",
+		})
+	}
+
+	// The session lets the tenant see and end the impersonation like any other login
+	if _, err := h.tokenManager.CreateSession(tokenClaims.TokenID, "", user.ID, user.TenantID, user.Email, c.IP(), c.Get("User-Agent")); err != nil {
+		return loginError(c, errSessionCreation)
+	}
+
+	return c.JSON(models.ImpersonationResponse{
+		Token:          token,
+		User:           user,
+		ImpersonatorID: claims.UserID,
+		ExpiresIn:      int(ttl.Seconds()),
+	})
+}
+
+// RejectImpersonation keeps impersonation tokens away from sensitive actions such as
+// changing credentials. It must run after RequireAuth, which audits the attempt.
+func (h *AuthHandler) RejectImpersonation(c *fiber.Ctx) error {
+	claims := getClaims(c)
+	if !claims.IsImpersonation() {
+		return c.Next()
+	}
+
+	c.Locals("impersonation_denied", true)
+	return impersonationForbidden(c)
+}
+
+// auditImpersonatedRequest records a request made with an impersonation token once it
+// has been handled
+func (h *AuthHandler) auditImpersonatedRequest(c *fiber.Ctx, claims *auth.Claims) error {
+	err := c.Next()
+
+	action := sharedmodels.AuditImpersonatedRequest
+	if denied, _ := c.Locals("impersonation_denied").(bool); denied {
+		action = sharedmodels.AuditImpersonationDenied
+	}
+	h.recordAudit(impersonationAudit(c, claims, action))
+	return err
+}
+
+// recordAudit appends an entry to the audit log, logging failures
+func (h *AuthHandler) recordAudit(entry *sharedmodels.AuditLog) {
+	if h.audit == nil {
+		log.Printf("audit log not configured, dropping %s entry for token %s", entry.Action, entry.TokenID)
+		return
+	}
+	if err := h.audit.Record(entry); err != nil {
+		log.Printf("failed to record %s entry for token %s: %v", entry.Action, entry.TokenID, err)
+	}
+}
+
+// impersonationAudit describes the current request of an impersonation token. Request
+// values are copied since fiber reuses their memory after the handler returns.
+func impersonationAudit(c *fiber.Ctx, claims *auth.Claims, action string) *sharedmodels.AuditLog {
+	return &sharedmodels.AuditLog{
+		Action:         action,
+		ImpersonatorID: parseUUID(claims.ImpersonatorID),
+		TenantID:       parseUUID(claims.TenantID),
+		UserID:         parseUUID(claims.UserID),
+		TokenID:        claims.TokenID,
+		Method:         strings.Clone(c.Method()),
+		Path:           strings.Clone(c.Path()),
+		Status:         c.Response().StatusCode(),
+		IPAddress:      strings.Clone(c.IP()),
+	}
+}
+
+// impersonationForbidden responds to a sensitive action attempted while impersonating
+func impersonationForbidden(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+		Error:   "Forbidden",
+		Code:    "IMPERSONATION_FORBIDDEN",
+		Message: "This action is not available while impersonating a user",
+	})
+}
+
+// parseUUID parses an optional UUID, returning nil when it is empty or malformed
+func parseUUID(value string) *uuid.UUID {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil
+	}
+	return &id
+}
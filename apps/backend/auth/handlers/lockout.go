@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"log"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// UnlockAccount lifts the login lock of an account. Tenant admins may only unlock
+// users of their own tenant, system admins any account.
+func (h *AuthHandler) UnlockAccount(c *fiber.Ctx) error {
+	claims := getClaims(c)
+
+	systemAdmin := isSystemAdmin(c, h.users)
+	if !systemAdmin && !isTenantAdmin(c, h.users) {
+		return forbidden(c, "Tenant administrator access required")
+	}
+
+	var req models.UnlockAccountRequest
+	if err := c.BodyParser(&req); err != nil || req.Email == "" || req.TenantSlug == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Missing required fields",
+			Code:    "VALIDATION_ERROR",
+			Message: "Email and tenant_slug are required",
+		})
+	}
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	tenantSlug := strings.ToLower(strings.TrimSpace(req.TenantSlug))
+
+	if !systemAdmin {
+		user, err := h.users.FindUser(tenantSlug, email)
+		if err != nil && err != store.ErrUserNotFound && err != store.ErrTenantNotFound {
+			return sessionError(c, err)
+		}
+		if err != nil || user.TenantID != claims.TenantID {
+			return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+				Error:   "User not found",
+				Code:    "USER_NOT_FOUND",
+				Message: "User does not exist in your organization",
+			})
+		}
+	}
+
+	if err := h.loginLimiter.Unlock(auth.LoginAccount(tenantSlug, email)); err != nil {
+		return sessionError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Account unlocked",
+	})
+}
+
+// recordLoginFailure counts a failed login. It only returns an error when the
+// failure locked the account, with how long the lock lasts.
+func (h *AuthHandler) recordLoginFailure(account, ip string) (time.Duration, error) {
+	lockedFor, err := h.loginLimiter.RecordFailure(account, ip)
+	if err == auth.ErrAccountLocked {
+		return lockedFor, err
+	}
+	if err != nil {
+		log.Printf("failed to record login failure of %s: %v", account, err)
+	}
+	return 0, nil
+}
+
+// lockoutError converts login limiter errors into responses with a Retry-After header
+func lockoutError(c *fiber.Ctx, retryAfter time.Duration, err error) error {
+	if retryAfter > 0 {
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	}
+
+	switch err {
+	case auth.ErrAccountLocked:
+		return c.Status(fiber.StatusLocked).JSON(models.ErrorResponse{
+			Error:   "Account locked",
+			Code:    "ACCOUNT_LOCKED",
+			Message: "Too many failed login attempts. Try again later or contact your administrator",
+		})
+	case auth.ErrTooManyAttempts, auth.ErrLoginRateLimited:
+		return c.Status(fiber.StatusTooManyRequests).JSON(models.ErrorResponse{
+			Error:   "Too many attempts",
+			Code:    "TOO_MANY_ATTEMPTS",
+			Message: "Please wait before trying to log in again",
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Authentication failed",
+			Code:    "SERVER_ERROR",
+			Message: "Unable to verify credentials",
+		})
+	}
+}
@@ -0,0 +1,459 @@
+package handlers
+
+import (
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// One-time token purposes of the two-step login
+const (
+	purposeMFAChallenge  = "mfa_challenge"  // Password verified, TOTP or recovery code pending
+	purposeMFAEnrollment = "mfa_enrollment" // Password verified, MFA required but not set up yet
+)
+
+const (
+	mfaChallengeTTL  = 5 * time.Minute
+	mfaEnrollmentTTL = 15 * time.Minute
+
+	// mfaIssuer is the account issuer shown in authenticator apps
+	mfaIssuer = "Zplus"
+
+	// recoveryCodeCount is how many recovery codes are generated at a time
+	recoveryCodeCount = 10
+
+	// mfaRequiredRolesSetting is the tenant setting listing the roles that must use MFA
+	mfaRequiredRolesSetting = "mfa_required_roles"
+)
+
+// mfaLogin is the payload of MFA challenge and enrolment tokens
+type mfaLogin struct {
+	TenantID string `json:"tenant_id"`
+	UserID   string `json:"user_id"`
+	Account  string `json:"account"` // Login limiter account of the password step
+}
+
+// VerifyMFALogin completes a login with a TOTP code or an unused recovery code.
+// Each challenge allows a single attempt, so guessing codes requires the password every time.
+func (h *AuthHandler) VerifyMFALogin(c *fiber.Ctx) error {
+	var req models.MFALoginRequest
+	if err := c.BodyParser(&req); err != nil || req.MFAToken == "" || (req.Code == "" && req.RecoveryCode == "") {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "mfa_token and a code or recovery_code are required",
+		})
+	}
+
+	var challenge mfaLogin
+	if err := h.tokenManager.ConsumeOneTimeToken(purposeMFAChallenge, req.MFAToken, &challenge); err != nil {
+		return mfaTokenError(c, err)
+	}
+
+	// The account may have been locked since the password step
+	if retryAfter, err := h.loginLimiter.Check(challenge.Account, c.IP()); err != nil {
+		return lockoutError(c, retryAfter, err)
+	}
+
+	user, err := h.users.GetUser(challenge.TenantID, challenge.UserID)
+	if err != nil || !user.MFAEnabled {
+		return mfaTokenError(c, auth.ErrInvalidOneTimeToken)
+	}
+	if user.Status != "active" {
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Account disabled",
+			Code:    "ACCOUNT_DISABLED",
+			Message: "Your account has been disabled. Please contact support",
+		})
+	}
+
+	var valid bool
+	if req.RecoveryCode != "" {
+		valid, err = h.users.UseRecoveryCode(user, auth.HashRecoveryCode(req.RecoveryCode))
+	} else {
+		valid, err = h.tokenManager.VerifyTOTP(user.ID, user.MFASecret, req.Code)
+	}
+	if err != nil {
+		return mfaError(c, err)
+	}
+	if !valid {
+		return h.rejectMFACode(c, challenge.Account)
+	}
+
+	response, err := h.newLogin(c, user, challenge.Account)
+	if err != nil {
+		return loginError(c, err)
+	}
+	return c.JSON(response)
+}
+
+// EnrollMFA creates a new TOTP secret for the user. MFA stays disabled until a code
+// from the authenticator app is confirmed with VerifyMFAEnrollment.
+func (h *AuthHandler) EnrollMFA(c *fiber.Ctx) error {
+	var req models.MFAEnrollRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Error:   "Invalid request body",
+				Code:    "INVALID_REQUEST",
+				Message: "Please provide valid JSON data",
+			})
+		}
+	}
+
+	user, _, ok := h.mfaUser(c, req.MFAToken)
+	if !ok {
+		return nil
+	}
+
+	if user.MFAEnabled {
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "MFA already enabled",
+			Code:    "MFA_ALREADY_ENABLED",
+			Message: "Disable multi-factor authentication before enrolling a new device",
+		})
+	}
+
+	secret, err := auth.GenerateTOTPSecret()
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	user.MFASecret = secret
+	user.MFARecoveryCodes = nil
+	if err := h.users.UpdateMFA(user); err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(models.MFAEnrollResponse{
+		Secret:          secret,
+		ProvisioningURI: auth.TOTPProvisioningURI(secret, mfaIssuer, user.Email),
+	})
+}
+
+// VerifyMFAEnrollment enables MFA once the user proves the authenticator app works and
+// returns the recovery codes. During a login that required enrolment, it also logs the user in.
+func (h *AuthHandler) VerifyMFAEnrollment(c *fiber.Ctx) error {
+	var req models.MFAEnrollRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Code is required",
+		})
+	}
+
+	user, account, ok := h.mfaUser(c, req.MFAToken)
+	if !ok {
+		return nil
+	}
+
+	if retryAfter, err := h.loginLimiter.Check(account, c.IP()); err != nil {
+		return lockoutError(c, retryAfter, err)
+	}
+
+	if user.MFAEnabled || user.MFASecret == "" {
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "No pending enrolment",
+			Code:    "MFA_NOT_ENROLLING",
+			Message: "Start enrolment before verifying a code",
+		})
+	}
+
+	valid, err := h.tokenManager.VerifyTOTP(user.ID, user.MFASecret, req.Code)
+	if err != nil {
+		return mfaError(c, err)
+	}
+	if !valid {
+		return h.rejectMFACode(c, account)
+	}
+
+	// The enrolment token finishes the login it was issued for, so it is only used up now
+	var enrollment mfaLogin
+	if req.MFAToken != "" {
+		if err := h.tokenManager.ConsumeOneTimeToken(purposeMFAEnrollment, req.MFAToken, &enrollment); err != nil {
+			return mfaTokenError(c, err)
+		}
+	}
+
+	codes, hashes, err := auth.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	user.MFAEnabled = true
+	user.MFARecoveryCodes = hashes
+	if err := h.users.UpdateMFA(user); err != nil {
+		return mfaError(c, err)
+	}
+
+	response := models.MFARecoveryCodesResponse{RecoveryCodes: codes}
+	if req.MFAToken != "" {
+		login, err := h.newLogin(c, user, enrollment.Account)
+		if err != nil {
+			return loginError(c, err)
+		}
+		response.Login = login
+	}
+
+	return c.JSON(response)
+}
+
+// DisableMFA turns MFA off for the authenticated user unless the tenant requires it for their role
+func (h *AuthHandler) DisableMFA(c *fiber.Ctx) error {
+	user, ok := h.confirmMFA(c)
+	if !ok {
+		return nil
+	}
+
+	required, err := h.mfaRequired(user)
+	if err != nil {
+		return mfaError(c, err)
+	}
+	if required {
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "MFA required",
+			Code:    "MFA_REQUIRED",
+			Message: "Your organization requires multi-factor authentication for your role",
+		})
+	}
+
+	user.MFAEnabled = false
+	user.MFASecret = ""
+	user.MFARecoveryCodes = nil
+	if err := h.users.UpdateMFA(user); err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Multi-factor authentication disabled",
+	})
+}
+
+// RegenerateRecoveryCodes replaces all recovery codes of the authenticated user
+func (h *AuthHandler) RegenerateRecoveryCodes(c *fiber.Ctx) error {
+	user, ok := h.confirmMFA(c)
+	if !ok {
+		return nil
+	}
+
+	codes, hashes, err := auth.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return mfaError(c, err)
+	}
+
+	user.MFARecoveryCodes = hashes
+	if err := h.users.UpdateMFA(user); err != nil {
+		return mfaError(c, err)
+	}
+
+	return c.JSON(models.MFARecoveryCodesResponse{RecoveryCodes: codes})
+}
+
+// mfaChallenge returns the challenge of a password-authenticated user who still needs a
+// second factor, or nil when the password is enough
+func (h *AuthHandler) mfaChallenge(user *models.User, account string) (*models.MFAChallengeResponse, error) {
+	purpose, ttl := purposeMFAChallenge, mfaChallengeTTL
+	if !user.MFAEnabled {
+		required, err := h.mfaRequired(user)
+		if err != nil || !required {
+			return nil, err
+		}
+		purpose, ttl = purposeMFAEnrollment, mfaEnrollmentTTL
+	}
+
+	token, err := h.tokenManager.IssueOneTimeToken(purpose, mfaLogin{TenantID: user.TenantID, UserID: user.ID, Account: account}, ttl)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.MFAChallengeResponse{
+		MFARequired:           user.MFAEnabled,
+		MFAEnrollmentRequired: !user.MFAEnabled,
+		MFAToken:              token,
+		ExpiresIn:             int(ttl.Seconds()),
+	}, nil
+}
+
+// mfaRequired reports whether the tenant's settings require MFA for one of the user's roles
+func (h *AuthHandler) mfaRequired(user *models.User) (bool, error) {
+	if user.TenantID == store.SystemTenantSlug {
+		return false, nil
+	}
+
+	settings, err := h.users.TenantSettings(user.TenantID)
+	if err != nil {
+		return false, err
+	}
+
+	var requiredRoles []string
+	switch roles := settings[mfaRequiredRolesSetting].(type) {
+	case []string:
+		requiredRoles = roles
+	case []interface{}: // decoded from JSON
+		for _, role := range roles {
+			if name, ok := role.(string); ok {
+				requiredRoles = append(requiredRoles, name)
+			}
+		}
+	}
+
+	for _, required := range requiredRoles {
+		for _, role := range user.Roles {
+			if role == required {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// mfaUser returns the user enrolling in MFA, identified by an enrolment token during
+// login or by the bearer token otherwise, and the account their failed codes count against.
+// It writes the error response when it fails.
+func (h *AuthHandler) mfaUser(c *fiber.Ctx, mfaToken string) (*models.User, string, bool) {
+	var tenantID, userID, account string
+	if mfaToken != "" {
+		var enrollment mfaLogin
+		if err := h.tokenManager.PeekOneTimeToken(purposeMFAEnrollment, mfaToken, &enrollment); err != nil {
+			mfaTokenError(c, err)
+			return nil, "", false
+		}
+		tenantID, userID, account = enrollment.TenantID, enrollment.UserID, enrollment.Account
+	} else {
+		parts := strings.Split(c.Get("Authorization"), " ")
+		if len(parts) != 2 || parts[0] != "Bearer" {
+			c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+				Error:   "Authorization required",
+				Code:    "AUTH_REQUIRED",
+				Message: "Please provide a valid authorization token or mfa_token",
+			})
+			return nil, "", false
+		}
+
+		claims, err := h.tokenManager.ValidateToken(parts[1])
+		if err != nil {
+			c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+				Error:   "Invalid token",
+				Code:    "INVALID_TOKEN",
+				Message: "Token is invalid or expired",
+			})
+			return nil, "", false
+		}
+		if claims.IsImpersonation() {
+			impersonationForbidden(c)
+			return nil, "", false
+		}
+		tenantID, userID = claims.TenantID, claims.UserID
+	}
+
+	user, err := h.users.GetUser(tenantID, userID)
+	if err != nil {
+		registrationError(c, err)
+		return nil, "", false
+	}
+	if account == "" {
+		account = auth.LoginAccount(user.TenantID, user.Email)
+	}
+	return user, account, true
+}
+
+// confirmMFA loads the authenticated user and checks the TOTP code in the request body.
+// It must run after RequireAuth and writes the error response when it fails.
+func (h *AuthHandler) confirmMFA(c *fiber.Ctx) (*models.User, bool) {
+	var req models.MFACodeRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Code is required",
+		})
+		return nil, false
+	}
+
+	claims := getClaims(c)
+	user, err := h.users.GetUser(claims.TenantID, claims.UserID)
+	if err != nil {
+		registrationError(c, err)
+		return nil, false
+	}
+
+	if !user.MFAEnabled {
+		c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "MFA not enabled",
+			Code:    "MFA_NOT_ENABLED",
+			Message: "Multi-factor authentication is not enabled for this account",
+		})
+		return nil, false
+	}
+
+	account := auth.LoginAccount(user.TenantID, user.Email)
+	if retryAfter, err := h.loginLimiter.Check(account, c.IP()); err != nil {
+		lockoutError(c, retryAfter, err)
+		return nil, false
+	}
+
+	valid, err := h.tokenManager.VerifyTOTP(user.ID, user.MFASecret, req.Code)
+	if err != nil {
+		mfaError(c, err)
+		return nil, false
+	}
+	if !valid {
+		h.rejectMFACode(c, account)
+		return nil, false
+	}
+	return user, true
+}
+
+// rejectMFACode responds to a wrong code, which counts toward the lockout like a wrong password
+func (h *AuthHandler) rejectMFACode(c *fiber.Ctx, account string) error {
+	if lockedFor, err := h.recordLoginFailure(account, c.IP()); err != nil {
+		return lockoutError(c, lockedFor, err)
+	}
+	return invalidMFACode(c)
+}
+
+// mfaTokenError responds to an invalid or expired MFA token
+func mfaTokenError(c *fiber.Ctx, err error) error {
+	if err != auth.ErrInvalidOneTimeToken {
+		return mfaError(c, err)
+	}
+	return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+		Error:   "Invalid MFA token",
+		Code:    "INVALID_MFA_TOKEN",
+		Message: "The login has expired. Please log in again",
+	})
+}
+
+// invalidMFACode responds to a wrong or already used code
+func invalidMFACode(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+		Error:   "Invalid code",
+		Code:    "INVALID_MFA_CODE",
+		Message: "The verification code is invalid or was already used",
+	})
+}
+
+// mfaError converts store and token errors of the MFA endpoints into responses
+func mfaError(c *fiber.Ctx, err error) error {
+	if err == store.ErrNotSupported {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Not supported",
+			Code:    "MFA_NOT_SUPPORTED",
+			Message: "Multi-factor authentication is not available for this account",
+		})
+	}
+	if err == store.ErrUserNotFound || err == store.ErrTenantNotFound {
+		return registrationError(c, err)
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Error:   "MFA failed",
+		Code:    "MFA_ERROR",
+		Message: "Unable to process multi-factor authentication",
+	})
+}
@@ -0,0 +1,164 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/mailer"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// purposePasswordReset is the purpose of password reset tokens
+const purposePasswordReset = "password_reset"
+
+// resetTokenTTL is how long a password reset link stays valid
+const resetTokenTTL = time.Hour
+
+// passwordReset is the payload of a password reset token, scoped to a user of a tenant
+type passwordReset struct {
+	TenantID string `json:"tenant_id"`
+	UserID   string `json:"user_id"`
+	Email    string `json:"email"`
+}
+
+// PasswordResetHandler handles the forgot-password and reset-password flow
+type PasswordResetHandler struct {
+	users        store.UserStore
+	tokenManager *auth.TokenManager
+	mailer       mailer.Mailer
+	appURL       string // Base URL of the web app the emailed links point to
+	sending      sync.WaitGroup
+}
+
+// NewPasswordResetHandler creates a new password reset handler
+func NewPasswordResetHandler(users store.UserStore, tokenManager *auth.TokenManager, mailer mailer.Mailer, appURL string) *PasswordResetHandler {
+	return &PasswordResetHandler{
+		users:        users,
+		tokenManager: tokenManager,
+		mailer:       mailer,
+		appURL:       strings.TrimRight(appURL, "/"),
+	}
+}
+
+// ForgotPassword emails a password reset link. The response is the same whether or not
+// the account exists, and the email is sent in the background so the response time does
+// not reveal it either.
+func (h *PasswordResetHandler) ForgotPassword(c *fiber.Ctx) error {
+	var req models.ForgotPasswordRequest
+	if err := c.BodyParser(&req); err != nil || req.Email == "" || req.TenantSlug == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Missing required fields",
+			Code:    "VALIDATION_ERROR",
+			Message: "Email and tenant_slug are required",
+		})
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	tenantSlug := strings.ToLower(strings.TrimSpace(req.TenantSlug))
+
+	h.sending.Add(1)
+	go func() {
+		defer h.sending.Done()
+		if err := h.sendResetEmail(tenantSlug, email); err != nil {
+			log.Printf("password reset for %s in %s not sent: %v", email, tenantSlug, err)
+		}
+	}()
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "If an account exists for this email, a password reset link has been sent",
+	})
+}
+
+// ResetPassword sets a new password with a reset token and logs the user out everywhere
+func (h *PasswordResetHandler) ResetPassword(c *fiber.Ctx) error {
+	var req models.ResetPasswordRequest
+	if err := c.BodyParser(&req); err != nil || req.Token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Reset token and password are required",
+		})
+	}
+
+	// Validate before consuming the token so a rejected password does not burn the link
+	if len(req.Password) < 8 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Password too short",
+			Code:    "VALIDATION_ERROR",
+			Message: "Password must be at least 8 characters",
+		})
+	}
+
+	var reset passwordReset
+	if err := h.tokenManager.ConsumeOneTimeToken(purposePasswordReset, req.Token, &reset); err != nil {
+		return invalidTokenError(c, err, "INVALID_RESET_TOKEN")
+	}
+
+	// The token only applies to the account it was issued for
+	user, err := h.users.GetUser(reset.TenantID, reset.UserID)
+	if err != nil || user.Email != reset.Email {
+		return invalidTokenError(c, auth.ErrInvalidOneTimeToken, "INVALID_RESET_TOKEN")
+	}
+
+	if err := h.users.SetPassword(reset.TenantID, reset.UserID, req.Password); err != nil {
+		return registrationError(c, err)
+	}
+
+	// Links requested before the reset must not set the password again
+	if err := h.tokenManager.RevokeOneTimeTokens(purposePasswordReset, resetSubject(reset.TenantID, reset.UserID)); err != nil {
+		return sessionError(c, err)
+	}
+
+	if _, err := h.tokenManager.RevokeUserSessions(reset.UserID); err != nil {
+		return sessionError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Password has been reset. Please log in with your new password",
+	})
+}
+
+// Wait blocks until the reset emails being sent in the background are sent
+func (h *PasswordResetHandler) Wait() {
+	h.sending.Wait()
+}
+
+// resetSubject identifies the account reset tokens are issued for
+func resetSubject(tenantID, userID string) string {
+	return tenantID + ":" + userID
+}
+
+// sendResetEmail emails a reset link if an enabled account exists
+func (h *PasswordResetHandler) sendResetEmail(tenantSlug, email string) error {
+	user, err := h.users.FindUser(tenantSlug, email)
+	if err != nil {
+		return err
+	}
+	if user.Status != "active" && user.Status != "pending" {
+		return fmt.Errorf("account is %s", user.Status)
+	}
+
+	token, err := h.tokenManager.IssueOneTimeTokenFor(purposePasswordReset, resetSubject(user.TenantID, user.ID), passwordReset{
+		TenantID: user.TenantID,
+		UserID:   user.ID,
+		Email:    user.Email,
+	}, resetTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	return h.mailer.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Hi %s,\n\nWe received a request to reset your Zplus password. Choose a new password here:\n%s\n\nThis link expires in 1 hour. If you did not request a reset, you can ignore this email.",
+			user.FirstName, appLink(h.appURL, "/reset-password", "token", token)),
+	})
+}
@@ -0,0 +1,384 @@
+package handlers
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/mailer"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// Purposes of the one-time tokens sent by email
+const (
+	purposeEmailVerification = "email_verification"
+	purposeInvitation        = "invitation"
+)
+
+// Lifetimes of the one-time tokens sent by email
+const (
+	verificationTokenTTL = 24 * time.Hour
+	invitationTokenTTL   = 7 * 24 * time.Hour
+)
+
+// emailVerification is the payload of an email verification token
+type emailVerification struct {
+	TenantID string `json:"tenant_id"`
+	UserID   string `json:"user_id"`
+}
+
+// invitation is the payload of an invitation token
+type invitation struct {
+	TenantID  string `json:"tenant_id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	InvitedBy string `json:"invited_by"`
+}
+
+// RegistrationHandler handles self-service signup, email verification and invitations
+type RegistrationHandler struct {
+	users        store.UserStore
+	roles        store.RoleStore
+	tokenManager *auth.TokenManager
+	mailer       mailer.Mailer
+	appURL       string // Base URL of the web app the emailed links point to
+}
+
+// NewRegistrationHandler creates a new registration handler
+func NewRegistrationHandler(users store.UserStore, roles store.RoleStore, tokenManager *auth.TokenManager, mailer mailer.Mailer, appURL string) *RegistrationHandler {
+	return &RegistrationHandler{
+		users:        users,
+		roles:        roles,
+		tokenManager: tokenManager,
+		mailer:       mailer,
+		appURL:       strings.TrimRight(appURL, "/"),
+	}
+}
+
+// Register creates an account. New tenants start as a trial with the registering user as
+// tenant admin, pending until the emailed verification link is confirmed. Accepting an
+// invitation already proves the email address, so invited users are active right away.
+func (h *RegistrationHandler) Register(c *fiber.Ctx) error {
+	var req models.RegisterRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Please provide valid JSON data",
+		})
+	}
+
+	req.Email = strings.ToLower(strings.TrimSpace(req.Email))
+	req.TenantSlug = strings.ToLower(strings.TrimSpace(req.TenantSlug))
+
+	if req.Password == "" || req.FirstName == "" || req.LastName == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Missing required fields",
+			Code:    "VALIDATION_ERROR",
+			Message: "Password, first_name and last_name are required",
+		})
+	}
+	if len(req.Password) < 8 {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Password too short",
+			Code:    "VALIDATION_ERROR",
+			Message: "Password must be at least 8 characters",
+		})
+	}
+
+	if req.InvitationToken != "" {
+		return h.registerWithInvitation(c, &req)
+	}
+
+	if req.Email == "" || req.TenantName == "" || req.TenantSlug == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Missing required fields",
+			Code:    "VALIDATION_ERROR",
+			Message: "Email, tenant_name and tenant_slug are required to create an organization",
+		})
+	}
+
+	user, err := h.users.CreateTenant(req.TenantName, req.TenantSlug, store.NewUser{
+		Email:     req.Email,
+		Password:  req.Password,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Role:      "tenant_admin",
+		Status:    "pending",
+	})
+	if err != nil {
+		return registrationError(c, err)
+	}
+
+	if err := h.sendVerificationEmail(user); err != nil {
+		log.Printf("failed to send verification email to %s: %v", user.Email, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(models.RegisterResponse{
+		User:    user,
+		Message: "Account created. Please check your email to verify your address",
+	})
+}
+
+// VerifyEmail activates a pending account with the token from the verification email
+func (h *RegistrationHandler) VerifyEmail(c *fiber.Ctx) error {
+	var req models.VerifyEmailRequest
+	if err := c.BodyParser(&req); err != nil || req.Token == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Verification token is required",
+		})
+	}
+
+	var verification emailVerification
+	if err := h.tokenManager.ConsumeOneTimeToken(purposeEmailVerification, req.Token, &verification); err != nil {
+		return invalidTokenError(c, err, "INVALID_VERIFICATION_TOKEN")
+	}
+
+	if err := h.users.SetUserStatus(verification.TenantID, verification.UserID, "active"); err != nil {
+		return registrationError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Email verified. You can now log in",
+	})
+}
+
+// ResendVerification sends a new verification email to a pending account. The password is
+// required so the endpoint cannot be used to send emails to arbitrary addresses.
+func (h *RegistrationHandler) ResendVerification(c *fiber.Ctx) error {
+	var req models.LoginRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Please provide valid JSON data",
+		})
+	}
+
+	user, err := h.users.Authenticate(strings.ToLower(strings.TrimSpace(req.TenantSlug)), strings.ToLower(strings.TrimSpace(req.Email)), req.Password)
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Error:   "Invalid credentials",
+			Code:    "INVALID_CREDENTIALS",
+			Message: "Email or password is incorrect",
+		})
+	}
+
+	if user.Status != "pending" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Already verified",
+			Code:    "ALREADY_VERIFIED",
+			Message: "This email address has already been verified",
+		})
+	}
+
+	if err := h.sendVerificationEmail(user); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Email delivery failed",
+			Code:    "EMAIL_ERROR",
+			Message: "Unable to send verification email",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Verification email sent",
+	})
+}
+
+// CreateInvitation emails an invitation to join the tenant of the authenticated tenant admin.
+// It must run after RequireAuth.
+func (h *RegistrationHandler) CreateInvitation(c *fiber.Ctx) error {
+	claims := getClaims(c)
+	if !isTenantAdmin(c, h.users) {
+		return forbidden(c, "Tenant administrator access required")
+	}
+
+	var req models.InvitationRequest
+	if err := c.BodyParser(&req); err != nil || req.Email == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Email is required",
+		})
+	}
+	req.Role = strings.ToLower(strings.TrimSpace(req.Role))
+	if req.Role == "" {
+		req.Role = "user"
+	}
+	if req.Role == store.SystemAdminRole {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid role",
+			Code:    "ROLE_NAME_RESERVED",
+			Message: "Invitations cannot grant system administrator access",
+		})
+	}
+	if exists, err := tenantHasRole(h.roles, claims.TenantID, req.Role); err != nil {
+		return registrationError(c, err)
+	} else if !exists {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid role",
+			Code:    "INVALID_ROLE",
+			Message: "The role does not exist in this organization",
+		})
+	}
+
+	email := strings.ToLower(strings.TrimSpace(req.Email))
+	token, err := h.tokenManager.IssueOneTimeToken(purposeInvitation, invitation{
+		TenantID:  claims.TenantID,
+		Email:     email,
+		Role:      req.Role,
+		InvitedBy: claims.UserID,
+	}, invitationTokenTTL)
+	if err != nil {
+		return registrationError(c, err)
+	}
+
+	err = h.mailer.Send(mailer.Message{
+		To:      email,
+		Subject: "You have been invited to Zplus",
+		Body: fmt.Sprintf("You have been invited to join your team on Zplus.\n\nCreate your account here:\n%s\n\nThis invitation expires in 7 days.",
+			appLink(h.appURL, "/register", "invitation", token)),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Email delivery failed",
+			Code:    "EMAIL_ERROR",
+			Message: "Unable to send invitation email",
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"success": true,
+		"message": "Invitation sent",
+	})
+}
+
+// Helper methods
+
+// registerWithInvitation creates an active user in the tenant that issued the invitation.
+// The invitation is only used up once the account exists, so a failed attempt can be retried.
+func (h *RegistrationHandler) registerWithInvitation(c *fiber.Ctx, req *models.RegisterRequest) error {
+	var invite invitation
+	if err := h.tokenManager.PeekOneTimeToken(purposeInvitation, req.InvitationToken, &invite); err != nil {
+		return invalidTokenError(c, err, "INVALID_INVITATION")
+	}
+
+	if req.Email != "" && req.Email != invite.Email {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid invitation",
+			Code:    "INVALID_INVITATION",
+			Message: "The invitation was sent to a different email address",
+		})
+	}
+
+	// The role may have been deleted since the invitation was sent
+	if exists, err := tenantHasRole(h.roles, invite.TenantID, invite.Role); err != nil {
+		return registrationError(c, err)
+	} else if !exists {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid invitation",
+			Code:    "INVALID_INVITATION",
+			Message: "The role of the invitation no longer exists",
+		})
+	}
+
+	user, err := h.users.CreateUser(invite.TenantID, store.NewUser{
+		Email:     invite.Email,
+		Password:  req.Password,
+		FirstName: req.FirstName,
+		LastName:  req.LastName,
+		Role:      invite.Role,
+		Status:    "active",
+	})
+	if err != nil {
+		return registrationError(c, err)
+	}
+	if err := h.tokenManager.ConsumeOneTimeToken(purposeInvitation, req.InvitationToken, &invite); err != nil {
+		log.Printf("failed to use up invitation of %s: %v", invite.Email, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(models.RegisterResponse{
+		User:    user,
+		Message: "Account created. You can now log in",
+	})
+}
+
+// sendVerificationEmail emails a link confirming the address of a pending user
+func (h *RegistrationHandler) sendVerificationEmail(user *models.User) error {
+	token, err := h.tokenManager.IssueOneTimeToken(purposeEmailVerification, emailVerification{
+		TenantID: user.TenantID,
+		UserID:   user.ID,
+	}, verificationTokenTTL)
+	if err != nil {
+		return err
+	}
+
+	return h.mailer.Send(mailer.Message{
+		To:      user.Email,
+		Subject: "Verify your email address",
+		Body: fmt.Sprintf("Hi %s,\n\nPlease confirm your email address to activate your Zplus account:\n%s\n\nThis link expires in 24 hours.",
+			user.FirstName, appLink(h.appURL, "/verify-email", "token", token)),
+	})
+}
+
+// appLink builds a web app URL carrying a token as query parameter
+func appLink(appURL, path, param, token string) string {
+	return fmt.Sprintf("%s%s?%s=%s", appURL, path, param, url.QueryEscape(token))
+}
+
+// registrationError converts store errors into responses
+func registrationError(c *fiber.Ctx, err error) error {
+	switch err {
+	case store.ErrInvalidSlug:
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid tenant slug",
+			Code:    "INVALID_SLUG",
+			Message: "Slug must contain only lowercase letters, numbers, and hyphens",
+		})
+	case store.ErrSlugTaken:
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "Tenant slug taken",
+			Code:    "SLUG_TAKEN",
+			Message: "An organization with this slug already exists",
+		})
+	case store.ErrEmailTaken:
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "Email taken",
+			Code:    "EMAIL_TAKEN",
+			Message: "An account with this email already exists in this organization",
+		})
+	case store.ErrUserNotFound, store.ErrTenantNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Not found",
+			Code:    "NOT_FOUND",
+			Message: "The account or organization no longer exists",
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Registration failed",
+			Code:    "SERVER_ERROR",
+			Message: err.Error(),
+		})
+	}
+}
+
+// invalidTokenError responds to an unknown, expired or used emailed token
+func invalidTokenError(c *fiber.Ctx, err error, code string) error {
+	if err != auth.ErrInvalidOneTimeToken {
+		return registrationError(c, err)
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+		Error:   "Invalid token",
+		Code:    code,
+		Message: "The link is invalid or has expired",
+	})
+}
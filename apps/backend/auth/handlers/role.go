@@ -1,127 +1,41 @@
 package handlers
 
 import (
-	"fmt"
-	"strconv"
 	"strings"
-	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	sharedmodels "github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
 )
 
-// RoleHandler handles role and permission management endpoints
+// RoleHandler handles role and permission management endpoints. Roles and assignments
+// belong to the caller's tenant; system admins pick the tenant with ?tenant_id=.
+// The endpoints must run after RequireAuth.
 type RoleHandler struct {
-	// Mock storage for roles and permissions
-	roles           map[int]*models.Role
-	permissions     map[int]*models.Permission
-	userRoles       map[string][]int // user_id -> role_ids
-	rolePermissions map[int][]int    // role_id -> permission_ids
-	nextRoleID      int
-	nextPermID      int
+	roles store.RoleStore
+	users store.UserStore // Accounts of callers, to check their access
 }
 
-// NewRoleHandler creates a new role handler with mock data
-func NewRoleHandler() *RoleHandler {
-	handler := &RoleHandler{
-		roles:           make(map[int]*models.Role),
-		permissions:     make(map[int]*models.Permission),
-		userRoles:       make(map[string][]int),
-		rolePermissions: make(map[int][]int),
-		nextRoleID:      1,
-		nextPermID:      1,
+// NewRoleHandler creates a new role handler
+func NewRoleHandler(roles store.RoleStore, users store.UserStore) *RoleHandler {
+	return &RoleHandler{
+		roles: roles,
+		users: users,
 	}
-
-	// Initialize with default roles and permissions
-	handler.initializeDefaultData()
-	return handler
-}
-
-// initializeDefaultData creates default roles and permissions
-func (h *RoleHandler) initializeDefaultData() {
-	now := time.Now()
-
-	// Create default roles
-	roles := []*models.Role{
-		{
-			ID:           h.nextRoleID,
-			Name:         "system_admin",
-			DisplayName:  "System Administrator",
-			Description:  "Full system access and management",
-			IsSystemRole: true,
-			CreatedAt:    now,
-			UpdatedAt:    now,
-		},
-		{
-			ID:           h.nextRoleID + 1,
-			Name:         "tenant_admin",
-			DisplayName:  "Tenant Administrator",
-			Description:  "Full tenant access and management",
-			IsSystemRole: true,
-			CreatedAt:    now,
-			UpdatedAt:    now,
-		},
-		{
-			ID:           h.nextRoleID + 2,
-			Name:         "manager",
-			DisplayName:  "Manager",
-			Description:  "Management level access",
-			IsSystemRole: true,
-			CreatedAt:    now,
-			UpdatedAt:    now,
-		},
-		{
-			ID:           h.nextRoleID + 3,
-			Name:         "user",
-			DisplayName:  "User",
-			Description:  "Standard user access",
-			IsSystemRole: true,
-			CreatedAt:    now,
-			UpdatedAt:    now,
-		},
-	}
-
-	for _, role := range roles {
-		h.roles[role.ID] = role
-		h.nextRoleID++
-	}
-
-	// Create default permissions
-	permissions := []*models.Permission{
-		{ID: h.nextPermID, Name: "system:manage", Resource: "system", Action: "manage", Description: "System management", CreatedAt: now},
-		{ID: h.nextPermID + 1, Name: "tenants:read", Resource: "tenants", Action: "read", Description: "Read tenants", CreatedAt: now},
-		{ID: h.nextPermID + 2, Name: "tenants:write", Resource: "tenants", Action: "write", Description: "Write tenants", CreatedAt: now},
-		{ID: h.nextPermID + 3, Name: "users:read", Resource: "users", Action: "read", Description: "Read users", CreatedAt: now},
-		{ID: h.nextPermID + 4, Name: "users:write", Resource: "users", Action: "write", Description: "Write users", CreatedAt: now},
-		{ID: h.nextPermID + 5, Name: "customers:read", Resource: "customers", Action: "read", Description: "Read customers", CreatedAt: now},
-		{ID: h.nextPermID + 6, Name: "customers:write", Resource: "customers", Action: "write", Description: "Write customers", CreatedAt: now},
-		{ID: h.nextPermID + 7, Name: "employees:read", Resource: "employees", Action: "read", Description: "Read employees", CreatedAt: now},
-		{ID: h.nextPermID + 8, Name: "employees:write", Resource: "employees", Action: "write", Description: "Write employees", CreatedAt: now},
-		{ID: h.nextPermID + 9, Name: "products:read", Resource: "products", Action: "read", Description: "Read products", CreatedAt: now},
-		{ID: h.nextPermID + 10, Name: "products:write", Resource: "products", Action: "write", Description: "Write products", CreatedAt: now},
-	}
-
-	for _, perm := range permissions {
-		h.permissions[perm.ID] = perm
-		h.nextPermID++
-	}
-
-	// Assign permissions to roles
-	// System Admin - all permissions
-	h.rolePermissions[1] = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}
-	// Tenant Admin - tenant-level permissions
-	h.rolePermissions[2] = []int{4, 5, 6, 7, 8, 9, 10, 11}
-	// Manager - read/write business data
-	h.rolePermissions[3] = []int{4, 6, 7, 8, 9, 10, 11}
-	// User - read-only access
-	h.rolePermissions[4] = []int{4, 6, 8, 10}
 }
 
-// GetRoles returns all roles
+// GetRoles returns all roles of the tenant
 func (h *RoleHandler) GetRoles(c *fiber.Ctx) error {
-	roles := make([]*models.Role, 0, len(h.roles))
-	for _, role := range h.roles {
-		roles = append(roles, role)
+	tenantID, ok := h.roleTenant(c, false)
+	if !ok {
+		return nil
+	}
+
+	roles, err := h.roles.ListRoles(tenantID)
+	if err != nil {
+		return roleStoreError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
@@ -132,22 +46,18 @@ func (h *RoleHandler) GetRoles(c *fiber.Ctx) error {
 
 // GetRole returns a specific role by ID
 func (h *RoleHandler) GetRole(c *fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error:   "Invalid role ID",
-			Code:    "INVALID_ID",
-			Message: "Role ID must be a valid integer",
-		})
+	tenantID, ok := h.roleTenant(c, false)
+	if !ok {
+		return nil
+	}
+	roleID, ok := roleIDParam(c)
+	if !ok {
+		return nil
 	}
 
-	role, exists := h.roles[id]
-	if !exists {
-		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-			Error:   "Role not found",
-			Code:    "ROLE_NOT_FOUND",
-			Message: fmt.Sprintf("Role with ID %d not found", id),
-		})
+	role, err := h.roles.GetRole(tenantID, roleID)
+	if err != nil {
+		return roleStoreError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
@@ -157,6 +67,11 @@ func (h *RoleHandler) GetRole(c *fiber.Ctx) error {
 
 // CreateRole creates a new role
 func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
+	tenantID, ok := h.roleTenant(c, true)
+	if !ok {
+		return nil
+	}
+
 	var req models.CreateRoleRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
@@ -175,31 +90,28 @@ func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
 		})
 	}
 
-	// Check if role name already exists
-	for _, role := range h.roles {
-		if strings.EqualFold(role.Name, req.Name) {
-			return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
-				Error:   "Role already exists",
-				Code:    "ROLE_EXISTS",
-				Message: fmt.Sprintf("Role with name '%s' already exists", req.Name),
-			})
-		}
+	if store.ReservedRoleName(req.Name) {
+		return roleStoreError(c, store.ErrReservedRole)
+	}
+
+	// Only system admins define system roles, which tenants cannot delete
+	if req.IsSystemRole && !isSystemAdmin(c, h.users) {
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Cannot create system role",
+			Code:    "SYSTEM_ROLE_PROTECTED",
+			Message: "Only system administrators can create system roles",
+		})
 	}
 
-	// Create new role
-	now := time.Now()
 	role := &models.Role{
-		ID:           h.nextRoleID,
 		Name:         strings.ToLower(strings.TrimSpace(req.Name)),
 		DisplayName:  strings.TrimSpace(req.DisplayName),
 		Description:  strings.TrimSpace(req.Description),
 		IsSystemRole: req.IsSystemRole,
-		CreatedAt:    now,
-		UpdatedAt:    now,
 	}
-
-	h.roles[role.ID] = role
-	h.nextRoleID++
+	if err := h.roles.CreateRole(tenantID, role); err != nil {
+		return roleStoreError(c, err)
+	}
 
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
 		"data":    role,
@@ -207,24 +119,15 @@ func (h *RoleHandler) CreateRole(c *fiber.Ctx) error {
 	})
 }
 
-// UpdateRole updates an existing role
+// UpdateRole updates the display name and description of a role
 func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error:   "Invalid role ID",
-			Code:    "INVALID_ID",
-			Message: "Role ID must be a valid integer",
-		})
+	tenantID, ok := h.roleTenant(c, true)
+	if !ok {
+		return nil
 	}
-
-	role, exists := h.roles[id]
-	if !exists {
-		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-			Error:   "Role not found",
-			Code:    "ROLE_NOT_FOUND",
-			Message: fmt.Sprintf("Role with ID %d not found", id),
-		})
+	roleID, ok := roleIDParam(c)
+	if !ok {
+		return nil
 	}
 
 	var req models.UpdateRoleRequest
@@ -236,6 +139,11 @@ func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
 		})
 	}
 
+	role, err := h.roles.GetRole(tenantID, roleID)
+	if err != nil {
+		return roleStoreError(c, err)
+	}
+
 	// Update role fields
 	if req.DisplayName != "" {
 		role.DisplayName = strings.TrimSpace(req.DisplayName)
@@ -243,7 +151,9 @@ func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
 	if req.Description != "" {
 		role.Description = strings.TrimSpace(req.Description)
 	}
-	role.UpdatedAt = time.Now()
+	if err := h.roles.UpdateRole(tenantID, role); err != nil {
+		return roleStoreError(c, err)
+	}
 
 	return c.JSON(fiber.Map{
 		"data":    role,
@@ -253,36 +163,19 @@ func (h *RoleHandler) UpdateRole(c *fiber.Ctx) error {
 
 // DeleteRole deletes a role
 func (h *RoleHandler) DeleteRole(c *fiber.Ctx) error {
-	id, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error:   "Invalid role ID",
-			Code:    "INVALID_ID",
-			Message: "Role ID must be a valid integer",
-		})
+	tenantID, ok := h.roleTenant(c, true)
+	if !ok {
+		return nil
 	}
-
-	role, exists := h.roles[id]
-	if !exists {
-		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-			Error:   "Role not found",
-			Code:    "ROLE_NOT_FOUND",
-			Message: fmt.Sprintf("Role with ID %d not found", id),
-		})
+	roleID, ok := roleIDParam(c)
+	if !ok {
+		return nil
 	}
 
-	// Prevent deletion of system roles
-	if role.IsSystemRole {
-		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
-			Error:   "Cannot delete system role",
-			Code:    "SYSTEM_ROLE_PROTECTED",
-			Message: "System roles cannot be deleted",
-		})
+	if err := h.roles.DeleteRole(tenantID, roleID); err != nil {
+		return roleStoreError(c, err)
 	}
 
-	delete(h.roles, id)
-	delete(h.rolePermissions, id)
-
 	return c.JSON(fiber.Map{
 		"message": "Role deleted successfully",
 	})
@@ -290,9 +183,14 @@ func (h *RoleHandler) DeleteRole(c *fiber.Ctx) error {
 
 // GetPermissions returns all permissions
 func (h *RoleHandler) GetPermissions(c *fiber.Ctx) error {
-	permissions := make([]*models.Permission, 0, len(h.permissions))
-	for _, perm := range h.permissions {
-		permissions = append(permissions, perm)
+	tenantID, ok := h.roleTenant(c, false)
+	if !ok {
+		return nil
+	}
+
+	permissions, err := h.roles.ListPermissions(tenantID)
+	if err != nil {
+		return roleStoreError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
@@ -301,8 +199,13 @@ func (h *RoleHandler) GetPermissions(c *fiber.Ctx) error {
 	})
 }
 
-// CreatePermission creates a new permission
+// CreatePermission adds a permission to the catalogue shared by all tenants, which is
+// limited to system admins
 func (h *RoleHandler) CreatePermission(c *fiber.Ctx) error {
+	if !isSystemAdmin(c, h.users) {
+		return forbidden(c, "System administrator access required")
+	}
+
 	var req models.CreatePermissionRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
@@ -321,30 +224,27 @@ func (h *RoleHandler) CreatePermission(c *fiber.Ctx) error {
 		})
 	}
 
-	// Check if permission already exists
-	for _, perm := range h.permissions {
-		if strings.EqualFold(perm.Name, req.Name) {
-			return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
-				Error:   "Permission already exists",
-				Code:    "PERMISSION_EXISTS",
-				Message: fmt.Sprintf("Permission with name '%s' already exists", req.Name),
-			})
-		}
+	// Resources and actions are names or a whole "*" wildcard
+	resource := strings.ToLower(strings.TrimSpace(req.Resource))
+	action := strings.ToLower(strings.TrimSpace(req.Action))
+	name := resource + ":" + action
+	if err := sharedmodels.ValidatePermission(name); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid permission",
+			Code:    "VALIDATION_ERROR",
+			Message: err.Error(),
+		})
 	}
 
-	// Create new permission
-	now := time.Now()
 	permission := &models.Permission{
-		ID:          h.nextPermID,
-		Name:        fmt.Sprintf("%s:%s", strings.ToLower(req.Resource), strings.ToLower(req.Action)),
-		Resource:    strings.ToLower(strings.TrimSpace(req.Resource)),
-		Action:      strings.ToLower(strings.TrimSpace(req.Action)),
+		Name:        name,
+		Resource:    resource,
+		Action:      action,
 		Description: strings.TrimSpace(req.Description),
-		CreatedAt:   now,
 	}
-
-	h.permissions[permission.ID] = permission
-	h.nextPermID++
+	if err := h.roles.CreatePermission(permission); err != nil {
+		return roleStoreError(c, err)
+	}
 
 	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
 		"data":    permission,
@@ -354,6 +254,11 @@ func (h *RoleHandler) CreatePermission(c *fiber.Ctx) error {
 
 // AssignRoleToUser assigns a role to a user
 func (h *RoleHandler) AssignRoleToUser(c *fiber.Ctx) error {
+	tenantID, ok := h.roleTenant(c, true)
+	if !ok {
+		return nil
+	}
+
 	var req models.AssignRoleRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
@@ -364,7 +269,7 @@ func (h *RoleHandler) AssignRoleToUser(c *fiber.Ctx) error {
 	}
 
 	// Validate required fields
-	if req.UserID == "" || req.RoleID == 0 {
+	if req.UserID == "" || req.RoleID == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
 			Error:   "Missing required fields",
 			Code:    "VALIDATION_ERROR",
@@ -372,30 +277,17 @@ func (h *RoleHandler) AssignRoleToUser(c *fiber.Ctx) error {
 		})
 	}
 
-	// Check if role exists
-	if _, exists := h.roles[req.RoleID]; !exists {
-		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-			Error:   "Role not found",
-			Code:    "ROLE_NOT_FOUND",
-			Message: fmt.Sprintf("Role with ID %d not found", req.RoleID),
-		})
-	}
-
-	// Check if user already has this role
-	userRoles := h.userRoles[req.UserID]
-	for _, roleID := range userRoles {
-		if roleID == req.RoleID {
+	if err := h.roles.AssignRole(tenantID, req.UserID, req.RoleID); err != nil {
+		if err == store.ErrAlreadyAssigned {
 			return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
 				Error:   "Role already assigned",
 				Code:    "ROLE_ALREADY_ASSIGNED",
 				Message: "User already has this role assigned",
 			})
 		}
+		return roleStoreError(c, err)
 	}
 
-	// Assign role to user
-	h.userRoles[req.UserID] = append(h.userRoles[req.UserID], req.RoleID)
-
 	return c.JSON(fiber.Map{
 		"message": "Role assigned successfully",
 	})
@@ -403,6 +295,11 @@ func (h *RoleHandler) AssignRoleToUser(c *fiber.Ctx) error {
 
 // AssignPermissionToRole assigns a permission to a role
 func (h *RoleHandler) AssignPermissionToRole(c *fiber.Ctx) error {
+	tenantID, ok := h.roleTenant(c, true)
+	if !ok {
+		return nil
+	}
+
 	var req models.AssignPermissionRequest
 	if err := c.BodyParser(&req); err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
@@ -413,7 +310,7 @@ func (h *RoleHandler) AssignPermissionToRole(c *fiber.Ctx) error {
 	}
 
 	// Validate required fields
-	if req.RoleID == 0 || req.PermissionID == 0 {
+	if req.RoleID == "" || req.PermissionID == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
 			Error:   "Missing required fields",
 			Code:    "VALIDATION_ERROR",
@@ -421,39 +318,17 @@ func (h *RoleHandler) AssignPermissionToRole(c *fiber.Ctx) error {
 		})
 	}
 
-	// Check if role exists
-	if _, exists := h.roles[req.RoleID]; !exists {
-		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-			Error:   "Role not found",
-			Code:    "ROLE_NOT_FOUND",
-			Message: fmt.Sprintf("Role with ID %d not found", req.RoleID),
-		})
-	}
-
-	// Check if permission exists
-	if _, exists := h.permissions[req.PermissionID]; !exists {
-		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-			Error:   "Permission not found",
-			Code:    "PERMISSION_NOT_FOUND",
-			Message: fmt.Sprintf("Permission with ID %d not found", req.PermissionID),
-		})
-	}
-
-	// Check if role already has this permission
-	rolePermissions := h.rolePermissions[req.RoleID]
-	for _, permID := range rolePermissions {
-		if permID == req.PermissionID {
+	if err := h.roles.AssignPermission(tenantID, req.RoleID, req.PermissionID); err != nil {
+		if err == store.ErrAlreadyAssigned {
 			return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
 				Error:   "Permission already assigned",
 				Code:    "PERMISSION_ALREADY_ASSIGNED",
 				Message: "Role already has this permission assigned",
 			})
 		}
+		return roleStoreError(c, err)
 	}
 
-	// Assign permission to role
-	h.rolePermissions[req.RoleID] = append(h.rolePermissions[req.RoleID], req.PermissionID)
-
 	return c.JSON(fiber.Map{
 		"message": "Permission assigned successfully",
 	})
@@ -461,30 +336,22 @@ func (h *RoleHandler) AssignPermissionToRole(c *fiber.Ctx) error {
 
 // GetRolePermissions returns all permissions for a specific role
 func (h *RoleHandler) GetRolePermissions(c *fiber.Ctx) error {
-	roleID, err := strconv.Atoi(c.Params("id"))
-	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
-			Error:   "Invalid role ID",
-			Code:    "INVALID_ID",
-			Message: "Role ID must be a valid integer",
-		})
+	tenantID, ok := h.roleTenant(c, false)
+	if !ok {
+		return nil
 	}
-
-	role, exists := h.roles[roleID]
-	if !exists {
-		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
-			Error:   "Role not found",
-			Code:    "ROLE_NOT_FOUND",
-			Message: fmt.Sprintf("Role with ID %d not found", roleID),
-		})
+	roleID, ok := roleIDParam(c)
+	if !ok {
+		return nil
 	}
 
-	permissionIDs := h.rolePermissions[roleID]
-	permissions := make([]*models.Permission, 0, len(permissionIDs))
-	for _, permID := range permissionIDs {
-		if perm, exists := h.permissions[permID]; exists {
-			permissions = append(permissions, perm)
-		}
+	role, err := h.roles.GetRole(tenantID, roleID)
+	if err != nil {
+		return roleStoreError(c, err)
+	}
+	permissions, err := h.roles.GetRolePermissions(tenantID, roleID)
+	if err != nil {
+		return roleStoreError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
@@ -496,6 +363,10 @@ func (h *RoleHandler) GetRolePermissions(c *fiber.Ctx) error {
 
 // GetUserRoles returns all roles for a specific user
 func (h *RoleHandler) GetUserRoles(c *fiber.Ctx) error {
+	tenantID, ok := h.roleTenant(c, false)
+	if !ok {
+		return nil
+	}
 	userID := c.Params("id")
 	if userID == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
@@ -505,12 +376,9 @@ func (h *RoleHandler) GetUserRoles(c *fiber.Ctx) error {
 		})
 	}
 
-	roleIDs := h.userRoles[userID]
-	roles := make([]*models.Role, 0, len(roleIDs))
-	for _, roleID := range roleIDs {
-		if role, exists := h.roles[roleID]; exists {
-			roles = append(roles, role)
-		}
+	roles, err := h.roles.GetUserRoles(tenantID, userID)
+	if err != nil {
+		return roleStoreError(c, err)
 	}
 
 	return c.JSON(fiber.Map{
@@ -518,4 +386,278 @@ func (h *RoleHandler) GetUserRoles(c *fiber.Ctx) error {
 		"roles":   roles,
 		"count":   len(roles),
 	})
-}
\ No newline at end of file
+}
+
+// GetUserPermissions returns the permissions a user has through their roles. With a
+// permission query parameter it also reports whether that permission is granted,
+// directly or through a wildcard or implied permission.
+func (h *RoleHandler) GetUserPermissions(c *fiber.Ctx) error {
+	tenantID, ok := h.roleTenant(c, false)
+	if !ok {
+		return nil
+	}
+	userID := c.Params("id")
+	if userID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid user ID",
+			Code:    "INVALID_ID",
+			Message: "User ID is required",
+		})
+	}
+
+	permissions, err := h.roles.GetUserPermissions(tenantID, userID)
+	if err != nil {
+		return roleStoreError(c, err)
+	}
+	response := fiber.Map{
+		"user_id":     userID,
+		"permissions": permissions,
+		"count":       len(permissions),
+	}
+
+	if permission := c.Query("permission"); permission != "" {
+		if err := sharedmodels.ValidatePermission(permission); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Error:   "Invalid permission",
+				Code:    "VALIDATION_ERROR",
+				Message: err.Error(),
+			})
+		}
+		response["permission"] = permission
+		response["granted"] = sharedmodels.PermissionsGrant(permissions, permission)
+	}
+
+	return c.JSON(response)
+}
+
+// GetTemplates returns the system role templates that tenants are seeded with and can clone
+func (h *RoleHandler) GetTemplates(c *fiber.Ctx) error {
+	templates, err := h.roles.ListTemplates()
+	if err != nil {
+		return roleStoreError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data":  templates,
+		"count": len(templates),
+	})
+}
+
+// UpdateTemplate changes a role template, which is limited to system admins. The change
+// is applied to the tenant roles created from the template that were not customised.
+func (h *RoleHandler) UpdateTemplate(c *fiber.Ctx) error {
+	if !isSystemAdmin(c, h.users) {
+		return forbidden(c, "System administrator access required")
+	}
+
+	var req models.UpdateRoleTemplateRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Please provide valid JSON data",
+		})
+	}
+
+	if req.DisplayName != nil && strings.TrimSpace(*req.DisplayName) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Missing required fields",
+			Code:    "VALIDATION_ERROR",
+			Message: "display_name cannot be empty",
+		})
+	}
+	for _, permission := range req.Permissions {
+		if err := sharedmodels.ValidatePermission(permission); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Error:   "Invalid permission",
+				Code:    "VALIDATION_ERROR",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	template, updated, err := h.roles.UpdateTemplate(c.Params("name"), req)
+	if err != nil {
+		return roleStoreError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data":          template,
+		"updated_roles": updated,
+		"message":       "Role template updated successfully",
+	})
+}
+
+// CloneRole creates a custom role from a role template. Clones keep the template's
+// permissions at the time of cloning and are not changed by later template updates.
+func (h *RoleHandler) CloneRole(c *fiber.Ctx) error {
+	tenantID, ok := h.roleTenant(c, true)
+	if !ok {
+		return nil
+	}
+
+	var req models.CloneRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Please provide valid JSON data",
+		})
+	}
+
+	// Validate required fields
+	if req.Template == "" || strings.TrimSpace(req.Name) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Missing required fields",
+			Code:    "VALIDATION_ERROR",
+			Message: "Template and name are required",
+		})
+	}
+
+	if store.ReservedRoleName(req.Name) {
+		return roleStoreError(c, store.ErrReservedRole)
+	}
+
+	role := &models.Role{
+		Name:        strings.ToLower(strings.TrimSpace(req.Name)),
+		DisplayName: strings.TrimSpace(req.DisplayName),
+		Description: strings.TrimSpace(req.Description),
+	}
+	if err := h.roles.CloneTemplate(tenantID, req.Template, role); err != nil {
+		return roleStoreError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"data":    role,
+		"message": "Role cloned successfully",
+	})
+}
+
+// HasPermission checks if any role of a tenant user grants the permission
+func (h *RoleHandler) HasPermission(tenantID, userID, permission string) (bool, error) {
+	permissions, err := h.roles.GetUserPermissions(tenantID, userID)
+	if err != nil {
+		return false, err
+	}
+	return sharedmodels.PermissionsGrant(permissions, permission), nil
+}
+
+// roleTenant returns the tenant whose roles the request works on: the caller's own
+// tenant, or the tenant_id query parameter for system admins. Changes need a tenant or
+// system admin. It writes the error response when it fails.
+func (h *RoleHandler) roleTenant(c *fiber.Ctx, manage bool) (string, bool) {
+	claims := getClaims(c)
+
+	systemAdmin := isSystemAdmin(c, h.users)
+	if manage && !systemAdmin && !isTenantAdmin(c, h.users) {
+		forbidden(c, "Tenant administrator access required")
+		return "", false
+	}
+
+	tenantID := claims.TenantID
+	if systemAdmin {
+		tenantID = c.Query("tenant_id")
+	}
+	if tenantID == "" || tenantID == store.SystemTenantSlug {
+		c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Tenant required",
+			Code:    "TENANT_REQUIRED",
+			Message: "System administrators must select a tenant with tenant_id",
+		})
+		return "", false
+	}
+	return tenantID, true
+}
+
+// roleIDParam returns the :id parameter when it is a UUID. It writes the error response otherwise.
+func roleIDParam(c *fiber.Ctx) (string, bool) {
+	id := c.Params("id")
+	if _, err := uuid.Parse(id); err != nil {
+		c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid role ID",
+			Code:    "INVALID_ID",
+			Message: "Role ID must be a valid UUID",
+		})
+		return "", false
+	}
+	return id, true
+}
+
+// tenantHasRole reports whether a tenant has a role of the given name
+func tenantHasRole(roles store.RoleStore, tenantID, name string) (bool, error) {
+	tenantRoles, err := roles.ListRoles(tenantID)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range tenantRoles {
+		if role.Name == name {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// roleStoreError converts role store errors into responses
+func roleStoreError(c *fiber.Ctx, err error) error {
+	switch err {
+	case store.ErrRoleNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Role not found",
+			Code:    "ROLE_NOT_FOUND",
+			Message: "Role does not exist in this tenant",
+		})
+	case store.ErrPermissionNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Permission not found",
+			Code:    "PERMISSION_NOT_FOUND",
+			Message: "Permission does not exist",
+		})
+	case store.ErrUserNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "User not found",
+			Code:    "USER_NOT_FOUND",
+			Message: "User does not exist in this tenant",
+		})
+	case store.ErrTenantNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Tenant not found",
+			Code:    "TENANT_NOT_FOUND",
+			Message: "Tenant does not exist",
+		})
+	case store.ErrTemplateNotFound:
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Role template not found",
+			Code:    "TEMPLATE_NOT_FOUND",
+			Message: "Role template does not exist",
+		})
+	case store.ErrRoleExists:
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "Role already exists",
+			Code:    "ROLE_EXISTS",
+			Message: "A role with this name already exists",
+		})
+	case store.ErrPermissionExists:
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "Permission already exists",
+			Code:    "PERMISSION_EXISTS",
+			Message: "A permission with this name already exists",
+		})
+	case store.ErrReservedRole:
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Role name is reserved",
+			Code:    "ROLE_NAME_RESERVED",
+			Message: "The name belongs to a system role",
+		})
+	case store.ErrSystemRole:
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Cannot delete system role",
+			Code:    "SYSTEM_ROLE_PROTECTED",
+			Message: "System roles cannot be deleted",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Error:   "Role operation failed",
+		Code:    "SERVER_ERROR",
+		Message: err.Error(),
+	})
+}
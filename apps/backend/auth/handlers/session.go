@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// RequireAuth validates the bearer token and stores its claims for the next handlers
+func (h *AuthHandler) RequireAuth(c *fiber.Ctx) error {
+	parts := strings.Split(c.Get("Authorization"), " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Error:   "Authorization header required",
+			Code:    "AUTH_REQUIRED",
+			Message: "Please provide a valid authorization token",
+		})
+	}
+
+	claims, err := h.tokenManager.ValidateToken(parts[1])
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Error:   "Invalid token",
+			Code:    "INVALID_TOKEN",
+			Message: "Token is invalid or expired",
+		})
+	}
+
+	c.Locals("claims", claims)
+	if claims.IsImpersonation() {
+		return h.auditImpersonatedRequest(c, claims)
+	}
+	return c.Next()
+}
+
+// RequireSystemAdmin only lets system administrators through. It must run after RequireAuth.
+func (h *AuthHandler) RequireSystemAdmin(c *fiber.Ctx) error {
+	if !isSystemAdmin(c, h.users) {
+		return forbidden(c, "System administrator access required")
+	}
+	return c.Next()
+}
+
+// GetMySessions lists the sessions of the authenticated user
+func (h *AuthHandler) GetMySessions(c *fiber.Ctx) error {
+	claims := getClaims(c)
+
+	sessions, err := h.tokenManager.GetUserSessions(claims.UserID)
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	result := make([]models.SessionInfo, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, models.SessionInfo{
+			ID:        session.ID,
+			IPAddress: session.IPAddress,
+			UserAgent: session.UserAgent,
+			CreatedAt: session.CreatedAt,
+			LastSeen:  session.LastSeen,
+			Current:   session.TokenID == claims.TokenID,
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"sessions": result,
+		"count":    len(result),
+	})
+}
+
+// RevokeMySession logs out one of the authenticated user's sessions
+func (h *AuthHandler) RevokeMySession(c *fiber.Ctx) error {
+	claims := getClaims(c)
+
+	session, err := h.tokenManager.GetUserSession(claims.UserID, c.Params("id"))
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	if err := h.tokenManager.RevokeSession(session); err != nil {
+		return sessionError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Session revoked",
+	})
+}
+
+// RevokeOtherSessions logs out every session of the authenticated user except the current one
+func (h *AuthHandler) RevokeOtherSessions(c *fiber.Ctx) error {
+	claims := getClaims(c)
+
+	sessions, err := h.tokenManager.GetUserSessions(claims.UserID)
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	others := make([]*auth.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.TokenID != claims.TokenID {
+			others = append(others, session)
+		}
+	}
+
+	revoked, err := h.tokenManager.RevokeSessions(others)
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"revoked": revoked,
+	})
+}
+
+// ForceLogoutUser revokes every session of a user. Tenant admins may only log out
+// users of their own tenant, system admins any user.
+func (h *AuthHandler) ForceLogoutUser(c *fiber.Ctx) error {
+	claims := getClaims(c)
+	userID := c.Params("id")
+
+	systemAdmin := isSystemAdmin(c, h.users)
+	if !systemAdmin && !isTenantAdmin(c, h.users) {
+		return forbidden(c, "Tenant administrator access required")
+	}
+
+	if !systemAdmin {
+		if _, err := h.users.GetUser(claims.TenantID, userID); err != nil {
+			if err == store.ErrUserNotFound || err == store.ErrTenantNotFound {
+				return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+					Error:   "User not found",
+					Code:    "USER_NOT_FOUND",
+					Message: "User does not exist in your organization",
+				})
+			}
+			return sessionError(c, err)
+		}
+	}
+
+	sessions, err := h.tokenManager.GetUserSessions(userID)
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	targets := make([]*auth.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if systemAdmin || session.TenantID == claims.TenantID {
+			targets = append(targets, session)
+		}
+	}
+
+	revoked, err := h.tokenManager.RevokeSessions(targets)
+	if err != nil {
+		return sessionError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"revoked": revoked,
+	})
+}
+
+// getClaims returns the claims stored by RequireAuth
+func getClaims(c *fiber.Ctx) *auth.Claims {
+	if claims, ok := c.Locals("claims").(*auth.Claims); ok {
+		return claims
+	}
+	return &auth.Claims{}
+}
+
+// sessionError converts session errors into responses
+func sessionError(c *fiber.Ctx, err error) error {
+	if err == auth.ErrSessionNotFound {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Session not found",
+			Code:    "SESSION_NOT_FOUND",
+			Message: "Session does not exist or has already ended",
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+		Error:   "Session operation failed",
+		Code:    "SERVER_ERROR",
+		Message: err.Error(),
+	})
+}
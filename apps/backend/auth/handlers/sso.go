@@ -0,0 +1,637 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/sso"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// One-time token purposes of single sign-on
+const (
+	purposeSSOState = "sso_state" // Login sent to the IdP, carried in state or RelayState
+	purposeSSOLogin = "sso_login" // IdP login verified, redeemed by the web app at /sso/token
+)
+
+const (
+	ssoStateTTL = 10 * time.Minute
+	ssoLoginTTL = time.Minute
+
+	// maskedSecret replaces the OIDC client secret in responses. Sending it back keeps the stored secret.
+	maskedSecret = "********"
+)
+
+// Errors of provisionUser
+var errSSOAccountDisabled = errors.New("account disabled")
+
+// ssoState is the payload of SSO state tokens
+type ssoState struct {
+	TenantID   string `json:"tenant_id"`
+	TenantSlug string `json:"tenant_slug"`
+	Protocol   string `json:"protocol"`
+	Verifier   string `json:"verifier,omitempty"`   // OIDC PKCE code verifier
+	Nonce      string `json:"nonce,omitempty"`      // OIDC ID token nonce
+	RequestID  string `json:"request_id,omitempty"` // SAML AuthnRequest ID
+}
+
+// SSOHandler handles single sign-on through the OIDC or SAML identity provider of a tenant.
+// Users are provisioned on their first login with roles mapped from their IdP groups.
+type SSOHandler struct {
+	authHandler *AuthHandler
+	roles       store.RoleStore
+	baseURL     string // Public URL of the auth service, used in callback URLs
+	appURL      string // Web app receiving the login code
+
+	providers map[string]*sso.OIDCProvider // By issuer
+	mutex     sync.Mutex
+}
+
+// NewSSOHandler creates a new single sign-on handler issuing logins through authHandler
+func NewSSOHandler(authHandler *AuthHandler, roles store.RoleStore, baseURL, appURL string) *SSOHandler {
+	return &SSOHandler{
+		authHandler: authHandler,
+		roles:       roles,
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		appURL:      appURL,
+		providers:   make(map[string]*sso.OIDCProvider),
+	}
+}
+
+// Login redirects to the tenant's identity provider
+func (h *SSOHandler) Login(c *fiber.Ctx) error {
+	tenantSlug := strings.ToLower(c.Params("tenant"))
+	tenantID, config, err := h.tenantConfig(tenantSlug)
+	if err != nil {
+		return ssoError(c, err)
+	}
+
+	state := ssoState{TenantID: tenantID, TenantSlug: tenantSlug, Protocol: config.Protocol}
+	if config.Protocol == sso.ProtocolOIDC {
+		if state.Verifier, err = sso.RandomString(); err == nil {
+			state.Nonce, err = sso.RandomString()
+		}
+	} else {
+		state.RequestID, err = sso.RandomString()
+		state.RequestID = "_" + state.RequestID // XML IDs cannot start with a digit
+	}
+	if err != nil {
+		return ssoError(c, err)
+	}
+
+	token, err := h.authHandler.tokenManager.IssueOneTimeToken(purposeSSOState, state, ssoStateTTL)
+	if err != nil {
+		return ssoError(c, err)
+	}
+
+	var redirect string
+	if config.Protocol == sso.ProtocolOIDC {
+		redirect, err = h.oidcProvider(config.OIDC.Issuer).AuthCodeURL(config.OIDC, h.oidcRedirectURL(tenantSlug), token, state.Nonce, state.Verifier)
+	} else {
+		redirect, err = h.samlProvider(config.SAML, tenantSlug).AuthnRequestURL(state.RequestID, token)
+	}
+	if err != nil {
+		log.Printf("failed to start SSO login for tenant %s: %v", tenantSlug, err)
+		return c.Status(fiber.StatusBadGateway).JSON(models.ErrorResponse{
+			Error:   "Single sign-on failed",
+			Code:    "SSO_ERROR",
+			Message: "Unable to reach the identity provider",
+		})
+	}
+	return c.Redirect(redirect)
+}
+
+// OIDCCallback completes an OIDC login with the authorization code
+func (h *SSOHandler) OIDCCallback(c *fiber.Ctx) error {
+	state, config, ok := h.callbackState(c, c.Query("state"), sso.ProtocolOIDC)
+	if !ok {
+		return nil
+	}
+	if c.Query("error") != "" || c.Query("code") == "" {
+		log.Printf("OIDC login for tenant %s failed at the identity provider: %s", state.TenantSlug, c.Query("error"))
+		return h.callbackError(c, "SSO_FAILED")
+	}
+
+	identity, err := h.oidcProvider(config.OIDC.Issuer).Exchange(config.OIDC, h.oidcRedirectURL(state.TenantSlug), c.Query("code"), state.Verifier, state.Nonce)
+	if err != nil {
+		log.Printf("OIDC login for tenant %s rejected: %v", state.TenantSlug, err)
+		return h.callbackError(c, "SSO_FAILED")
+	}
+	return h.completeLogin(c, state, config, identity)
+}
+
+// SAMLACS completes a SAML login with the response posted by the IdP
+func (h *SSOHandler) SAMLACS(c *fiber.Ctx) error {
+	state, config, ok := h.callbackState(c, c.FormValue("RelayState"), sso.ProtocolSAML)
+	if !ok {
+		return nil
+	}
+
+	identity, err := h.samlProvider(config.SAML, state.TenantSlug).ParseResponse(c.FormValue("SAMLResponse"), state.RequestID)
+	if err != nil {
+		log.Printf("SAML login for tenant %s rejected: %v", state.TenantSlug, err)
+		return h.callbackError(c, "SSO_FAILED")
+	}
+	return h.completeLogin(c, state, config, identity)
+}
+
+// SAMLMetadata returns the service provider metadata of a tenant
+func (h *SSOHandler) SAMLMetadata(c *fiber.Ctx) error {
+	tenantSlug := strings.ToLower(c.Params("tenant"))
+	if _, err := h.authHandler.users.TenantID(tenantSlug); err != nil {
+		return ssoError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "application/samlmetadata+xml")
+	return c.Send(h.samlProvider(&sso.SAMLConfig{}, tenantSlug).Metadata())
+}
+
+// Token exchanges the code of a completed SSO login for access and refresh tokens
+func (h *SSOHandler) Token(c *fiber.Ctx) error {
+	var req models.SSOTokenRequest
+	if err := c.BodyParser(&req); err != nil || req.Code == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "code is required",
+		})
+	}
+
+	var login mfaLogin
+	if err := h.authHandler.tokenManager.ConsumeOneTimeToken(purposeSSOLogin, req.Code, &login); err != nil {
+		return invalidTokenError(c, err, "INVALID_SSO_CODE")
+	}
+
+	user, err := h.authHandler.users.GetUser(login.TenantID, login.UserID)
+	if err != nil {
+		return ssoError(c, err)
+	}
+	if user.Status != "active" {
+		return ssoError(c, errSSOAccountDisabled)
+	}
+
+	response, err := h.authHandler.newLogin(c, user, login.Account)
+	if err != nil {
+		return loginError(c, err)
+	}
+	return c.JSON(response)
+}
+
+// GetConfig returns the SSO configuration of the tenant (tenant admins)
+func (h *SSOHandler) GetConfig(c *fiber.Ctx) error {
+	tenantID, tenantSlug, ok := h.adminTenant(c)
+	if !ok {
+		return nil
+	}
+
+	settings, err := h.authHandler.users.TenantSettings(tenantID)
+	if err != nil {
+		return ssoError(c, err)
+	}
+	config, err := sso.ConfigFromSettings(settings)
+	if err == sso.ErrNotConfigured {
+		config, err = &sso.Config{}, nil
+	}
+	if err != nil {
+		return ssoError(c, err)
+	}
+
+	if config.OIDC != nil && config.OIDC.ClientSecret != "" {
+		config.OIDC.ClientSecret = maskedSecret
+	}
+	return c.JSON(h.configResponse(tenantSlug, config))
+}
+
+// UpdateConfig replaces the SSO configuration of the tenant (tenant admins)
+func (h *SSOHandler) UpdateConfig(c *fiber.Ctx) error {
+	tenantID, tenantSlug, ok := h.adminTenant(c)
+	if !ok {
+		return nil
+	}
+
+	var config sso.Config
+	if err := c.BodyParser(&config); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid request body",
+			Code:    "INVALID_REQUEST",
+			Message: "Please provide valid JSON data",
+		})
+	}
+	config.Protocol = strings.ToLower(config.Protocol)
+	if role := reservedSSORole(&config); role != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid SSO configuration",
+			Code:    "ROLE_NAME_RESERVED",
+			Message: fmt.Sprintf("Role %q cannot be granted through single sign-on", role),
+		})
+	}
+	if role, err := h.unknownSSORole(tenantID, &config); err != nil {
+		return ssoError(c, err)
+	} else if role != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "Invalid SSO configuration",
+			Code:    "INVALID_ROLE",
+			Message: fmt.Sprintf("Role %q does not exist in this organization", role),
+		})
+	}
+
+	if config.Enabled {
+		if err := config.Validate(); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Error:   "Invalid SSO configuration",
+				Code:    "VALIDATION_ERROR",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	// Keep the stored client secret unless a new one is given
+	if config.OIDC != nil && (config.OIDC.ClientSecret == "" || config.OIDC.ClientSecret == maskedSecret) {
+		config.OIDC.ClientSecret = ""
+		if settings, err := h.authHandler.users.TenantSettings(tenantID); err == nil {
+			if current, err := sso.ConfigFromSettings(settings); err == nil && current.OIDC != nil {
+				config.OIDC.ClientSecret = current.OIDC.ClientSecret
+			}
+		}
+	}
+
+	settings, err := config.Settings()
+	if err != nil {
+		return ssoError(c, err)
+	}
+	if err := h.authHandler.users.UpdateTenantSettings(tenantID, settings); err != nil {
+		return ssoError(c, err)
+	}
+
+	if config.OIDC != nil && config.OIDC.ClientSecret != "" {
+		config.OIDC.ClientSecret = maskedSecret
+	}
+	return c.JSON(h.configResponse(tenantSlug, &config))
+}
+
+// ssoEnforced reports whether the tenant only allows logins through its identity provider
+func (h *AuthHandler) ssoEnforced(tenantSlug string) (bool, error) {
+	if tenantSlug == store.SystemTenantSlug {
+		return false, nil
+	}
+
+	tenantID, err := h.users.TenantID(tenantSlug)
+	if err != nil {
+		if err == store.ErrTenantNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	settings, err := h.users.TenantSettings(tenantID)
+	if err != nil {
+		return false, err
+	}
+
+	config, err := sso.ConfigFromSettings(settings)
+	if err == sso.ErrNotConfigured {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return config.Enabled && config.Enforced, nil
+}
+
+// hasRole reports whether a user has a role
+func hasRole(user *models.User, role string) bool {
+	for _, name := range user.Roles {
+		if name == role {
+			return true
+		}
+	}
+	return false
+}
+
+// ssoRoleAllowed reports whether the IdP may grant a role. System and tenant administrator
+// access is never granted through group mappings.
+func ssoRoleAllowed(role string) bool {
+	role = strings.ToLower(strings.TrimSpace(role))
+	return role != store.SystemAdminRole && role != "tenant_admin"
+}
+
+// reservedSSORole returns the first role of a configuration the IdP may not grant, or ""
+func reservedSSORole(config *sso.Config) string {
+	if config.DefaultRole != "" && !ssoRoleAllowed(config.DefaultRole) {
+		return config.DefaultRole
+	}
+	for _, role := range config.GroupRoles {
+		if !ssoRoleAllowed(role) {
+			return role
+		}
+	}
+	return ""
+}
+
+// unknownSSORole returns the first role of a configuration the tenant does not have, or ""
+func (h *SSOHandler) unknownSSORole(tenantID string, config *sso.Config) (string, error) {
+	roles := make([]string, 0, len(config.GroupRoles)+1)
+	if config.DefaultRole != "" {
+		roles = append(roles, config.DefaultRole)
+	}
+	for _, role := range config.GroupRoles {
+		roles = append(roles, role)
+	}
+
+	for _, role := range roles {
+		exists, err := tenantHasRole(h.roles, tenantID, role)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return role, nil
+		}
+	}
+	return "", nil
+}
+
+// completeLogin provisions the user of a verified identity and redirects to the web app
+// with a short-lived code for /sso/token
+func (h *SSOHandler) completeLogin(c *fiber.Ctx, state *ssoState, config *sso.Config, identity *sso.Identity) error {
+	user, err := h.provisionUser(state, config, identity)
+	switch err {
+	case nil:
+	case sso.ErrNoRole:
+		return h.callbackError(c, "SSO_NO_ROLE")
+	case store.ErrRoleNotFound:
+		log.Printf("SSO group mapping of tenant %s names a role the tenant no longer has", state.TenantSlug)
+		return h.callbackError(c, "SSO_NO_ROLE")
+	case errSSOAccountDisabled:
+		return h.callbackError(c, "ACCOUNT_DISABLED")
+	default:
+		log.Printf("failed to provision SSO user %s in tenant %s: %v", identity.Email, state.TenantSlug, err)
+		return h.callbackError(c, "SERVER_ERROR")
+	}
+
+	code, err := h.authHandler.tokenManager.IssueOneTimeToken(purposeSSOLogin, mfaLogin{
+		TenantID: user.TenantID,
+		UserID:   user.ID,
+		Account:  auth.LoginAccount(state.TenantSlug, user.Email),
+	}, ssoLoginTTL)
+	if err != nil {
+		return h.callbackError(c, "SERVER_ERROR")
+	}
+	return c.Redirect(appLink(h.appURL, "/sso/callback", "code", code))
+}
+
+// provisionUser returns the tenant user of an identity, creating it on first login. Roles
+// are synchronized with the IdP groups whenever a group maps to a role.
+func (h *SSOHandler) provisionUser(state *ssoState, config *sso.Config, identity *sso.Identity) (*models.User, error) {
+	users := h.authHandler.users
+	email := strings.ToLower(strings.TrimSpace(identity.Email))
+	roles := make([]string, 0)
+	for _, role := range config.Roles(identity.Groups) {
+		if ssoRoleAllowed(role) {
+			roles = append(roles, role)
+		}
+	}
+
+	user, err := users.FindUser(state.TenantSlug, email)
+	if err == store.ErrUserNotFound {
+		if len(roles) == 0 {
+			return nil, sso.ErrNoRole
+		}
+
+		// The password is never used, SSO users log in through the IdP
+		password, err := sso.RandomString()
+		if err != nil {
+			return nil, err
+		}
+		user, err = users.CreateUser(state.TenantID, store.NewUser{
+			Email:     email,
+			Password:  password,
+			FirstName: identity.FirstName,
+			LastName:  identity.LastName,
+			Role:      roles[0],
+			Status:    "active",
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(roles) > 1 {
+			if err := users.SetUserRoles(user.TenantID, user.ID, roles); err != nil {
+				return nil, err
+			}
+			user.Roles = roles
+		}
+		return user, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch user.Status {
+	case "active":
+	case "pending":
+		// The IdP vouches for the email address
+		if err := users.SetUserStatus(user.TenantID, user.ID, "active"); err != nil {
+			return nil, err
+		}
+		user.Status = "active"
+	default:
+		return nil, errSSOAccountDisabled
+	}
+
+	roles = syncedSSORoles(config, identity.Groups, user.Roles)
+	if len(roles) == 0 {
+		return nil, sso.ErrNoRole
+	}
+	if !sameRoles(roles, user.Roles) {
+		if err := users.SetUserRoles(user.TenantID, user.ID, roles); err != nil {
+			return nil, err
+		}
+		user.Roles = roles
+	}
+	return user, nil
+}
+
+// syncedSSORoles returns the roles of an existing user after a login through the IdP.
+// Roles of the group mapping follow the user's groups. Other roles, such as tenant_admin
+// or roles assigned by hand, are kept.
+func syncedSSORoles(config *sso.Config, groups, current []string) []string {
+	mapped := make(map[string]bool)
+	for _, role := range config.GroupRoles {
+		if ssoRoleAllowed(role) {
+			mapped[role] = true
+		}
+	}
+
+	roles := make([]string, 0, len(current))
+	seen := make(map[string]bool)
+	for _, role := range current {
+		if !mapped[role] && !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+	if config.MappedRoles(groups) {
+		for _, role := range config.Roles(groups) {
+			if ssoRoleAllowed(role) && !seen[role] {
+				seen[role] = true
+				roles = append(roles, role)
+			}
+		}
+	}
+	return roles
+}
+
+// sameRoles reports whether two lists hold the same roles in any order
+func sameRoles(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	names := make(map[string]bool, len(a))
+	for _, role := range a {
+		names[role] = true
+	}
+	for _, role := range b {
+		if !names[role] {
+			return false
+		}
+	}
+	return true
+}
+
+// tenantConfig returns the ID and the enabled SSO configuration of a tenant
+func (h *SSOHandler) tenantConfig(tenantSlug string) (string, *sso.Config, error) {
+	if tenantSlug == store.SystemTenantSlug {
+		return "", nil, sso.ErrNotConfigured
+	}
+
+	tenantID, err := h.authHandler.users.TenantID(tenantSlug)
+	if err != nil {
+		return "", nil, err
+	}
+	settings, err := h.authHandler.users.TenantSettings(tenantID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	config, err := sso.ConfigFromSettings(settings)
+	if err != nil {
+		return "", nil, err
+	}
+	if !config.Enabled || config.Validate() != nil {
+		return "", nil, sso.ErrNotConfigured
+	}
+	return tenantID, config, nil
+}
+
+// callbackState consumes the state of a login returning from the IdP and reloads the
+// tenant configuration. It redirects to the web app with an error when it fails.
+func (h *SSOHandler) callbackState(c *fiber.Ctx, token, protocol string) (*ssoState, *sso.Config, bool) {
+	var state ssoState
+	err := h.authHandler.tokenManager.ConsumeOneTimeToken(purposeSSOState, token, &state)
+	if err != nil || state.TenantSlug != strings.ToLower(c.Params("tenant")) || state.Protocol != protocol {
+		h.callbackError(c, "INVALID_SSO_STATE")
+		return nil, nil, false
+	}
+
+	tenantID, config, err := h.tenantConfig(state.TenantSlug)
+	if err != nil || tenantID != state.TenantID || config.Protocol != protocol {
+		h.callbackError(c, "SSO_NOT_CONFIGURED")
+		return nil, nil, false
+	}
+	return &state, config, true
+}
+
+// callbackError sends the browser back to the web app with an error code
+func (h *SSOHandler) callbackError(c *fiber.Ctx, code string) error {
+	return c.Redirect(appLink(h.appURL, "/sso/callback", "error", code))
+}
+
+// adminTenant returns the tenant of the :tenant parameter when the caller administers it.
+// It writes the error response when it fails.
+func (h *SSOHandler) adminTenant(c *fiber.Ctx) (string, string, bool) {
+	claims := getClaims(c)
+	if !isTenantAdmin(c, h.authHandler.users) {
+		forbidden(c, "Tenant administrator access required")
+		return "", "", false
+	}
+
+	tenantSlug := strings.ToLower(c.Params("tenant"))
+	tenantID, err := h.authHandler.users.TenantID(tenantSlug)
+	if err != nil || tenantID != claims.TenantID {
+		c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Tenant not found",
+			Code:    "TENANT_NOT_FOUND",
+			Message: "Tenant does not exist or is not yours",
+		})
+		return "", "", false
+	}
+	return tenantID, tenantSlug, true
+}
+
+// configResponse adds the URLs to register at the IdP to a configuration
+func (h *SSOHandler) configResponse(tenantSlug string, config *sso.Config) models.SSOConfigResponse {
+	return models.SSOConfigResponse{
+		Config:          config,
+		LoginURL:        h.tenantURL(tenantSlug, "/login"),
+		OIDCRedirectURL: h.oidcRedirectURL(tenantSlug),
+		SAMLEntityID:    h.tenantURL(tenantSlug, "/saml/metadata"),
+		SAMLACSURL:      h.tenantURL(tenantSlug, "/saml/acs"),
+	}
+}
+
+// oidcProvider returns the cached provider of an issuer
+func (h *SSOHandler) oidcProvider(issuer string) *sso.OIDCProvider {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	provider, exists := h.providers[issuer]
+	if !exists {
+		provider = sso.NewOIDCProvider(issuer)
+		h.providers[issuer] = provider
+	}
+	return provider
+}
+
+// samlProvider returns the service provider of a tenant for its IdP configuration
+func (h *SSOHandler) samlProvider(config *sso.SAMLConfig, tenantSlug string) *sso.SAMLServiceProvider {
+	return sso.NewSAMLServiceProvider(config, h.tenantURL(tenantSlug, "/saml/metadata"), h.tenantURL(tenantSlug, "/saml/acs"))
+}
+
+// oidcRedirectURL returns the OIDC redirect URI of a tenant
+func (h *SSOHandler) oidcRedirectURL(tenantSlug string) string {
+	return h.tenantURL(tenantSlug, "/oidc/callback")
+}
+
+// tenantURL returns a public SSO URL of a tenant
+func (h *SSOHandler) tenantURL(tenantSlug, path string) string {
+	return h.baseURL + "/sso/" + tenantSlug + path
+}
+
+// ssoError converts single sign-on errors into responses
+func ssoError(c *fiber.Ctx, err error) error {
+	switch err {
+	case store.ErrTenantNotFound, store.ErrTenantInactive, sso.ErrNotConfigured:
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "Single sign-on not available",
+			Code:    "SSO_NOT_CONFIGURED",
+			Message: "Single sign-on is not configured for this organization",
+		})
+	case store.ErrUserNotFound, errSSOAccountDisabled:
+		return c.Status(fiber.StatusForbidden).JSON(models.ErrorResponse{
+			Error:   "Account disabled",
+			Code:    "ACCOUNT_DISABLED",
+			Message: "Your account has been disabled. Please contact support",
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "Single sign-on failed",
+			Code:    "SSO_ERROR",
+			Message: "Unable to complete single sign-on",
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/mailer"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	sharedmodels "github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// memoryAuditLog keeps audit log entries in memory
+type memoryAuditLog struct {
+	entries []*sharedmodels.AuditLog
+}
+
+func (l *memoryAuditLog) Record(entry *sharedmodels.AuditLog) error {
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func setupImpersonationTestApp(audit handlers.AuditRecorder) (*fiber.App, *auth.TokenManager) {
+	tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
+	authHandler := handlers.NewAuthHandler(newTestUserStore(), tokenManager)
+	if audit != nil {
+		authHandler.SetAuditRecorder(audit)
+	}
+
+	app := fiber.New()
+	app.Post("/login", authHandler.Login)
+	app.Post("/impersonate", authHandler.RequireAuth, authHandler.RequireSystemAdmin, authHandler.Impersonate)
+	app.Get("/me/sessions", authHandler.RequireAuth, authHandler.GetMySessions)
+	app.Post("/mfa/recovery-codes", authHandler.RequireAuth, authHandler.RejectImpersonation, authHandler.RegenerateRecoveryCodes)
+	return app, tokenManager
+}
+
+func impersonate(t *testing.T, app *fiber.App, token string, req models.ImpersonateRequest) (int, models.ImpersonationResponse) {
+	body, _ := json.Marshal(req)
+	httpReq, _ := http.NewRequest("POST", "/impersonate", bytes.NewBuffer(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(httpReq, 5000)
+	if err != nil {
+		t.Fatalf("Impersonation request failed: %v", err)
+	}
+
+	var result models.ImpersonationResponse
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestImpersonation(t *testing.T) {
+	audit := &memoryAuditLog{}
+	app, tokenManager := setupImpersonationTestApp(audit)
+	target := models.ImpersonateRequest{TenantID: "demo-corp", UserID: "customer-1", Reason: "TICKET-42"}
+
+	// Only system admins may impersonate
+	tenantAdmin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+	if status, _ := impersonate(t, app, tenantAdmin, target); status != 403 {
+		t.Fatalf("Expected 403 for a tenant admin, got %d", status)
+	}
+
+	systemAdmin := loginAs(t, app, "admin@zplus.com", "admin123", "system", "laptop")
+	if status, _ := impersonate(t, app, systemAdmin, models.ImpersonateRequest{TenantID: "demo-corp", UserID: "customer-1"}); status != 400 {
+		t.Fatalf("Expected 400 without a reason, got %d", status)
+	}
+	if status, _ := impersonate(t, app, systemAdmin, models.ImpersonateRequest{TenantID: "system", UserID: "sys-admin-1", Reason: "x"}); status != 403 {
+		t.Fatalf("Expected 403 for a system user target, got %d", status)
+	}
+
+	status, result := impersonate(t, app, systemAdmin, target)
+	if status != 200 || result.Token == "" || result.User.ID != "customer-1" || result.ImpersonatorID != "sys-admin-1" {
+		t.Fatalf("Expected an impersonation token for customer-1, got %d %+v", status, result)
+	}
+	if result.ExpiresIn <= 0 || result.ExpiresIn > int(auth.DefaultImpersonationTTL.Seconds()) {
+		t.Fatalf("Expected a short-lived token, got %d seconds", result.ExpiresIn)
+	}
+
+	// The token carries both identities
+	claims, err := tokenManager.ValidateToken(result.Token)
+	if err != nil || claims.UserID != "customer-1" || claims.TenantID != "demo-corp" || claims.ImpersonatorID != "sys-admin-1" {
+		t.Fatalf("Expected impersonation claims, got %+v (%v)", claims, err)
+	}
+	if len(audit.entries) != 1 || audit.entries[0].Action != sharedmodels.AuditImpersonationStarted || audit.entries[0].Details["reason"] != "TICKET-42" {
+		t.Fatalf("Expected the impersonation to be audited with its reason, got %+v", audit.entries)
+	}
+
+	// Requests are audited and sensitive actions are refused
+	if status, body := sessionRequest(t, app, "GET", "/me/sessions", result.Token); status != 200 || body["count"].(float64) != 1 {
+		t.Fatalf("Expected the impersonated session to be listed, got %d %v", status, body)
+	}
+	if status, body := sessionRequest(t, app, "POST", "/mfa/recovery-codes", result.Token); status != 403 || body["code"] != "IMPERSONATION_FORBIDDEN" {
+		t.Fatalf("Expected 403 IMPERSONATION_FORBIDDEN, got %d %v", status, body)
+	}
+	if status, _ := impersonate(t, app, result.Token, target); status != 403 {
+		t.Fatalf("Expected 403 when impersonating with an impersonation token, got %d", status)
+	}
+
+	actions := make([]string, 0, len(audit.entries))
+	for _, entry := range audit.entries {
+		actions = append(actions, entry.Action)
+	}
+	expected := []string{
+		sharedmodels.AuditImpersonationStarted,
+		sharedmodels.AuditImpersonatedRequest,
+		sharedmodels.AuditImpersonationDenied,
+		sharedmodels.AuditImpersonatedRequest,
+	}
+	if len(actions) != len(expected) {
+		t.Fatalf("Expected audit entries %v, got %v", expected, actions)
+	}
+	for i := range expected {
+		if actions[i] != expected[i] {
+			t.Fatalf("Expected audit entries %v, got %v", expected, actions)
+		}
+	}
+	if entry := audit.entries[2]; entry.Path != "/mfa/recovery-codes" || entry.Status != 403 || entry.TokenID != claims.TokenID {
+		t.Fatalf("Expected the denied request to be recorded, got %+v", entry)
+	}
+
+	t.Log("✓ Audited impersonation by system admins")
+}
+
+func TestImpersonationRequiresAuditLog(t *testing.T) {
+	app, _ := setupImpersonationTestApp(nil)
+
+	systemAdmin := loginAs(t, app, "admin@zplus.com", "admin123", "system", "laptop")
+	status, _ := impersonate(t, app, systemAdmin, models.ImpersonateRequest{TenantID: "demo-corp", UserID: "customer-1", Reason: "TICKET-42"})
+	if status != 503 {
+		t.Fatalf("Expected 503 without an audit log, got %d", status)
+	}
+
+	t.Log("✓ Impersonation refused without an audit log")
+}
+
+func TestImpersonationRejectedOnSensitiveRoutes(t *testing.T) {
+	users := newTestUserStore()
+	tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
+	authHandler := handlers.NewAuthHandler(users, tokenManager)
+	authHandler.SetAuditRecorder(&memoryAuditLog{})
+
+	app := fiber.New()
+	registerRoutes(app, routeHandlers{
+		auth:         authHandler,
+		registration: handlers.NewRegistrationHandler(users, newTestRoleStore(), tokenManager, mailer.NewMemoryMailer(), "http://localhost:3000"),
+		password:     handlers.NewPasswordResetHandler(users, tokenManager, mailer.NewMemoryMailer(), "http://localhost:3000"),
+		roles:        handlers.NewRoleHandler(newTestRoleStore(), users),
+		sso:          handlers.NewSSOHandler(authHandler, newTestRoleStore(), ssoTestBaseURL, ssoTestAppURL),
+	})
+
+	// Support staff acting as the tenant admin cannot use the admin's privileges to
+	// change credentials, sessions, roles, SSO or other users
+	systemAdmin := loginAs(t, app, "admin@zplus.com", "admin123", "system", "laptop")
+	status, result := impersonate(t, app, systemAdmin, models.ImpersonateRequest{TenantID: "demo-corp", UserID: "tenant-admin-1", Reason: "TICKET-7"})
+	if status != 200 {
+		t.Fatalf("Expected an impersonation token, got %d", status)
+	}
+	for _, route := range [][2]string{
+		{"POST", "/mfa/enroll"},
+		{"POST", "/mfa/disable"},
+		{"POST", "/mfa/recovery-codes"},
+		{"PUT", "/sso/demo-corp/config"},
+		{"DELETE", "/me/sessions"},
+		{"DELETE", "/me/sessions/other"},
+		{"DELETE", "/users/customer-1/sessions"},
+		{"POST", "/users/unlock"},
+		{"POST", "/roles"},
+		{"PUT", "/roles/some-role"},
+		{"DELETE", "/roles/some-role"},
+		{"PUT", "/role-templates/user"},
+		{"POST", "/roles/clone"},
+		{"POST", "/permissions"},
+		{"POST", "/roles/permissions"},
+		{"POST", "/users/roles"},
+		{"POST", "/invitations"},
+	} {
+		if status, body := sessionRequest(t, app, route[0], route[1], result.Token); status != 403 || body["code"] != "IMPERSONATION_FORBIDDEN" {
+			t.Errorf("Expected %s %s to refuse impersonation, got %d %v", route[0], route[1], status, body)
+		}
+	}
+
+	// Reading stays available to support staff
+	if status, _ := sessionRequest(t, app, "GET", "/roles", result.Token); status != 200 {
+		t.Fatalf("Expected roles to be readable while impersonating, got %d", status)
+	}
+
+	t.Log("✓ Impersonation tokens refused on credential, session, role, SSO and user management routes")
+}
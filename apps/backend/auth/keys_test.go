@@ -0,0 +1,259 @@
+package main
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// newJWKSServer serves the key manager's JWKS like the auth service does
+func newJWKSServer(keyManager *auth.KeyManager) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(keyManager.JWKS())
+	}))
+}
+
+func TestAsymmetricTokensVerifiedThroughJWKS(t *testing.T) {
+	for _, algorithm := range []string{auth.AlgorithmRS256, auth.AlgorithmEdDSA} {
+		keyManager := auth.NewKeyManager(time.Hour)
+		if _, err := keyManager.Rotate(algorithm); err != nil {
+			t.Fatalf("%s: failed to generate key: %v", algorithm, err)
+		}
+		issuer := auth.NewKeyedTokenManager(keyManager, "zplus-saas")
+
+		server := newJWKSServer(keyManager)
+		defer server.Close()
+		verifier := auth.NewVerifyingTokenManager(auth.NewRemoteKeySet(server.URL, time.Minute), "zplus-saas")
+
+		token, err := issuer.GenerateToken("user-1", "demo-corp", "tenant_admin")
+		if err != nil {
+			t.Fatalf("%s: failed to generate token: %v", algorithm, err)
+		}
+
+		parsed, _, _ := new(jwt.Parser).ParseUnverified(token, &auth.Claims{})
+		if parsed.Header["alg"] != algorithm || parsed.Header["kid"] == nil {
+			t.Fatalf("%s: expected alg and kid headers, got %v", algorithm, parsed.Header)
+		}
+
+		claims, err := verifier.ValidateToken(token)
+		if err != nil {
+			t.Fatalf("%s: failed to verify token through JWKS: %v", algorithm, err)
+		}
+		if claims.UserID != "user-1" {
+			t.Fatalf("%s: expected user-1, got %s", algorithm, claims.UserID)
+		}
+
+		if _, err := verifier.GenerateToken("user-1", "demo-corp", "user"); err != auth.ErrNoSigningKey {
+			t.Fatalf("%s: expected verify-only manager to refuse signing, got %v", algorithm, err)
+		}
+	}
+
+	t.Log("✓ RS256 and EdDSA tokens verified with JWKS keys")
+}
+
+func TestKeyRotationKeepsLiveTokensValid(t *testing.T) {
+	keyManager := auth.NewKeyManager(time.Hour)
+	keyManager.Rotate(auth.AlgorithmRS256)
+	issuer := auth.NewKeyedTokenManager(keyManager, "zplus-saas")
+
+	server := newJWKSServer(keyManager)
+	defer server.Close()
+	verifier := auth.NewVerifyingTokenManager(auth.NewRemoteKeySet(server.URL, time.Minute), "zplus-saas")
+
+	oldToken, _ := issuer.GenerateToken("user-1", "demo-corp", "user")
+	if _, err := verifier.ValidateToken(oldToken); err != nil {
+		t.Fatalf("Failed to verify token before rotation: %v", err)
+	}
+
+	if _, err := keyManager.Rotate(auth.AlgorithmRS256); err != nil {
+		t.Fatalf("Failed to rotate key: %v", err)
+	}
+	newToken, _ := issuer.GenerateToken("user-1", "demo-corp", "user")
+
+	// Previous, active and next key are published
+	if len(keyManager.JWKS().Keys) != 3 {
+		t.Fatalf("Expected 3 published keys after rotation, got %d", len(keyManager.JWKS().Keys))
+	}
+	if _, err := issuer.ValidateToken(oldToken); err != nil {
+		t.Fatalf("Token signed before rotation should stay valid: %v", err)
+	}
+
+	// The verifier already knows the new kid because it was published before activation
+	if _, err := verifier.ValidateToken(newToken); err != nil {
+		t.Fatalf("Token signed after rotation should be verified: %v", err)
+	}
+
+	t.Log("✓ Key rotation keeps live tokens valid")
+}
+
+func TestHMACTokensRejectedByKeyedManager(t *testing.T) {
+	keyManager := auth.NewKeyManager(time.Hour)
+	keyManager.Rotate(auth.AlgorithmRS256)
+	verifier := auth.NewKeyedTokenManager(keyManager, "zplus-saas")
+
+	hmacToken, _ := auth.NewTokenManager("your-secret-key", "zplus-saas").GenerateToken("user-1", "demo-corp", "system_admin")
+	if _, err := verifier.ValidateToken(hmacToken); err == nil {
+		t.Fatal("Expected HS256 token to be rejected")
+	}
+
+	t.Log("✓ HS256 tokens rejected by asymmetric token manager")
+}
+
+func TestJWKSEndpoint(t *testing.T) {
+	keyManager := auth.NewKeyManager(time.Hour)
+	keyManager.Rotate(auth.AlgorithmRS256)
+
+	app := fiber.New()
+	app.Get("/.well-known/jwks.json", func(c *fiber.Ctx) error {
+		return c.JSON(keyManager.JWKS())
+	})
+
+	req, _ := http.NewRequest("GET", "/.well-known/jwks.json", nil)
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("JWKS request failed: %v", err)
+	}
+
+	var set auth.JSONWebKeySet
+	json.NewDecoder(resp.Body).Decode(&set)
+	if len(set.Keys) != 2 || set.Keys[0].KeyType != "RSA" || set.Keys[0].KeyID == "" {
+		t.Fatalf("Unexpected JWKS: %+v", set)
+	}
+	if strings.Contains(set.Keys[0].N, "=") {
+		t.Fatal("Expected base64url encoding without padding")
+	}
+
+	t.Log("✓ JWKS endpoint publishes public keys")
+}
+
+func TestSigningKeysSharedAcrossReplicas(t *testing.T) {
+	store := newTestRedisStore(t, testRedisAddr(t))
+
+	// Replicas starting together agree on one signing key
+	replicas := []*auth.KeyManager{auth.NewKeyManager(time.Hour), auth.NewKeyManager(time.Hour)}
+	var wg sync.WaitGroup
+	for _, replica := range replicas {
+		replica.SetStore(store)
+		wg.Add(1)
+		go func(replica *auth.KeyManager) {
+			defer wg.Done()
+			if err := replica.RotateIfDue(auth.AlgorithmEdDSA, 0); err != nil {
+				t.Errorf("Failed to initialize signing keys: %v", err)
+			}
+		}(replica)
+	}
+	wg.Wait()
+
+	first, _ := replicas[0].ActiveKey()
+	second, _ := replicas[1].ActiveKey()
+	if first == nil || second == nil || first.ID != second.ID {
+		t.Fatal("Expected both replicas to sign with the same key")
+	}
+
+	// A rotation on one replica is picked up by the other instead of rotating again
+	rotated, err := replicas[0].Rotate(auth.AlgorithmEdDSA)
+	if err != nil {
+		t.Fatalf("Rotation failed: %v", err)
+	}
+	if err := replicas[1].RotateIfDue(auth.AlgorithmEdDSA, time.Hour); err != nil {
+		t.Fatalf("Failed to sync signing keys: %v", err)
+	}
+	if active, _ := replicas[1].ActiveKey(); active.ID != rotated.ID {
+		t.Fatalf("Expected the other replica to load key %s, got %s", rotated.ID, active.ID)
+	}
+
+	// Tokens signed before the rotation by one replica are verified by the other
+	issuer := auth.NewKeyedTokenManager(replicas[0], "zplus-saas")
+	verifier := auth.NewKeyedTokenManager(replicas[1], "zplus-saas")
+	token, _ := issuer.GenerateToken("user-1", "demo-corp", "user")
+	if _, err := verifier.ValidateToken(token); err != nil {
+		t.Fatalf("Expected the other replica to verify the token: %v", err)
+	}
+	if _, err := replicas[1].VerificationKey(first.ID); err != nil {
+		t.Fatalf("Expected the retired key to stay available: %v", err)
+	}
+
+	t.Log("✓ Signing keys are shared and rotated once across replicas")
+}
+
+// expiringLockStore lets the key ring lock expire and another replica take it while the
+// key ring is being saved
+type expiringLockStore struct {
+	auth.Store
+}
+
+func (s *expiringLockStore) Set(key string, value []byte, ttl time.Duration) error {
+	if key == "signing_keys" {
+		s.Store.Set("signing_keys:lock", []byte("other-replica"), time.Minute)
+	}
+	return s.Store.Set(key, value, ttl)
+}
+
+func TestKeyRingLockReleasedOnlyByItsHolder(t *testing.T) {
+	store := newTestRedisStore(t, testRedisAddr(t))
+	keyManager := auth.NewKeyManager(time.Hour)
+	keyManager.SetStore(&expiringLockStore{Store: store})
+
+	if _, err := keyManager.Rotate(auth.AlgorithmEdDSA); err != nil {
+		t.Fatalf("Rotation failed: %v", err)
+	}
+	if value, _ := store.Get("signing_keys:lock"); string(value) != "other-replica" {
+		t.Fatalf("Expected the lock of the other replica to be kept, got %q", value)
+	}
+
+	// Without another holder the lock is released
+	keyManager.SetStore(store)
+	store.Delete("signing_keys:lock")
+	if _, err := keyManager.Rotate(auth.AlgorithmEdDSA); err != nil {
+		t.Fatalf("Rotation failed: %v", err)
+	}
+	if _, err := store.Get("signing_keys:lock"); err != auth.ErrNotFound {
+		t.Fatalf("Expected the lock to be released, got %v", err)
+	}
+
+	t.Log("✓ The key ring lock is only released by the replica holding it")
+}
+
+func TestKeyRotationRequiresSharedKeys(t *testing.T) {
+	t.Setenv("JWT_SIGNING_ALGORITHM", auth.AlgorithmEdDSA)
+	t.Setenv("JWT_KEY_ROTATION_HOURS", "24")
+	store := auth.NewMemoryStore()
+
+	// Replicas rotating keys loaded from files or kept in memory would not agree on them
+	keyFile := t.TempDir() + "/signing.pem"
+	key, _ := auth.GenerateSigningKey(auth.AlgorithmEdDSA)
+	der, _ := x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+	os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0600)
+	t.Setenv("JWT_SIGNING_KEY_FILES", keyFile)
+	if _, err := initializeKeyManager(time.Hour, store); err == nil || !strings.Contains(err.Error(), "JWT_KEY_ROTATION_HOURS") {
+		t.Fatalf("Expected rotation with key files to be refused, got %v", err)
+	}
+	t.Setenv("JWT_SIGNING_KEY_FILES", "")
+	if _, err := initializeKeyManager(time.Hour, nil); err == nil {
+		t.Fatal("Expected rotation without a shared store to be refused")
+	}
+
+	// Key files without rotation and rotation of the shared key ring are allowed
+	if _, err := initializeKeyManager(time.Hour, store); err != nil {
+		t.Fatalf("Expected rotation of the shared key ring, got %v", err)
+	}
+	t.Setenv("JWT_KEY_ROTATION_HOURS", "0")
+	t.Setenv("JWT_SIGNING_KEY_FILES", keyFile)
+	if _, err := initializeKeyManager(time.Hour, nil); err != nil {
+		t.Fatalf("Expected key files without rotation, got %v", err)
+	}
+
+	t.Log("✓ Key rotation is refused unless the keys are shared between replicas")
+}
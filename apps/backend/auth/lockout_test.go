@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// setupLockoutTestApp returns an auth service replica whose login limiter uses the given store
+func setupLockoutTestApp(limiterStore auth.Store, policy auth.LockoutPolicy) *fiber.App {
+	app := fiber.New(withTrustedProxies(fiber.Config{}))
+	authHandler := handlers.NewAuthHandler(newTestUserStore(), auth.NewTokenManager("your-secret-key", "zplus-saas"))
+	authHandler.SetLoginLimiter(auth.NewLoginLimiter(limiterStore, policy))
+
+	app.Post("/login", authHandler.Login)
+	app.Post("/users/unlock", authHandler.RequireAuth, authHandler.UnlockAccount)
+
+	return app
+}
+
+func TestAccountLockedAcrossReplicas(t *testing.T) {
+	policy := auth.DefaultLockoutPolicy
+	policy.BaseDelay = 0
+
+	// Two replicas sharing one store see the same failures
+	shared := newTestRedisStore(t, testRedisAddr(t))
+	replicaA := setupLockoutTestApp(shared, policy)
+	replicaB := setupLockoutTestApp(shared, policy)
+
+	wrong := models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "wrong", TenantSlug: "demo-corp"}
+	for i := 1; i < policy.MaxAccountFailures; i++ {
+		replica := replicaA
+		if i%2 == 0 {
+			replica = replicaB
+		}
+		if status, body := postJSON(t, replica, "/login", "", wrong); status != 401 {
+			t.Fatalf("Attempt %d: expected 401, got %d %v", i, status, body)
+		}
+	}
+	if status, body := postJSON(t, replicaB, "/login", "", wrong); status != 423 || body["code"] != "ACCOUNT_LOCKED" {
+		t.Fatalf("Expected ACCOUNT_LOCKED on the last allowed failure, got %d %v", status, body)
+	}
+
+	// The correct password is refused while locked, on every replica
+	john := models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"}
+	if status, body := postJSON(t, replicaA, "/login", "", john); status != 423 || body["code"] != "ACCOUNT_LOCKED" {
+		t.Fatalf("Expected locked account to refuse the correct password, got %d %v", status, body)
+	}
+
+	// Other accounts are not affected
+	admin := loginAs(t, replicaA, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+
+	if status, body := postJSON(t, replicaB, "/users/unlock", admin, models.UnlockAccountRequest{Email: "john@demo-corp.zplus.com", TenantSlug: "demo-corp"}); status != 200 {
+		t.Fatalf("Expected status 200 unlocking, got %d %v", status, body)
+	}
+	loginAs(t, replicaA, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+
+	t.Log("✓ Accounts lock after repeated failures on any replica and admins can unlock them")
+}
+
+func TestLoginDelaysAfterFailures(t *testing.T) {
+	policy := auth.DefaultLockoutPolicy
+	policy.BaseDelay = 2 * time.Second
+	app := setupLockoutTestApp(auth.NewMemoryStore(), policy)
+
+	wrong := models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "wrong", TenantSlug: "demo-corp"}
+	postJSON(t, app, "/login", "", wrong)
+	postJSON(t, app, "/login", "", wrong)
+
+	body, _ := json.Marshal(models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"})
+	req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("Login failed: %v", err)
+	}
+	if resp.StatusCode != 429 || resp.Header.Get("Retry-After") != "2" {
+		t.Fatalf("Expected 429 with Retry-After 2, got %d %q", resp.StatusCode, resp.Header.Get("Retry-After"))
+	}
+
+	t.Log("✓ Consecutive failures delay the next attempt")
+}
+
+func TestUnlockAccountRequiresTenantAdmin(t *testing.T) {
+	app := setupLockoutTestApp(auth.NewMemoryStore(), auth.DefaultLockoutPolicy)
+	john := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+
+	if status, _ := postJSON(t, app, "/users/unlock", john, models.UnlockAccountRequest{Email: "admin@demo-corp.zplus.com", TenantSlug: "demo-corp"}); status != 403 {
+		t.Fatalf("Expected 403 for regular user, got %d", status)
+	}
+	if status, _ := postJSON(t, app, "/users/unlock", admin, models.UnlockAccountRequest{Email: "admin@zplus.com", TenantSlug: "system"}); status != 404 {
+		t.Fatalf("Expected 404 unlocking an account of another tenant, got %d", status)
+	}
+
+	t.Log("✓ Only admins unlock accounts of their own tenant")
+}
+
+func TestLoginLimitedByClientAddress(t *testing.T) {
+	policy := auth.DefaultLockoutPolicy
+	policy.BaseDelay = 0
+	policy.MaxIPFailures = 3
+
+	failFrom := func(app *fiber.App, clientIP string) (int, string) {
+		body, _ := json.Marshal(models.LoginRequest{Email: "nobody@demo-corp.zplus.com", Password: "wrong", TenantSlug: "demo-corp"})
+		req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Real-Ip", clientIP)
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Login failed: %v", err)
+		}
+		var result map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&result)
+		code, _ := result["code"].(string)
+		return resp.StatusCode, code
+	}
+
+	// Behind a trusted proxy each client is limited by its own address
+	t.Setenv("TRUSTED_PROXIES", "0.0.0.0/0")
+	proxied := setupLockoutTestApp(auth.NewMemoryStore(), policy)
+	for i := 0; i < policy.MaxIPFailures; i++ {
+		failFrom(proxied, "203.0.113.7")
+	}
+	if status, code := failFrom(proxied, "203.0.113.7"); status != 429 || code != "TOO_MANY_ATTEMPTS" {
+		t.Fatalf("Expected the client to be blocked, got %d %s", status, code)
+	}
+	if status, _ := failFrom(proxied, "198.51.100.9"); status != 401 {
+		t.Fatalf("Expected other clients behind the proxy to be unaffected, got %d", status)
+	}
+
+	// Without a trusted proxy the header is ignored, so rotating it does not evade the limit
+	t.Setenv("TRUSTED_PROXIES", "")
+	direct := setupLockoutTestApp(auth.NewMemoryStore(), policy)
+	for i := 0; i < policy.MaxIPFailures; i++ {
+		failFrom(direct, fmt.Sprintf("203.0.113.%d", i))
+	}
+	if status, code := failFrom(direct, "198.51.100.9"); status != 429 || code != "TOO_MANY_ATTEMPTS" {
+		t.Fatalf("Expected a spoofed client address to be ignored, got %d %s", status, code)
+	}
+
+	t.Log("✓ Login limits count the client address forwarded by trusted proxies only")
+}
@@ -0,0 +1,31 @@
+package mailer
+
+import (
+	"fmt"
+
+	sharedmodels "github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+)
+
+// WelcomeNotifier emails the first admin of a new tenant once the tenant is set up
+type WelcomeNotifier struct {
+	mailer Mailer
+	appURL string
+}
+
+// NewWelcomeNotifier creates a notifier sending welcome emails through mailer
+func NewWelcomeNotifier(mailer Mailer, appURL string) *WelcomeNotifier {
+	return &WelcomeNotifier{
+		mailer: mailer,
+		appURL: appURL,
+	}
+}
+
+// NotifyWelcome sends the welcome email to the admin of a tenant
+func (n *WelcomeNotifier) NotifyWelcome(tenant *sharedmodels.Tenant, admin *sharedmodels.TenantUser) error {
+	return n.mailer.Send(Message{
+		To:      admin.Email,
+		Subject: fmt.Sprintf("Welcome to Zplus, %s is ready", tenant.Name),
+		Body: fmt.Sprintf("Hi %s,\n\nYour workspace %s has been set up. Sign in with the tenant %s at:\n%s/login",
+			admin.FirstName, tenant.Name, tenant.Slug, n.appURL),
+	})
+}
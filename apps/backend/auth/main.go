@@ -1,14 +1,26 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
+	"gorm.io/gorm"
 
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/mailer"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/database"
 )
 
 // getEnv returns environment variable or default value
@@ -19,8 +31,24 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getEnvInt returns environment variable as int or default value
+func getEnvInt(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if intValue, err := strconv.Atoi(value); err == nil {
+			return intValue
+		}
+	}
+	return defaultValue
+}
+
 func main() {
-	app := fiber.New(fiber.Config{
+	// Initialize database connection
+	db, err := initializeDatabase()
+	if err != nil {
+		log.Fatalf("Failed to initialize database: %v", err)
+	}
+
+	app := fiber.New(withTrustedProxies(fiber.Config{
 		ErrorHandler: func(c *fiber.Ctx, err error) error {
 			code := fiber.StatusInternalServerError
 			if e, ok := err.(*fiber.Error); ok {
@@ -31,7 +59,7 @@ func main() {
 				"code":  "SERVER_ERROR",
 			})
 		},
-	})
+	}))
 
 	// Middleware
 	app.Use(logger.New())
@@ -41,9 +69,62 @@ func main() {
 		AllowMethods: "GET, POST, PUT, DELETE, OPTIONS",
 	}))
 
+	tokenStore, err := initializeTokenStore()
+	if err != nil {
+		log.Fatalf("Failed to initialize token store: %v", err)
+	}
+
+	// Initialize signing keys and token manager
+	refreshTTL := time.Duration(getEnvInt("JWT_REFRESH_IN", 604800)) * time.Second
+	keyManager, err := initializeKeyManager(refreshTTL, tokenStore)
+	if err != nil {
+		log.Fatalf("Failed to initialize signing keys: %v", err)
+	}
+	tokenManager := auth.NewKeyedTokenManager(keyManager, "zplus-saas")
+	tokenManager.SetTokenLifetimes(time.Duration(getEnvInt("JWT_EXPIRES_IN", 900))*time.Second, refreshTTL)
+
+	if tokenStore != nil {
+		tokenManager.SetStore(tokenStore)
+	}
+
+	// Make sure the role templates seeded into new tenants exist
+	if err := services.NewRoleTemplateService(db).EnsureDefaultTemplates(); err != nil {
+		log.Printf("Failed to create default role templates: %v", err)
+	}
+
+	mail := initializeMailer()
+	appURL := getEnv("APP_URL", "http://localhost:3000")
+	welcome := mailer.NewWelcomeNotifier(mail, appURL)
+
+	// Finish the tenant onboardings interrupted by a restart
+	onboarding := services.NewOnboardingService(db)
+	onboarding.SetNotifier(welcome)
+	resumed, err := onboarding.ResumePending()
+	if err != nil {
+		log.Printf("Failed to resume tenant onboardings: %v", err)
+	}
+	for _, record := range resumed {
+		log.Printf("Resumed onboarding of tenant %s: %s", record.Slug, record.Status)
+	}
+
 	// Initialize handlers
-	authHandler := handlers.NewAuthHandler()
-	roleHandler := handlers.NewRoleHandler()
+	userStore := store.NewDatabaseUserStore(db)
+	userStore.SetTrial(getEnv("SIGNUP_TRIAL_PLAN", "Basic"), time.Duration(getEnvInt("SIGNUP_TRIAL_DAYS", 14))*24*time.Hour)
+	userStore.SetNotifier(welcome)
+	roleStore := store.NewDatabaseRoleStore(db)
+
+	// Tenant, user and role changes are relayed to the gateways so they drop cached contexts
+	events := initializeEventBus(tokenStore)
+	userStore.SetEventBus(events)
+	roleStore.SetEventBus(events)
+
+	authHandler := handlers.NewAuthHandler(userStore, tokenManager)
+	authHandler.SetLoginLimiter(initializeLoginLimiter(tokenStore))
+	authHandler.SetAuditRecorder(services.NewAuditService(db))
+	registrationHandler := handlers.NewRegistrationHandler(userStore, roleStore, tokenManager, mail, appURL)
+	passwordHandler := handlers.NewPasswordResetHandler(userStore, tokenManager, mail, appURL)
+	roleHandler := handlers.NewRoleHandler(roleStore, userStore)
+	ssoHandler := handlers.NewSSOHandler(authHandler, roleStore, getEnv("AUTH_PUBLIC_URL", "http://localhost:8001"), appURL)
 
 	// Routes
 	app.Get("/", func(c *fiber.Ctx) error {
@@ -63,44 +144,276 @@ func main() {
 		})
 	})
 
+	// Public keys for verifying tokens issued by this service
+	app.Get("/.well-known/jwks.json", func(c *fiber.Ctx) error {
+		c.Set("Cache-Control", "public, max-age=300")
+		return c.JSON(keyManager.JWKS())
+	})
+
+	registerRoutes(app, routeHandlers{
+		auth:         authHandler,
+		registration: registrationHandler,
+		password:     passwordHandler,
+		roles:        roleHandler,
+		sso:          ssoHandler,
+	})
+
+	log.Printf("Auth service starting on port 8001...")
+	log.Fatal(app.Listen(":" + getEnv("AUTH_PORT", "8001")))
+}
+
+// routeHandlers are the handlers serving the routes of the auth service
+type routeHandlers struct {
+	auth         *handlers.AuthHandler
+	registration *handlers.RegistrationHandler
+	password     *handlers.PasswordResetHandler
+	roles        *handlers.RoleHandler
+	sso          *handlers.SSOHandler
+}
+
+// withTrustedProxies makes c.IP() the client address forwarded in PROXY_HEADER by the
+// proxies listed in TRUSTED_PROXIES (IPs or CIDR ranges). Clients reaching the service
+// directly cannot set it, so login limits always count the real client address.
+func withTrustedProxies(config fiber.Config) fiber.Config {
+	config.ProxyHeader = getEnv("PROXY_HEADER", "X-Real-Ip")
+	config.EnableTrustedProxyCheck = true
+	config.EnableIPValidation = true
+	for _, proxy := range strings.Split(getEnv("TRUSTED_PROXIES", ""), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			config.TrustedProxies = append(config.TrustedProxies, proxy)
+		}
+	}
+	return config
+}
+
+// registerRoutes registers the API routes. Impersonation tokens are rejected on routes
+// changing credentials, sessions, roles, SSO or other users.
+func registerRoutes(app *fiber.App, h routeHandlers) {
 	// Authentication endpoints
-	app.Post("/login", authHandler.Login)
-	app.Post("/logout", authHandler.Logout)
-	app.Post("/refresh", authHandler.RefreshToken)
+	app.Post("/login", h.auth.Login)
+	app.Post("/logout", h.auth.Logout)
+	app.Post("/refresh", h.auth.RefreshToken)
+	app.Post("/login/mfa", h.auth.VerifyMFALogin)
+
+	// Multi-factor authentication. Enrolment accepts the bearer token or, when a login
+	// requires enrolment, the mfa_token returned by /login.
+	app.Post("/mfa/enroll", h.auth.EnrollMFA)
+	app.Post("/mfa/enroll/verify", h.auth.VerifyMFAEnrollment)
+	app.Post("/mfa/disable", h.auth.RequireAuth, h.auth.RejectImpersonation, h.auth.DisableMFA)
+	app.Post("/mfa/recovery-codes", h.auth.RequireAuth, h.auth.RejectImpersonation, h.auth.RegenerateRecoveryCodes)
+
+	// Single sign-on through the tenant's OIDC or SAML identity provider. Callbacks redirect
+	// to the web app with a code that /sso/token exchanges for tokens.
+	app.Get("/sso/:tenant/login", h.sso.Login)
+	app.Get("/sso/:tenant/oidc/callback", h.sso.OIDCCallback)
+	app.Post("/sso/:tenant/saml/acs", h.sso.SAMLACS)
+	app.Get("/sso/:tenant/saml/metadata", h.sso.SAMLMetadata)
+	app.Post("/sso/token", h.sso.Token)
+
+	// SSO configuration (tenant admins for their own tenant)
+	app.Get("/sso/:tenant/config", h.auth.RequireAuth, h.sso.GetConfig)
+	app.Put("/sso/:tenant/config", h.auth.RequireAuth, h.auth.RejectImpersonation, h.sso.UpdateConfig)
+
+	// Users of the caller's tenant (tenant admins), or of ?tenant_slug= (system admins)
+	app.Get("/users", h.auth.RequireAuth, h.auth.GetUsers)
+
+	// Active sessions across the platform (system admins only)
+	app.Get("/sessions", h.auth.RequireAuth, h.auth.RequireSystemAdmin, h.auth.GetSessions)
+
+	// Impersonation of tenant users by support staff (system admins only, audit-logged)
+	app.Post("/impersonate", h.auth.RequireAuth, h.auth.RequireSystemAdmin, h.auth.Impersonate)
+
+	// Self-service session management
+	app.Get("/me/sessions", h.auth.RequireAuth, h.auth.GetMySessions)
+	app.Delete("/me/sessions", h.auth.RequireAuth, h.auth.RejectImpersonation, h.auth.RevokeOtherSessions)
+	app.Delete("/me/sessions/:id", h.auth.RequireAuth, h.auth.RejectImpersonation, h.auth.RevokeMySession)
+
+	// Force logout of a user (tenant admins for their own tenant)
+	app.Delete("/users/:id/sessions", h.auth.RequireAuth, h.auth.RejectImpersonation, h.auth.ForceLogoutUser)
 
-	// Development endpoint to see mock users
-	app.Get("/users", authHandler.GetUsers)
+	// Unlock an account locked after failed logins (tenant admins for their own tenant)
+	app.Post("/users/unlock", h.auth.RequireAuth, h.auth.RejectImpersonation, h.auth.UnlockAccount)
 
-	// Development endpoint to see active sessions
-	app.Get("/sessions", authHandler.GetSessions)
+	// Role management endpoints (changes by tenant admins for their own tenant)
+	app.Get("/roles", h.auth.RequireAuth, h.roles.GetRoles)
+	app.Get("/roles/:id", h.auth.RequireAuth, h.roles.GetRole)
+	app.Post("/roles", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.CreateRole)
+	app.Put("/roles/:id", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.UpdateRole)
+	app.Delete("/roles/:id", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.DeleteRole)
 
-	// Role management endpoints
-	app.Get("/roles", roleHandler.GetRoles)
-	app.Get("/roles/:id", roleHandler.GetRole)
-	app.Post("/roles", roleHandler.CreateRole)
-	app.Put("/roles/:id", roleHandler.UpdateRole)
-	app.Delete("/roles/:id", roleHandler.DeleteRole)
+	// Role templates seeded into new tenants (updated by system admins, cloned by tenant admins)
+	app.Get("/role-templates", h.auth.RequireAuth, h.roles.GetTemplates)
+	app.Put("/role-templates/:name", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.UpdateTemplate)
+	app.Post("/roles/clone", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.CloneRole)
 
-	// Permission management endpoints
-	app.Get("/permissions", roleHandler.GetPermissions)
-	app.Post("/permissions", roleHandler.CreatePermission)
+	// Permission catalogue shared by all tenants (created by system admins)
+	app.Get("/permissions", h.auth.RequireAuth, h.roles.GetPermissions)
+	app.Post("/permissions", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.CreatePermission)
 
 	// Role-Permission assignment endpoints
-	app.Get("/roles/:id/permissions", roleHandler.GetRolePermissions)
-	app.Post("/roles/permissions", roleHandler.AssignPermissionToRole)
+	app.Get("/roles/:id/permissions", h.auth.RequireAuth, h.roles.GetRolePermissions)
+	app.Post("/roles/permissions", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.AssignPermissionToRole)
 
 	// User-Role assignment endpoints
-	app.Get("/users/:id/roles", roleHandler.GetUserRoles)
-	app.Post("/users/roles", roleHandler.AssignRoleToUser)
-
-	// Register endpoint (placeholder for future implementation)
-	app.Post("/register", func(c *fiber.Ctx) error {
-		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-			"message": "Register endpoint - to be implemented",
-			"code":    "NOT_IMPLEMENTED",
-		})
+	app.Get("/users/:id/roles", h.auth.RequireAuth, h.roles.GetUserRoles)
+	app.Post("/users/roles", h.auth.RequireAuth, h.auth.RejectImpersonation, h.roles.AssignRoleToUser)
+	app.Get("/users/:id/permissions", h.auth.RequireAuth, h.roles.GetUserPermissions)
+
+	// Self-service signup and email verification
+	app.Post("/register", h.registration.Register)
+	app.Post("/verify-email", h.registration.VerifyEmail)
+	app.Post("/verify-email/resend", h.registration.ResendVerification)
+
+	// Password recovery
+	app.Post("/forgot-password", h.password.ForgotPassword)
+	app.Post("/reset-password", h.password.ResetPassword)
+
+	// Invitations to join a tenant (tenant admins)
+	app.Post("/invitations", h.auth.RequireAuth, h.auth.RejectImpersonation, h.registration.CreateInvitation)
+}
+
+// initializeDatabase connects to the database holding system and tenant users
+func initializeDatabase() (*gorm.DB, error) {
+	dbConfig := database.Config{
+		Host:     getEnv("DB_HOST", "localhost"),
+		Port:     getEnvInt("DB_PORT", 5432),
+		Username: getEnv("DB_USERNAME", "zplus_user"),
+		Password: getEnv("DB_PASSWORD", "zplus_password"),
+		Database: getEnv("DB_DATABASE", "zplus_saas"),
+		SSLMode:  getEnv("DB_SSL_MODE", "disable"),
+	}
+
+	db, err := database.Connect(dbConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tenants are isolated in shared tables, a schema or a database of their own
+	router := tenancy.NewRouter(tenancy.NewDatabasePerTenant(database.Connect))
+	if err := db.Use(router); err != nil {
+		return nil, err
+	}
+
+	log.Printf("Database connected successfully")
+	return db, nil
+}
+
+// initializeKeyManager loads the signing keys from JWT_SIGNING_KEY_FILES or generates
+// keys. Generated keys are shared through the token store when there is one, so all
+// replicas sign with the same key, and are ephemeral otherwise. Retired keys are kept for
+// the refresh token lifetime so rotation does not invalidate live sessions. Rotation
+// needs the shared keys: replicas rotating keys of their own would reject each other's tokens.
+func initializeKeyManager(retention time.Duration, tokenStore auth.Store) (*auth.KeyManager, error) {
+	keyManager := auth.NewKeyManager(retention)
+	algorithm := getEnv("JWT_SIGNING_ALGORITHM", auth.AlgorithmRS256)
+	files := getEnv("JWT_SIGNING_KEY_FILES", "")
+	rotationHours := getEnvInt("JWT_KEY_ROTATION_HOURS", 0)
+
+	if rotationHours > 0 && (files != "" || tokenStore == nil) {
+		return nil, fmt.Errorf("JWT_KEY_ROTATION_HOURS needs the signing keys shared through redis: set REDIS_HOST and leave JWT_SIGNING_KEY_FILES empty")
+	}
+
+	if files != "" {
+		for _, file := range strings.Split(files, ",") {
+			data, err := os.ReadFile(strings.TrimSpace(file))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read signing key %s: %v", file, err)
+			}
+			key, err := auth.ParseSigningKeyPEM(data)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse signing key %s: %v", file, err)
+			}
+			keyManager.AddKey(key)
+		}
+	} else if tokenStore != nil {
+		log.Printf("JWT_SIGNING_KEY_FILES not set, sharing generated %s signing keys through redis", algorithm)
+		keyManager.SetStore(tokenStore)
+		if err := keyManager.RotateIfDue(algorithm, 0); err != nil {
+			return nil, err
+		}
+	} else {
+		log.Printf("JWT_SIGNING_KEY_FILES not set, generating an ephemeral %s signing key", algorithm)
+		if _, err := keyManager.Rotate(algorithm); err != nil {
+			return nil, err
+		}
+	}
+
+	if rotationHours > 0 {
+		keyManager.StartRotationRoutine(time.Duration(rotationHours)*time.Hour, algorithm)
+	}
+
+	return keyManager, nil
+}
+
+// initializeTokenStore connects to the Redis server shared by all services so token
+// revocation and sessions are visible everywhere. Without REDIS_HOST tokens are
+// tracked in process memory only.
+func initializeTokenStore() (auth.Store, error) {
+	redisConfig := shared.RedisConfig{
+		Host:     getEnv("REDIS_HOST", ""),
+		Port:     getEnvInt("REDIS_PORT", 6379),
+		Password: getEnv("REDIS_PASSWORD", ""),
+		DB:       getEnvInt("REDIS_DB", 0),
+	}
+	if redisConfig.Host == "" {
+		return nil, nil
+	}
+
+	store, err := auth.NewRedisStore(auth.RedisOptions{
+		Addr:      redisConfig.Addr(),
+		Password:  redisConfig.Password,
+		DB:        redisConfig.DB,
+		KeyPrefix: "zplus:auth:",
 	})
+	if err != nil {
+		return nil, err
+	}
 
-	log.Printf("Auth service starting on port 8001...")
-	log.Fatal(app.Listen(":" + getEnv("AUTH_PORT", "8001")))
+	log.Printf("Token store connected to redis at %s", redisConfig.Addr())
+	return store, nil
+}
+
+// initializeEventBus creates the event bus and relays it over the shared Redis server,
+// so changes made here reach every gateway replica
+func initializeEventBus(tokenStore auth.Store) *services.EventBus {
+	events := services.NewEventBus()
+	if transport, ok := tokenStore.(services.EventTransport); ok {
+		if _, err := events.Relay(transport, services.EventsChannel); err != nil {
+			log.Printf("Failed to relay events over redis: %v", err)
+		}
+	}
+	return events
+}
+
+// initializeLoginLimiter tracks failed logins in the shared token store so every auth
+// replica enforces the same lockouts. The limits are configurable through LOGIN_* variables.
+func initializeLoginLimiter(tokenStore auth.Store) *auth.LoginLimiter {
+	if tokenStore == nil {
+		tokenStore = auth.NewMemoryStore()
+	}
+
+	policy := auth.DefaultLockoutPolicy
+	policy.MaxAccountFailures = getEnvInt("LOGIN_MAX_FAILURES", policy.MaxAccountFailures)
+	policy.MaxIPFailures = getEnvInt("LOGIN_MAX_IP_FAILURES", policy.MaxIPFailures)
+	policy.Window = time.Duration(getEnvInt("LOGIN_FAILURE_WINDOW", int(policy.Window.Seconds()))) * time.Second
+	policy.LockDuration = time.Duration(getEnvInt("LOGIN_LOCK_DURATION", int(policy.LockDuration.Seconds()))) * time.Second
+
+	return auth.NewLoginLimiter(tokenStore, policy)
+}
+
+// initializeMailer sends emails through SMTP_HOST, or writes them to the log when it is not set
+func initializeMailer() mailer.Mailer {
+	host := getEnv("SMTP_HOST", "")
+	if host == "" {
+		log.Printf("SMTP_HOST not set, emails will be written to the log")
+		return mailer.NewLogMailer()
+	}
+
+	return mailer.NewSMTPMailer(
+		host,
+		getEnvInt("SMTP_PORT", 587),
+		getEnv("SMTP_USERNAME", ""),
+		getEnv("SMTP_PASSWORD", ""),
+		getEnv("MAIL_FROM", "no-reply@zplus.com"),
+	)
 }
@@ -0,0 +1,261 @@
+package main
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// setupMFATestApp returns an app with login and MFA routes and its user store
+func setupMFATestApp() (*fiber.App, *store.MemoryUserStore) {
+	app := fiber.New()
+	users := newTestUserStore()
+	authHandler := handlers.NewAuthHandler(users, auth.NewTokenManager("your-secret-key", "zplus-saas"))
+
+	app.Post("/login", authHandler.Login)
+	app.Post("/login/mfa", authHandler.VerifyMFALogin)
+	app.Post("/mfa/enroll", authHandler.EnrollMFA)
+	app.Post("/mfa/enroll/verify", authHandler.VerifyMFAEnrollment)
+	app.Post("/mfa/disable", authHandler.RequireAuth, authHandler.DisableMFA)
+	app.Post("/mfa/recovery-codes", authHandler.RequireAuth, authHandler.RegenerateRecoveryCodes)
+
+	return app, users
+}
+
+// totpCode returns the code of a secret at an offset from now. Codes of neighbouring
+// time steps are accepted once each, so tests use different offsets for every code.
+func totpCode(t *testing.T, secret string, offset time.Duration) string {
+	code, err := auth.TOTPCode(secret, time.Now().Add(offset))
+	if err != nil {
+		t.Fatalf("Failed to compute TOTP code: %v", err)
+	}
+	return code
+}
+
+// stringList converts a decoded JSON array
+func stringList(value interface{}) []string {
+	items, _ := value.([]interface{})
+	result := make([]string, 0, len(items))
+	for _, item := range items {
+		result = append(result, item.(string))
+	}
+	return result
+}
+
+func TestMFAEnrollmentAndTwoStepLogin(t *testing.T) {
+	app, _ := setupMFATestApp()
+	john := models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"}
+
+	_, body := postJSON(t, app, "/login", "", john)
+	token := body["token"].(string)
+
+	status, body := postJSON(t, app, "/mfa/enroll", token, models.MFAEnrollRequest{})
+	if status != 200 {
+		t.Fatalf("Expected status 200 enrolling, got %d %v", status, body)
+	}
+	secret := body["secret"].(string)
+	if uri := body["provisioning_uri"].(string); uri == "" || uri[:15] != "otpauth://totp/" {
+		t.Fatalf("Expected otpauth provisioning URI, got %q", uri)
+	}
+
+	// MFA is not enabled until a code is confirmed
+	if _, body := postJSON(t, app, "/login", "", john); body["token"] == nil {
+		t.Fatalf("Expected password login before enrolment is verified, got %v", body)
+	}
+
+	if status, _ := postJSON(t, app, "/mfa/enroll/verify", token, models.MFAEnrollRequest{Code: "000000"}); status != 401 {
+		t.Fatalf("Expected wrong code to be rejected, got %d", status)
+	}
+	status, body = postJSON(t, app, "/mfa/enroll/verify", token, models.MFAEnrollRequest{Code: totpCode(t, secret, 0)})
+	if status != 200 || len(stringList(body["recovery_codes"])) != 10 {
+		t.Fatalf("Expected 10 recovery codes, got %d %v", status, body)
+	}
+
+	// The password step now returns a challenge instead of tokens
+	status, body = postJSON(t, app, "/login", "", john)
+	if status != 200 || body["mfa_required"] != true || body["token"] != nil {
+		t.Fatalf("Expected MFA challenge, got %d %v", status, body)
+	}
+	code := totpCode(t, secret, 30*time.Second)
+	status, body = postJSON(t, app, "/login/mfa", "", models.MFALoginRequest{MFAToken: body["mfa_token"].(string), Code: code})
+	if status != 200 || body["token"] == nil {
+		t.Fatalf("Expected tokens after MFA, got %d %v", status, body)
+	}
+
+	// A used code cannot be replayed within its validity window
+	_, body = postJSON(t, app, "/login", "", john)
+	if status, _ := postJSON(t, app, "/login/mfa", "", models.MFALoginRequest{MFAToken: body["mfa_token"].(string), Code: code}); status != 401 {
+		t.Fatalf("Expected replayed code to be rejected, got %d", status)
+	}
+
+	t.Log("✓ TOTP enrolment enables the two-step login")
+}
+
+func TestMFARecoveryCodesAreSingleUse(t *testing.T) {
+	app, users := setupMFATestApp()
+	john := models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"}
+
+	_, body := postJSON(t, app, "/login", "", john)
+	token := body["token"].(string)
+	_, body = postJSON(t, app, "/mfa/enroll", token, models.MFAEnrollRequest{})
+	secret := body["secret"].(string)
+	_, body = postJSON(t, app, "/mfa/enroll/verify", token, models.MFAEnrollRequest{Code: totpCode(t, secret, 0)})
+	recoveryCodes := stringList(body["recovery_codes"])
+
+	_, body = postJSON(t, app, "/login", "", john)
+	status, body := postJSON(t, app, "/login/mfa", "", models.MFALoginRequest{MFAToken: body["mfa_token"].(string), RecoveryCode: recoveryCodes[0]})
+	if status != 200 || body["token"] == nil {
+		t.Fatalf("Expected login with recovery code, got %d %v", status, body)
+	}
+
+	_, body = postJSON(t, app, "/login", "", john)
+	if status, _ := postJSON(t, app, "/login/mfa", "", models.MFALoginRequest{MFAToken: body["mfa_token"].(string), RecoveryCode: recoveryCodes[0]}); status != 401 {
+		t.Fatalf("Expected recovery code to be single use, got %d", status)
+	}
+
+	user, _ := users.GetUser("demo-corp", "customer-1")
+	if len(user.MFARecoveryCodes) != 9 {
+		t.Fatalf("Expected 9 remaining recovery codes, got %d", len(user.MFARecoveryCodes))
+	}
+
+	// Regenerating replaces all codes
+	status, body = postJSON(t, app, "/mfa/recovery-codes", token, models.MFACodeRequest{Code: totpCode(t, secret, 30*time.Second)})
+	if status != 200 || len(stringList(body["recovery_codes"])) != 10 {
+		t.Fatalf("Expected 10 new recovery codes, got %d %v", status, body)
+	}
+	_, body = postJSON(t, app, "/login", "", john)
+	if status, _ := postJSON(t, app, "/login/mfa", "", models.MFALoginRequest{MFAToken: body["mfa_token"].(string), RecoveryCode: recoveryCodes[1]}); status != 401 {
+		t.Fatalf("Expected old recovery codes to be replaced, got %d", status)
+	}
+
+	t.Log("✓ Recovery codes log in once and can be regenerated")
+}
+
+func TestMFARecoveryCodeUsedOnceConcurrently(t *testing.T) {
+	app, users := setupMFATestApp()
+	john := models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"}
+
+	_, body := postJSON(t, app, "/login", "", john)
+	token := body["token"].(string)
+	_, body = postJSON(t, app, "/mfa/enroll", token, models.MFAEnrollRequest{})
+	secret := body["secret"].(string)
+	_, body = postJSON(t, app, "/mfa/enroll/verify", token, models.MFAEnrollRequest{Code: totpCode(t, secret, 0)})
+	recoveryCode := stringList(body["recovery_codes"])[0]
+
+	// Several challenges answered with the same code at once
+	challenges := make([]string, 8)
+	for i := range challenges {
+		_, body := postJSON(t, app, "/login", "", john)
+		challenges[i] = body["mfa_token"].(string)
+	}
+
+	var wg sync.WaitGroup
+	statuses := make([]int, len(challenges))
+	for i, challenge := range challenges {
+		wg.Add(1)
+		go func(i int, challenge string) {
+			defer wg.Done()
+			statuses[i], _ = postJSON(t, app, "/login/mfa", "", models.MFALoginRequest{MFAToken: challenge, RecoveryCode: recoveryCode})
+		}(i, challenge)
+	}
+	wg.Wait()
+
+	logins := 0
+	for _, status := range statuses {
+		if status == 200 {
+			logins++
+		}
+	}
+	if logins != 1 {
+		t.Fatalf("Expected the recovery code to log in once, got %d logins %v", logins, statuses)
+	}
+	if user, _ := users.GetUser("demo-corp", "customer-1"); len(user.MFARecoveryCodes) != 9 {
+		t.Fatalf("Expected 9 remaining recovery codes, got %d", len(user.MFARecoveryCodes))
+	}
+
+	t.Log("✓ Concurrent logins use a recovery code once")
+}
+
+func TestMFAEnrollmentCodesCountTowardLockout(t *testing.T) {
+	policy := auth.DefaultLockoutPolicy
+	policy.BaseDelay = 0
+
+	app := fiber.New()
+	authHandler := handlers.NewAuthHandler(newTestUserStore(), auth.NewTokenManager("your-secret-key", "zplus-saas"))
+	authHandler.SetLoginLimiter(auth.NewLoginLimiter(auth.NewMemoryStore(), policy))
+	app.Post("/login", authHandler.Login)
+	app.Post("/mfa/enroll", authHandler.EnrollMFA)
+	app.Post("/mfa/enroll/verify", authHandler.VerifyMFAEnrollment)
+
+	john := models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"}
+	_, body := postJSON(t, app, "/login", "", john)
+	token := body["token"].(string)
+	_, body = postJSON(t, app, "/mfa/enroll", token, models.MFAEnrollRequest{})
+	secret := body["secret"].(string)
+
+	for i := 1; i < policy.MaxAccountFailures; i++ {
+		if status, body := postJSON(t, app, "/mfa/enroll/verify", token, models.MFAEnrollRequest{Code: "000000"}); status != 401 {
+			t.Fatalf("Attempt %d: expected 401, got %d %v", i, status, body)
+		}
+	}
+	if status, body := postJSON(t, app, "/mfa/enroll/verify", token, models.MFAEnrollRequest{Code: "000000"}); status != 423 || body["code"] != "ACCOUNT_LOCKED" {
+		t.Fatalf("Expected ACCOUNT_LOCKED on the last allowed failure, got %d %v", status, body)
+	}
+
+	// The right code no longer helps, and neither does the password
+	if status, _ := postJSON(t, app, "/mfa/enroll/verify", token, models.MFAEnrollRequest{Code: totpCode(t, secret, 0)}); status != 423 {
+		t.Fatalf("Expected locked enrolment to stay locked, got %d", status)
+	}
+	if status, _ := postJSON(t, app, "/login", "", john); status != 423 {
+		t.Fatalf("Expected password login to be locked, got %d", status)
+	}
+
+	t.Log("✓ Wrong enrolment codes lock the account like wrong passwords")
+}
+
+func TestTenantEnforcesMFAForRoles(t *testing.T) {
+	app, users := setupMFATestApp()
+	users.SetTenantSettings("demo-corp", map[string]interface{}{"mfa_required_roles": []interface{}{"tenant_admin"}})
+	admin := models.LoginRequest{Email: "admin@demo-corp.zplus.com", Password: "demo123", TenantSlug: "demo-corp"}
+
+	// Users in other roles are not affected
+	if _, body := postJSON(t, app, "/login", "", models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"}); body["token"] == nil {
+		t.Fatalf("Expected regular user to log in with password, got %v", body)
+	}
+
+	status, body := postJSON(t, app, "/login", "", admin)
+	if status != 200 || body["mfa_enrollment_required"] != true || body["token"] != nil {
+		t.Fatalf("Expected enrolment to be required, got %d %v", status, body)
+	}
+	mfaToken := body["mfa_token"].(string)
+
+	// The enrolment token cannot be used as a challenge
+	if status, _ := postJSON(t, app, "/login/mfa", "", models.MFALoginRequest{MFAToken: mfaToken, Code: "123456"}); status != 401 {
+		t.Fatalf("Expected enrolment token to be rejected at /login/mfa, got %d", status)
+	}
+
+	_, body = postJSON(t, app, "/mfa/enroll", "", models.MFAEnrollRequest{MFAToken: mfaToken})
+	secret := body["secret"].(string)
+	status, body = postJSON(t, app, "/mfa/enroll/verify", "", models.MFAEnrollRequest{MFAToken: mfaToken, Code: totpCode(t, secret, 0)})
+	if status != 200 {
+		t.Fatalf("Expected status 200 verifying enrolment, got %d %v", status, body)
+	}
+	login, _ := body["login"].(map[string]interface{})
+	if login == nil || login["token"] == nil {
+		t.Fatalf("Expected enrolment to complete the login, got %v", body)
+	}
+
+	// Enforced MFA cannot be turned off
+	status, body = postJSON(t, app, "/mfa/disable", login["token"].(string), models.MFACodeRequest{Code: totpCode(t, secret, 30*time.Second)})
+	if status != 403 || body["code"] != "MFA_REQUIRED" {
+		t.Fatalf("Expected MFA_REQUIRED disabling enforced MFA, got %d %v", status, body)
+	}
+
+	t.Log("✓ Tenant settings enforce MFA enrolment for roles")
+}
@@ -6,18 +6,34 @@ import (
 
 // Role represents a role in the system
 type Role struct {
-	ID           int       `json:"id" db:"id"`
-	Name         string    `json:"name" db:"name"`
-	DisplayName  string    `json:"display_name" db:"display_name"`
-	Description  string    `json:"description" db:"description"`
-	IsSystemRole bool      `json:"is_system_role" db:"is_system_role"`
-	CreatedAt    time.Time `json:"created_at" db:"created_at"`
-	UpdatedAt    time.Time `json:"updated_at" db:"updated_at"`
+	ID              string    `json:"id" db:"id"`
+	TenantID        string    `json:"tenant_id" db:"tenant_id"`
+	Name            string    `json:"name" db:"name"`
+	DisplayName     string    `json:"display_name" db:"display_name"`
+	Description     string    `json:"description" db:"description"`
+	IsSystemRole    bool      `json:"is_system_role" db:"is_system_role"`
+	TemplateID      string    `json:"template_id,omitempty" db:"template_id"`           // Role template the role was seeded or cloned from
+	TemplateVersion int       `json:"template_version,omitempty" db:"template_version"` // Template version the role was last updated to
+	Customized      bool      `json:"customized" db:"customized"`                       // Changed by the tenant, template updates no longer apply
+	CreatedAt       time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// RoleTemplate is a system role definition seeded into every tenant
+type RoleTemplate struct {
+	ID          string    `json:"id" db:"id"`
+	Name        string    `json:"name" db:"name"`
+	DisplayName string    `json:"display_name" db:"display_name"`
+	Description string    `json:"description" db:"description"`
+	Module      string    `json:"module,omitempty" db:"module"`
+	Permissions []string  `json:"permissions" db:"permissions"`
+	Version     int       `json:"version" db:"version"`
+	UpdatedAt   time.Time `json:"updated_at" db:"updated_at"`
 }
 
 // Permission represents a permission in the system
 type Permission struct {
-	ID          int       `json:"id" db:"id"`
+	ID          string    `json:"id" db:"id"`
 	Name        string    `json:"name" db:"name"`
 	Resource    string    `json:"resource" db:"resource"`
 	Action      string    `json:"action" db:"action"`
@@ -27,17 +43,15 @@ type Permission struct {
 
 // UserRole represents the junction table between users and roles
 type UserRole struct {
-	ID         int       `json:"id" db:"id"`
 	UserID     string    `json:"user_id" db:"user_id"`
-	RoleID     int       `json:"role_id" db:"role_id"`
+	RoleID     string    `json:"role_id" db:"role_id"`
 	AssignedAt time.Time `json:"assigned_at" db:"assigned_at"`
 }
 
 // RolePermission represents the junction table between roles and permissions
 type RolePermission struct {
-	ID           int `json:"id" db:"id"`
-	RoleID       int `json:"role_id" db:"role_id"`
-	PermissionID int `json:"permission_id" db:"permission_id"`
+	RoleID       string `json:"role_id" db:"role_id"`
+	PermissionID string `json:"permission_id" db:"permission_id"`
 }
 
 // CreateRoleRequest represents the request to create a new role
@@ -62,16 +76,32 @@ type CreatePermissionRequest struct {
 	Description string `json:"description" validate:"max=1000"`
 }
 
+// CloneRoleRequest represents the request to clone a role template into a custom role
+type CloneRoleRequest struct {
+	Template    string `json:"template" validate:"required"`
+	Name        string `json:"name" validate:"required,min=3,max=100"`
+	DisplayName string `json:"display_name" validate:"max=255"`
+	Description string `json:"description" validate:"max=1000"`
+}
+
+// UpdateRoleTemplateRequest represents the request to update a role template. Omitted
+// fields keep their current value.
+type UpdateRoleTemplateRequest struct {
+	DisplayName *string  `json:"display_name"`
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
 // AssignRoleRequest represents the request to assign a role to a user
 type AssignRoleRequest struct {
 	UserID string `json:"user_id" validate:"required"`
-	RoleID int    `json:"role_id" validate:"required,min=1"`
+	RoleID string `json:"role_id" validate:"required,uuid"`
 }
 
 // AssignPermissionRequest represents the request to assign a permission to a role
 type AssignPermissionRequest struct {
-	RoleID       int `json:"role_id" validate:"required,min=1"`
-	PermissionID int `json:"permission_id" validate:"required,min=1"`
+	RoleID       string `json:"role_id" validate:"required,uuid"`
+	PermissionID string `json:"permission_id" validate:"required,uuid"`
 }
 
 // RoleWithPermissions represents a role with its associated permissions
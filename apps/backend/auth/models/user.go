@@ -3,6 +3,8 @@ package models
 import (
 	"time"
 	"golang.org/x/crypto/bcrypt"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/sso"
 )
 
 // User represents a user in the system
@@ -19,6 +21,11 @@ type User struct {
 	Status      string    `json:"status"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+
+	// Multi-factor authentication
+	MFAEnabled       bool     `json:"mfa_enabled"`
+	MFASecret        string   `json:"-"` // Set once enrolment starts, active when MFAEnabled
+	MFARecoveryCodes []string `json:"-"` // Hashes of unused recovery codes
 }
 
 // HashPassword hashes the user's password
@@ -52,6 +59,135 @@ type LoginResponse struct {
 	ExpiresIn    int    `json:"expires_in"`
 }
 
+// MFAChallengeResponse is returned by login when a second factor is needed. MFAToken
+// continues the login at /login/mfa, or at /mfa/enroll when enrolment is required.
+type MFAChallengeResponse struct {
+	MFARequired           bool   `json:"mfa_required"`
+	MFAEnrollmentRequired bool   `json:"mfa_enrollment_required"`
+	MFAToken              string `json:"mfa_token"`
+	ExpiresIn             int    `json:"expires_in"`
+}
+
+// MFALoginRequest completes a login with a TOTP code or a recovery code
+type MFALoginRequest struct {
+	MFAToken     string `json:"mfa_token" validate:"required"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+// MFAEnrollRequest starts or confirms MFA enrolment. MFAToken is only needed during a
+// login that requires enrolment, otherwise the access token identifies the user.
+type MFAEnrollRequest struct {
+	MFAToken string `json:"mfa_token"`
+	Code     string `json:"code"`
+}
+
+// MFAEnrollResponse contains the secret to add to an authenticator app
+type MFAEnrollResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// URI to render as QR code
+}
+
+// MFACodeRequest confirms a sensitive MFA change with a current TOTP code
+type MFACodeRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// MFARecoveryCodesResponse returns newly generated recovery codes. They are only shown once.
+// Login is set when enrolment completed a login that required it.
+type MFARecoveryCodesResponse struct {
+	RecoveryCodes []string       `json:"recovery_codes"`
+	Login         *LoginResponse `json:"login,omitempty"`
+}
+
+// UnlockAccountRequest identifies a locked login account
+type UnlockAccountRequest struct {
+	Email      string `json:"email" validate:"required,email"`
+	TenantSlug string `json:"tenant_slug" validate:"required"`
+}
+
+// SSOTokenRequest redeems the one-time code a single sign-on callback redirected with
+type SSOTokenRequest struct {
+	Code string `json:"code" validate:"required"`
+}
+
+// SSOConfigResponse is a tenant's SSO configuration with the URLs to register at the IdP.
+// The OIDC client secret is never returned.
+type SSOConfigResponse struct {
+	Config          *sso.Config `json:"config"`
+	LoginURL        string      `json:"login_url"`
+	OIDCRedirectURL string      `json:"oidc_redirect_url"`
+	SAMLEntityID    string      `json:"saml_entity_id"`
+	SAMLACSURL      string      `json:"saml_acs_url"`
+}
+
+// RegisterRequest represents the registration payload. An invitation token joins the
+// inviting tenant, otherwise tenant_name and tenant_slug create a new tenant.
+type RegisterRequest struct {
+	Email           string `json:"email" validate:"required,email"`
+	Password        string `json:"password" validate:"required,min=8"`
+	FirstName       string `json:"first_name" validate:"required"`
+	LastName        string `json:"last_name" validate:"required"`
+	InvitationToken string `json:"invitation_token"`
+	TenantName      string `json:"tenant_name"`
+	TenantSlug      string `json:"tenant_slug"`
+}
+
+// RegisterResponse represents the registration response payload
+type RegisterResponse struct {
+	User    *User  `json:"user"`
+	Message string `json:"message"`
+}
+
+// VerifyEmailRequest represents the email verification payload
+type VerifyEmailRequest struct {
+	Token string `json:"token" validate:"required"`
+}
+
+// ForgotPasswordRequest represents the payload for requesting a password reset email
+type ForgotPasswordRequest struct {
+	Email      string `json:"email" validate:"required,email"`
+	TenantSlug string `json:"tenant_slug" validate:"required"`
+}
+
+// ResetPasswordRequest represents the payload for setting a new password with a reset token
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required"`
+	Password string `json:"password" validate:"required,min=8"`
+}
+
+// InvitationRequest represents the payload for inviting a user to a tenant
+type InvitationRequest struct {
+	Email string `json:"email" validate:"required,email"`
+	Role  string `json:"role"` // defaults to user
+}
+
+// SessionInfo is a session as shown to its owner
+type SessionInfo struct {
+	ID        string    `json:"id"`
+	IPAddress string    `json:"ip_address"`
+	UserAgent string    `json:"user_agent"`
+	CreatedAt time.Time `json:"created_at"`
+	LastSeen  time.Time `json:"last_seen"`
+	Current   bool      `json:"current"` // Session of the token used for the request
+}
+
+// ImpersonateRequest identifies the tenant user a system user wants to act as
+type ImpersonateRequest struct {
+	TenantID string `json:"tenant_id" validate:"required"`
+	UserID   string `json:"user_id" validate:"required"`
+	Reason   string `json:"reason" validate:"required"` // Recorded in the audit log, e.g. a ticket reference
+}
+
+// ImpersonationResponse contains a short-lived token acting as the impersonated user.
+// It cannot be refreshed.
+type ImpersonationResponse struct {
+	Token          string `json:"token"`
+	User           *User  `json:"user"`
+	ImpersonatorID string `json:"impersonator_id"`
+	ExpiresIn      int    `json:"expires_in"`
+}
+
 // ErrorResponse represents an error response
 type ErrorResponse struct {
 	Error   string `json:"error"`
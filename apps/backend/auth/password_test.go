@@ -0,0 +1,122 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/mailer"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// setupPasswordTestApp returns an app with password reset routes whose tokens live in
+// tokenStore, the mailer capturing its emails and the password reset handler
+func setupPasswordTestApp(tokenStore auth.Store) (*fiber.App, *mailer.MemoryMailer, *handlers.PasswordResetHandler) {
+	app := fiber.New()
+	users := newTestUserStore()
+	tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
+	tokenManager.SetStore(tokenStore)
+	mail := mailer.NewMemoryMailer()
+
+	authHandler := handlers.NewAuthHandler(users, tokenManager)
+	passwordHandler := handlers.NewPasswordResetHandler(users, tokenManager, mail, "http://localhost:3000")
+
+	app.Post("/login", authHandler.Login)
+	app.Post("/refresh", authHandler.RefreshToken)
+	app.Get("/me/sessions", authHandler.RequireAuth, authHandler.GetMySessions)
+	app.Post("/forgot-password", passwordHandler.ForgotPassword)
+	app.Post("/reset-password", passwordHandler.ResetPassword)
+
+	return app, mail, passwordHandler
+}
+
+func TestPasswordResetFlow(t *testing.T) {
+	app, mail, passwordHandler := setupPasswordTestApp(auth.NewMemoryStore())
+
+	oldToken := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+
+	status, body := postJSON(t, app, "/forgot-password", "", models.ForgotPasswordRequest{Email: "john@demo-corp.zplus.com", TenantSlug: "demo-corp"})
+	if status != 200 {
+		t.Fatalf("Expected status 200, got %d %v", status, body)
+	}
+	passwordHandler.Wait()
+	token := tokenFromEmail(t, mail, "john@demo-corp.zplus.com", "token")
+
+	// Too short passwords are rejected without using up the token
+	if status, _ := postJSON(t, app, "/reset-password", "", models.ResetPasswordRequest{Token: token, Password: "short"}); status != 400 {
+		t.Fatalf("Expected 400 for short password, got %d", status)
+	}
+
+	if status, body := postJSON(t, app, "/reset-password", "", models.ResetPasswordRequest{Token: token, Password: "new-password"}); status != 200 {
+		t.Fatalf("Expected status 200 resetting password, got %d %v", status, body)
+	}
+	if status, _ := postJSON(t, app, "/reset-password", "", models.ResetPasswordRequest{Token: token, Password: "other-password"}); status != 400 {
+		t.Fatalf("Expected reset token to be single use, got %d", status)
+	}
+
+	// Existing sessions are revoked
+	if status, _ := sessionRequest(t, app, "GET", "/me/sessions", oldToken); status != 401 {
+		t.Fatalf("Expected session from before the reset to be revoked, got %d", status)
+	}
+
+	if status, _ := postJSON(t, app, "/login", "", models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"}); status != 401 {
+		t.Fatalf("Expected old password to be rejected, got %d", status)
+	}
+	loginAs(t, app, "john@demo-corp.zplus.com", "new-password", "demo-corp", "laptop")
+
+	t.Log("✓ Password reset sets a new password and revokes sessions")
+}
+
+func TestForgotPasswordDoesNotRevealAccounts(t *testing.T) {
+	app, mail, passwordHandler := setupPasswordTestApp(auth.NewMemoryStore())
+
+	_, existing := postJSON(t, app, "/forgot-password", "", models.ForgotPasswordRequest{Email: "john@demo-corp.zplus.com", TenantSlug: "demo-corp"})
+	_, missing := postJSON(t, app, "/forgot-password", "", models.ForgotPasswordRequest{Email: "nobody@demo-corp.zplus.com", TenantSlug: "demo-corp"})
+	_, otherTenant := postJSON(t, app, "/forgot-password", "", models.ForgotPasswordRequest{Email: "john@demo-corp.zplus.com", TenantSlug: "other-corp"})
+
+	passwordHandler.Wait()
+	if existing["message"] != missing["message"] || existing["message"] != otherTenant["message"] {
+		t.Fatalf("Expected identical responses, got %v / %v / %v", existing, missing, otherTenant)
+	}
+	if len(mail.Messages("nobody@demo-corp.zplus.com")) != 0 || len(mail.Messages("john@demo-corp.zplus.com")) != 1 {
+		t.Fatal("Expected a reset email only for the existing account")
+	}
+
+	t.Log("✓ Forgot-password responses do not reveal whether an account exists")
+}
+
+func TestPasswordResetRevokesEarlierLinksAndIdleLogins(t *testing.T) {
+	tokenStore := auth.NewMemoryStore()
+	app, mail, passwordHandler := setupPasswordTestApp(tokenStore)
+
+	// A login whose session expired from being idle still holds a refresh token
+	_, login := postJSON(t, app, "/login", "", models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"})
+	refreshToken, _ := login["refresh_token"].(string)
+	claims, err := auth.NewTokenManager("your-secret-key", "zplus-saas").ValidateToken(login["token"].(string))
+	if err != nil || refreshToken == "" {
+		t.Fatalf("Login failed: %v %v", err, login)
+	}
+	tokenStore.Delete("session:" + claims.TokenID)
+
+	// Two reset links are requested before the password is reset with the second
+	forgot := func() string {
+		postJSON(t, app, "/forgot-password", "", models.ForgotPasswordRequest{Email: "john@demo-corp.zplus.com", TenantSlug: "demo-corp"})
+		passwordHandler.Wait()
+		return tokenFromEmail(t, mail, "john@demo-corp.zplus.com", "token")
+	}
+	first := forgot()
+	latest := forgot()
+
+	if status, body := postJSON(t, app, "/reset-password", "", models.ResetPasswordRequest{Token: latest, Password: "new-password"}); status != 200 {
+		t.Fatalf("Expected status 200 resetting password, got %d %v", status, body)
+	}
+	if status, _ := postJSON(t, app, "/reset-password", "", models.ResetPasswordRequest{Token: first, Password: "attacker-password"}); status != 400 {
+		t.Fatalf("Expected an earlier reset link to be invalid after the reset, got %d", status)
+	}
+	if status, _ := postJSON(t, app, "/refresh", "", map[string]string{"refresh_token": refreshToken}); status != 401 {
+		t.Fatalf("Expected the refresh token of the idle login to be revoked, got %d", status)
+	}
+
+	t.Log("✓ Password reset invalidates earlier reset links and every login of the user")
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/mailer"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// setupRegistrationTestApp returns an app with registration routes and the mailer capturing its emails
+func setupRegistrationTestApp() (*fiber.App, *mailer.MemoryMailer) {
+	app := fiber.New()
+	users := newTestUserStore()
+	tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
+	mail := mailer.NewMemoryMailer()
+
+	authHandler := handlers.NewAuthHandler(users, tokenManager)
+	registrationHandler := handlers.NewRegistrationHandler(users, newTestRoleStore(), tokenManager, mail, "http://localhost:3000")
+
+	app.Post("/login", authHandler.Login)
+	app.Post("/register", registrationHandler.Register)
+	app.Post("/verify-email", registrationHandler.VerifyEmail)
+	app.Post("/verify-email/resend", registrationHandler.ResendVerification)
+	app.Post("/invitations", authHandler.RequireAuth, registrationHandler.CreateInvitation)
+
+	return app, mail
+}
+
+// postJSON sends a JSON body with an optional bearer token and returns the status code and decoded body
+func postJSON(t *testing.T, app *fiber.App, path, token string, payload interface{}) (int, map[string]interface{}) {
+	body, _ := json.Marshal(payload)
+	req, _ := http.NewRequest("POST", path, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("POST %s failed: %v", path, err)
+	}
+
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+// tokenFromEmail extracts the token query parameter of the link in the last email sent to a recipient
+func tokenFromEmail(t *testing.T, mail *mailer.MemoryMailer, to, param string) string {
+	messages := mail.Messages(to)
+	if len(messages) == 0 {
+		t.Fatalf("No email sent to %s", to)
+	}
+
+	link := regexp.MustCompile(`https?://\S+`).FindString(messages[len(messages)-1].Body)
+	parsed, err := url.Parse(link)
+	if err != nil || parsed.Query().Get(param) == "" {
+		t.Fatalf("No %s link in email: %q", param, messages[len(messages)-1].Body)
+	}
+	return parsed.Query().Get(param)
+}
+
+func TestRegisterNewTenantRequiresVerification(t *testing.T) {
+	app, mail := setupRegistrationTestApp()
+
+	status, body := postJSON(t, app, "/register", "", models.RegisterRequest{
+		Email:      "owner@acme.com",
+		Password:   "acme-secret",
+		FirstName:  "Ada",
+		LastName:   "Owner",
+		TenantName: "Acme Inc",
+		TenantSlug: "acme",
+	})
+	if status != 201 {
+		t.Fatalf("Expected status 201, got %d %v", status, body)
+	}
+	user := body["user"].(map[string]interface{})
+	if user["status"] != "pending" || user["roles"].([]interface{})[0] != "tenant_admin" {
+		t.Fatalf("Expected pending tenant admin, got %v", user)
+	}
+
+	login := models.LoginRequest{Email: "owner@acme.com", Password: "acme-secret", TenantSlug: "acme"}
+	if status, body := postJSON(t, app, "/login", "", login); status != 403 || body["code"] != "EMAIL_NOT_VERIFIED" {
+		t.Fatalf("Expected EMAIL_NOT_VERIFIED before verification, got %d %v", status, body)
+	}
+
+	// A new verification email can be requested with the account password
+	if status, _ := postJSON(t, app, "/verify-email/resend", "", login); status != 200 {
+		t.Fatalf("Expected status 200 resending verification, got %d", status)
+	}
+	if len(mail.Messages("owner@acme.com")) != 2 {
+		t.Fatalf("Expected 2 verification emails, got %d", len(mail.Messages("owner@acme.com")))
+	}
+
+	token := tokenFromEmail(t, mail, "owner@acme.com", "token")
+	if status, body := postJSON(t, app, "/verify-email", "", models.VerifyEmailRequest{Token: token}); status != 200 {
+		t.Fatalf("Expected status 200 verifying email, got %d %v", status, body)
+	}
+	if status, _ := postJSON(t, app, "/verify-email", "", models.VerifyEmailRequest{Token: token}); status != 400 {
+		t.Fatalf("Expected verification token to be single use, got %d", status)
+	}
+
+	if status, body := postJSON(t, app, "/login", "", login); status != 200 {
+		t.Fatalf("Expected login after verification, got %d %v", status, body)
+	}
+
+	t.Log("✓ New tenant owners log in after verifying their email")
+}
+
+func TestRegisterRejectsInvalidAndTakenSlugs(t *testing.T) {
+	app, _ := setupRegistrationTestApp()
+
+	cases := map[string]int{
+		"Bad Slug":  400,
+		"-acme":     400,
+		"system":    400,
+		"demo-corp": 409,
+	}
+
+	for slug, expected := range cases {
+		status, body := postJSON(t, app, "/register", "", models.RegisterRequest{
+			Email:      "owner@example.com",
+			Password:   "password123",
+			FirstName:  "Test",
+			LastName:   "Owner",
+			TenantName: "Example",
+			TenantSlug: slug,
+		})
+		if status != expected {
+			t.Fatalf("Slug %q: expected status %d, got %d %v", slug, expected, status, body)
+		}
+	}
+
+	t.Log("✓ Invalid, reserved and taken slugs rejected")
+}
+
+func TestRegisterWithInvitation(t *testing.T) {
+	app, mail := setupRegistrationTestApp()
+
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+	john := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+
+	// Only tenant admins can invite
+	if status, _ := postJSON(t, app, "/invitations", john, models.InvitationRequest{Email: "jane@demo-corp.zplus.com"}); status != 403 {
+		t.Fatalf("Expected 403 for regular user, got %d", status)
+	}
+
+	if status, body := postJSON(t, app, "/invitations", admin, models.InvitationRequest{Email: "jane@demo-corp.zplus.com", Role: "system_admin"}); status != 400 || body["code"] != "ROLE_NAME_RESERVED" {
+		t.Fatalf("Expected invitations as system admin to be refused, got %d %v", status, body)
+	}
+	if status, body := postJSON(t, app, "/invitations", admin, models.InvitationRequest{Email: "jane@demo-corp.zplus.com", Role: "owner"}); status != 400 || body["code"] != "INVALID_ROLE" {
+		t.Fatalf("Expected invitations with a role the tenant does not have to be refused, got %d %v", status, body)
+	}
+
+	if status, body := postJSON(t, app, "/invitations", admin, models.InvitationRequest{Email: "jane@demo-corp.zplus.com", Role: "manager"}); status != 201 {
+		t.Fatalf("Expected status 201 creating invitation, got %d %v", status, body)
+	}
+	token := tokenFromEmail(t, mail, "jane@demo-corp.zplus.com", "invitation")
+
+	register := models.RegisterRequest{
+		Password:        "jane-secret",
+		FirstName:       "Jane",
+		LastName:        "Doe",
+		InvitationToken: token,
+	}
+
+	// A failed attempt does not use up the invitation
+	mismatched := register
+	mismatched.Email = "someone@else.com"
+	if status, body := postJSON(t, app, "/register", "", mismatched); status != 400 || body["code"] != "INVALID_INVITATION" {
+		t.Fatalf("Expected an invitation of another address to be refused, got %d %v", status, body)
+	}
+
+	status, body := postJSON(t, app, "/register", "", register)
+	if status != 201 {
+		t.Fatalf("Expected status 201 accepting invitation, got %d %v", status, body)
+	}
+	user := body["user"].(map[string]interface{})
+	if user["status"] != "active" || user["tenant_id"] != "demo-corp" || user["roles"].([]interface{})[0] != "manager" {
+		t.Fatalf("Expected active manager in demo-corp, got %v", user)
+	}
+
+	if status, _ := postJSON(t, app, "/register", "", register); status != 400 {
+		t.Fatalf("Expected invitation to be single use, got %d", status)
+	}
+
+	if status, body := postJSON(t, app, "/login", "", models.LoginRequest{Email: "jane@demo-corp.zplus.com", Password: "jane-secret", TenantSlug: "demo-corp"}); status != 200 {
+		t.Fatalf("Expected invited user to log in, got %d %v", status, body)
+	}
+
+	t.Log("✓ Invited users join the inviting tenant")
+}
@@ -4,57 +4,133 @@ import (
 	"bytes"
 	"encoding/json"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
 )
 
-func setupRoleTestApp() *fiber.App {
+// newTestRoleStore returns an in-memory role store with the default permission catalogue
+// and the default roles of the demo-corp tenant
+func newTestRoleStore() *store.MemoryRoleStore {
+	roles := store.NewMemoryRoleStore()
+	for _, name := range []string{
+		"system:manage", "tenants:read", "tenants:write", "users:read", "users:write",
+		"customers:read", "customers:write", "employees:read", "employees:write",
+		"products:read", "products:write",
+	} {
+		resource, action, _ := strings.Cut(name, ":")
+		roles.CreatePermission(&models.Permission{Name: name, Resource: resource, Action: action})
+	}
+
+	roles.AddRole("demo-corp", &models.Role{Name: "tenant_admin", DisplayName: "Tenant Administrator", IsSystemRole: true},
+		"users:read", "users:write", "customers:read", "customers:write", "employees:read", "employees:write", "products:read", "products:write")
+	roles.AddRole("demo-corp", &models.Role{Name: "manager", DisplayName: "Manager", IsSystemRole: true},
+		"users:read", "customers:read", "customers:write", "employees:read", "employees:write", "products:read", "products:write")
+	roles.AddRole("demo-corp", &models.Role{Name: "employee", DisplayName: "Employee", IsSystemRole: true},
+		"customers:read", "employees:read", "products:read")
+	roles.AddRole("demo-corp", &models.Role{Name: "user", DisplayName: "User", IsSystemRole: true},
+		"users:read", "customers:read", "employees:read", "products:read")
+	return roles
+}
+
+func setupRoleTestApp() (*fiber.App, *store.MemoryRoleStore) {
 	app := fiber.New()
-	roleHandler := handlers.NewRoleHandler()
+	users := newTestUserStore()
+	authHandler := handlers.NewAuthHandler(users, auth.NewTokenManager("your-secret-key", "zplus-saas"))
+	roles := newTestRoleStore()
+	roleHandler := handlers.NewRoleHandler(roles, users)
+
+	app.Post("/login", authHandler.Login)
 
 	// Role management endpoints
-	app.Get("/roles", roleHandler.GetRoles)
-	app.Get("/roles/:id", roleHandler.GetRole)
-	app.Post("/roles", roleHandler.CreateRole)
-	app.Put("/roles/:id", roleHandler.UpdateRole)
-	app.Delete("/roles/:id", roleHandler.DeleteRole)
+	app.Get("/roles", authHandler.RequireAuth, roleHandler.GetRoles)
+	app.Get("/roles/:id", authHandler.RequireAuth, roleHandler.GetRole)
+	app.Post("/roles", authHandler.RequireAuth, roleHandler.CreateRole)
+	app.Put("/roles/:id", authHandler.RequireAuth, roleHandler.UpdateRole)
+	app.Delete("/roles/:id", authHandler.RequireAuth, roleHandler.DeleteRole)
 
 	// Permission management endpoints
-	app.Get("/permissions", roleHandler.GetPermissions)
-	app.Post("/permissions", roleHandler.CreatePermission)
+	app.Get("/permissions", authHandler.RequireAuth, roleHandler.GetPermissions)
+	app.Post("/permissions", authHandler.RequireAuth, roleHandler.CreatePermission)
 
 	// Role-Permission assignment endpoints
-	app.Get("/roles/:id/permissions", roleHandler.GetRolePermissions)
-	app.Post("/roles/permissions", roleHandler.AssignPermissionToRole)
+	app.Get("/roles/:id/permissions", authHandler.RequireAuth, roleHandler.GetRolePermissions)
+	app.Post("/roles/permissions", authHandler.RequireAuth, roleHandler.AssignPermissionToRole)
 
 	// User-Role assignment endpoints
-	app.Get("/users/:id/roles", roleHandler.GetUserRoles)
-	app.Post("/users/roles", roleHandler.AssignRoleToUser)
+	app.Get("/users/:id/roles", authHandler.RequireAuth, roleHandler.GetUserRoles)
+	app.Post("/users/roles", authHandler.RequireAuth, roleHandler.AssignRoleToUser)
+	app.Get("/users/:id/permissions", authHandler.RequireAuth, roleHandler.GetUserPermissions)
 
-	return app
+	// Role template endpoints
+	app.Get("/role-templates", authHandler.RequireAuth, roleHandler.GetTemplates)
+	app.Put("/role-templates/:name", authHandler.RequireAuth, roleHandler.UpdateTemplate)
+	app.Post("/roles/clone", authHandler.RequireAuth, roleHandler.CloneRole)
+
+	return app, roles
 }
 
-func TestGetRoles(t *testing.T) {
-	app := setupRoleTestApp()
+// tenantAdminToken logs in the demo-corp tenant admin
+func tenantAdminToken(t *testing.T, app *fiber.App) string {
+	return loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "roles-test")
+}
 
-	req, _ := http.NewRequest("GET", "/roles", nil)
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+// systemAdminToken logs in the system admin
+func systemAdminToken(t *testing.T, app *fiber.App) string {
+	return loginAs(t, app, "admin@zplus.com", "admin123", "system", "roles-test")
+}
+
+// idByName returns the ID of the item named name in the data list of a GET endpoint
+func idByName(t *testing.T, app *fiber.App, path, token, name string) string {
+	status, body := sessionRequest(t, app, "GET", path, token)
+	if status != fiber.StatusOK {
+		t.Fatalf("GET %s failed with status %d", path, status)
+	}
+	for _, item := range body["data"].([]interface{}) {
+		if item := item.(map[string]interface{}); strings.EqualFold(item["name"].(string), name) {
+			return item["id"].(string)
+		}
 	}
+	t.Fatalf("No %s found at %s", name, path)
+	return ""
+}
+
+// putJSON sends a JSON body with a bearer token and returns the status code and decoded body
+func putJSON(t *testing.T, app *fiber.App, path, token string, payload interface{}) (int, map[string]interface{}) {
+	body, _ := json.Marshal(payload)
+	req, _ := http.NewRequest("PUT", path, bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
 
-	if resp.StatusCode != fiber.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("PUT %s failed: %v", path, err)
 	}
 
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
+	var result map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&result)
+	return resp.StatusCode, result
+}
+
+func TestGetRoles(t *testing.T) {
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
+
+	status, response := sessionRequest(t, app, "GET", "/roles", token)
+	if status != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", status)
+	}
 
 	if response["count"] == nil {
-		t.Error("Expected count field in response")
+		t.Fatal("Expected count field in response")
 	}
 
 	if response["data"] == nil {
@@ -69,170 +145,193 @@ func TestGetRoles(t *testing.T) {
 }
 
 func TestCreateRole(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
-	roleData := models.CreateRoleRequest{
+	status, response := postJSON(t, app, "/roles", token, models.CreateRoleRequest{
 		Name:         "test_role",
 		DisplayName:  "Test Role",
 		Description:  "A test role",
 		IsSystemRole: false,
+	})
+	if status != fiber.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", status)
 	}
 
-	jsonData, _ := json.Marshal(roleData)
-	req, _ := http.NewRequest("POST", "/roles", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
-	}
-
-	if resp.StatusCode != fiber.StatusCreated {
-		t.Errorf("Expected status 201, got %d", resp.StatusCode)
-	}
-
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
-
 	if response["data"] == nil {
-		t.Error("Expected data field in response")
+		t.Fatal("Expected data field in response")
 	}
 
 	role := response["data"].(map[string]interface{})
 	if role["name"] != "test_role" {
 		t.Errorf("Expected role name 'test_role', got %v", role["name"])
 	}
+	if _, err := uuid.Parse(role["id"].(string)); err != nil {
+		t.Errorf("Expected a UUID role ID, got %v", role["id"])
+	}
+	if role["tenant_id"] != "demo-corp" {
+		t.Errorf("Expected the role in the admin's tenant, got %v", role["tenant_id"])
+	}
 }
 
 func TestCreateDuplicateRole(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
+
+	if status, _ := postJSON(t, app, "/roles", token, models.CreateRoleRequest{Name: "auditor", DisplayName: "Auditor"}); status != fiber.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", status)
+	}
 
 	// Try to create a role with existing name
-	roleData := models.CreateRoleRequest{
-		Name:         "system_admin", // This already exists
-		DisplayName:  "Another System Admin",
+	status, _ := postJSON(t, app, "/roles", token, models.CreateRoleRequest{
+		Name:         "Auditor", // This already exists
+		DisplayName:  "Another Auditor",
 		Description:  "Duplicate role",
 		IsSystemRole: false,
+	})
+	if status != fiber.StatusConflict {
+		t.Errorf("Expected status 409, got %d", status)
 	}
+}
 
-	jsonData, _ := json.Marshal(roleData)
-	req, _ := http.NewRequest("POST", "/roles", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
+func TestReservedRoleNames(t *testing.T) {
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
+	admin := systemAdminToken(t, app)
 
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+	// Neither the system admin role nor the names of system templates can be taken
+	for _, name := range []string{"system_admin", " System_Admin ", "tenant_admin", "cashier"} {
+		status, body := postJSON(t, app, "/roles", token, models.CreateRoleRequest{Name: name, DisplayName: "Reserved"})
+		if status != fiber.StatusBadRequest || body["code"] != "ROLE_NAME_RESERVED" {
+			t.Errorf("Expected %q to be reserved, got %d %v", name, status, body)
+		}
 	}
-
-	if resp.StatusCode != fiber.StatusConflict {
-		t.Errorf("Expected status 409, got %d", resp.StatusCode)
+	if status, _ := postJSON(t, app, "/roles?tenant_id=acme", admin, models.CreateRoleRequest{Name: "system_admin", DisplayName: "Reserved", IsSystemRole: true}); status != fiber.StatusBadRequest {
+		t.Errorf("Expected system_admin to be reserved for system admins too, got %d", status)
 	}
+	if status, _ := postJSON(t, app, "/roles/clone", token, models.CloneRoleRequest{Template: "tenant_admin", Name: "system_admin"}); status != fiber.StatusBadRequest {
+		t.Errorf("Expected cloning into system_admin to be refused, got %d", status)
+	}
+
+	t.Log("✓ Role names of system administrators and templates are reserved")
 }
 
 func TestGetRole(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
-	// Get role with ID 1 (system_admin)
-	req, _ := http.NewRequest("GET", "/roles/1", nil)
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
-	}
-
-	if resp.StatusCode != fiber.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	managerID := idByName(t, app, "/roles", token, "manager")
+	status, response := sessionRequest(t, app, "GET", "/roles/"+managerID, token)
+	if status != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", status)
 	}
 
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
-
 	if response["data"] == nil {
-		t.Error("Expected data field in response")
+		t.Fatal("Expected data field in response")
 	}
 
 	role := response["data"].(map[string]interface{})
-	if role["name"] != "system_admin" {
-		t.Errorf("Expected role name 'system_admin', got %v", role["name"])
+	if role["name"] != "manager" {
+		t.Errorf("Expected role name 'manager', got %v", role["name"])
 	}
 }
 
 func TestGetNonExistentRole(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
-	req, _ := http.NewRequest("GET", "/roles/999", nil)
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+	status, _ := sessionRequest(t, app, "GET", "/roles/"+uuid.New().String(), token)
+	if status != fiber.StatusNotFound {
+		t.Errorf("Expected status 404, got %d", status)
 	}
 
-	if resp.StatusCode != fiber.StatusNotFound {
-		t.Errorf("Expected status 404, got %d", resp.StatusCode)
+	status, _ = sessionRequest(t, app, "GET", "/roles/999", token)
+	if status != fiber.StatusBadRequest {
+		t.Errorf("Expected status 400 for a malformed ID, got %d", status)
 	}
 }
 
 func TestUpdateRole(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
-	updateData := models.UpdateRoleRequest{
+	roleID := idByName(t, app, "/roles", token, "tenant_admin")
+	status, response := putJSON(t, app, "/roles/"+roleID, token, models.UpdateRoleRequest{
 		DisplayName: "Updated Role Name",
 		Description: "Updated description",
+	})
+	if status != fiber.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
 	}
 
-	jsonData, _ := json.Marshal(updateData)
-	req, _ := http.NewRequest("PUT", "/roles/2", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
-	}
-
-	if resp.StatusCode != fiber.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
-	}
-
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
-
 	role := response["data"].(map[string]interface{})
 	if role["display_name"] != "Updated Role Name" {
 		t.Errorf("Expected updated display name, got %v", role["display_name"])
 	}
+
+	// The change is stored
+	_, response = sessionRequest(t, app, "GET", "/roles/"+roleID, token)
+	if role := response["data"].(map[string]interface{}); role["description"] != "Updated description" {
+		t.Errorf("Expected stored description, got %v", role["description"])
+	}
 }
 
 func TestDeleteSystemRole(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
-	// Try to delete system_admin role (ID 1)
-	req, _ := http.NewRequest("DELETE", "/roles/1", nil)
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+	// Try to delete the tenant_admin system role
+	roleID := idByName(t, app, "/roles", token, "tenant_admin")
+	status, body := sessionRequest(t, app, "DELETE", "/roles/"+roleID, token)
+	if status != fiber.StatusForbidden {
+		t.Errorf("Expected status 403, got %d", status)
+	}
+	if body["code"] != "SYSTEM_ROLE_PROTECTED" {
+		t.Errorf("Expected SYSTEM_ROLE_PROTECTED, got %v", body["code"])
 	}
 
-	if resp.StatusCode != fiber.StatusForbidden {
-		t.Errorf("Expected status 403, got %d", resp.StatusCode)
+	// Tenant admins cannot create roles that would be protected
+	status, _ = postJSON(t, app, "/roles", token, models.CreateRoleRequest{Name: "auditor", DisplayName: "Auditor", IsSystemRole: true})
+	if status != fiber.StatusForbidden {
+		t.Errorf("Expected status 403 creating a system role, got %d", status)
 	}
 }
 
-func TestGetPermissions(t *testing.T) {
-	app := setupRoleTestApp()
+func TestDeleteCustomRoleRemovesAssignments(t *testing.T) {
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
-	req, _ := http.NewRequest("GET", "/permissions", nil)
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+	_, body := postJSON(t, app, "/roles", token, models.CreateRoleRequest{Name: "auditor", DisplayName: "Auditor"})
+	roleID := body["data"].(map[string]interface{})["id"].(string)
+	postJSON(t, app, "/users/roles", token, models.AssignRoleRequest{UserID: "customer-1", RoleID: roleID})
+
+	status, _ := sessionRequest(t, app, "DELETE", "/roles/"+roleID, token)
+	if status != fiber.StatusOK {
+		t.Fatalf("Expected status 200, got %d", status)
 	}
 
-	if resp.StatusCode != fiber.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	if status, _ := sessionRequest(t, app, "GET", "/roles/"+roleID, token); status != fiber.StatusNotFound {
+		t.Errorf("Expected deleted role to be gone, got %d", status)
+	}
+	_, body = sessionRequest(t, app, "GET", "/users/customer-1/roles", token)
+	if roles := body["roles"].([]interface{}); len(roles) != 0 {
+		t.Errorf("Expected the assignment of the deleted role to be removed, got %v", roles)
 	}
 
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
+	t.Log("✓ Custom role deleted with its assignments")
+}
+
+func TestGetPermissions(t *testing.T) {
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
+
+	status, response := sessionRequest(t, app, "GET", "/permissions", token)
+	if status != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", status)
+	}
 
 	if response["count"] == nil {
-		t.Error("Expected count field in response")
+		t.Fatal("Expected count field in response")
 	}
 
 	// Should have default permissions
@@ -243,7 +342,7 @@ func TestGetPermissions(t *testing.T) {
 }
 
 func TestCreatePermission(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
 
 	permData := models.CreatePermissionRequest{
 		Name:        "reports:generate",
@@ -252,22 +351,17 @@ func TestCreatePermission(t *testing.T) {
 		Description: "Generate reports",
 	}
 
-	jsonData, _ := json.Marshal(permData)
-	req, _ := http.NewRequest("POST", "/permissions", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+	// The catalogue is shared by all tenants, so tenant admins cannot extend it
+	status, _ := postJSON(t, app, "/permissions", tenantAdminToken(t, app), permData)
+	if status != fiber.StatusForbidden {
+		t.Errorf("Expected status 403 for a tenant admin, got %d", status)
 	}
 
-	if resp.StatusCode != fiber.StatusCreated {
-		t.Errorf("Expected status 201, got %d", resp.StatusCode)
+	status, response := postJSON(t, app, "/permissions", systemAdminToken(t, app), permData)
+	if status != fiber.StatusCreated {
+		t.Fatalf("Expected status 201, got %d", status)
 	}
 
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
-
 	perm := response["data"].(map[string]interface{})
 	if perm["name"] != "reports:generate" {
 		t.Errorf("Expected permission name 'reports:generate', got %v", perm["name"])
@@ -275,111 +369,319 @@ func TestCreatePermission(t *testing.T) {
 }
 
 func TestAssignRoleToUser(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
 	assignData := models.AssignRoleRequest{
-		UserID: "test-user-1",
-		RoleID: 1,
+		UserID: "customer-1",
+		RoleID: idByName(t, app, "/roles", token, "manager"),
 	}
 
-	jsonData, _ := json.Marshal(assignData)
-	req, _ := http.NewRequest("POST", "/users/roles", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+	status, _ := postJSON(t, app, "/users/roles", token, assignData)
+	if status != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", status)
 	}
 
-	if resp.StatusCode != fiber.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	status, body := postJSON(t, app, "/users/roles", token, assignData)
+	if status != fiber.StatusConflict || body["code"] != "ROLE_ALREADY_ASSIGNED" {
+		t.Errorf("Expected status 409 assigning the role again, got %d %v", status, body["code"])
 	}
 }
 
 func TestAssignPermissionToRole(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
 	assignData := models.AssignPermissionRequest{
-		RoleID:       1,
-		PermissionID: 1,
+		RoleID:       idByName(t, app, "/roles", token, "tenant_admin"),
+		PermissionID: idByName(t, app, "/permissions", token, "users:read"),
 	}
 
-	jsonData, _ := json.Marshal(assignData)
-	req, _ := http.NewRequest("POST", "/roles/permissions", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+	// This should fail with conflict since permission is already assigned
+	status, _ := postJSON(t, app, "/roles/permissions", token, assignData)
+	if status != fiber.StatusConflict {
+		t.Errorf("Expected status 409, got %d", status)
 	}
 
-	// This should fail with conflict since permission is already assigned
-	if resp.StatusCode != fiber.StatusConflict {
-		t.Errorf("Expected status 409, got %d", resp.StatusCode)
+	assignData.PermissionID = uuid.New().String()
+	status, _ = postJSON(t, app, "/roles/permissions", token, assignData)
+	if status != fiber.StatusNotFound {
+		t.Errorf("Expected status 404 for an unknown permission, got %d", status)
 	}
 }
 
 func TestGetRolePermissions(t *testing.T) {
-	app := setupRoleTestApp()
-
-	// Get permissions for system_admin role (ID 1)
-	req, _ := http.NewRequest("GET", "/roles/1/permissions", nil)
-	resp, err := app.Test(req)
-	if err != nil {
-		t.Fatalf("Error making request: %v", err)
-	}
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
-	if resp.StatusCode != fiber.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	// Get permissions for the tenant_admin role
+	roleID := idByName(t, app, "/roles", token, "tenant_admin")
+	status, response := sessionRequest(t, app, "GET", "/roles/"+roleID+"/permissions", token)
+	if status != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", status)
 	}
 
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
-
 	if response["permissions"] == nil {
-		t.Error("Expected permissions field in response")
+		t.Fatal("Expected permissions field in response")
 	}
 
 	permissions := response["permissions"].([]interface{})
 	if len(permissions) == 0 {
-		t.Error("Expected system_admin to have permissions")
+		t.Error("Expected tenant_admin to have permissions")
 	}
 }
 
 func TestGetUserRoles(t *testing.T) {
-	app := setupRoleTestApp()
+	app, _ := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
 
 	// First assign a role to a user
-	assignData := models.AssignRoleRequest{
-		UserID: "test-user-2",
-		RoleID: 2,
+	postJSON(t, app, "/users/roles", token, models.AssignRoleRequest{
+		UserID: "customer-1",
+		RoleID: idByName(t, app, "/roles", token, "employee"),
+	})
+
+	// Now get user roles
+	status, response := sessionRequest(t, app, "GET", "/users/customer-1/roles", token)
+	if status != fiber.StatusOK {
+		t.Errorf("Expected status 200, got %d", status)
 	}
 
-	jsonData, _ := json.Marshal(assignData)
-	req, _ := http.NewRequest("POST", "/users/roles", bytes.NewBuffer(jsonData))
-	req.Header.Set("Content-Type", "application/json")
-	app.Test(req)
+	if response["roles"] == nil {
+		t.Fatal("Expected roles field in response")
+	}
 
-	// Now get user roles
-	req, _ = http.NewRequest("GET", "/users/test-user-2/roles", nil)
-	resp, err := app.Test(req)
+	roles := response["roles"].([]interface{})
+	if len(roles) != 1 {
+		t.Errorf("Expected 1 role, got %d", len(roles))
+	}
+}
+
+func TestRoleChangesRequireTenantAdmin(t *testing.T) {
+	app, _ := setupRoleTestApp()
+	token := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "roles-test")
+
+	if status, _ := sessionRequest(t, app, "GET", "/roles", token); status != fiber.StatusOK {
+		t.Errorf("Expected tenant members to list roles, got %d", status)
+	}
+
+	status, body := postJSON(t, app, "/roles", token, models.CreateRoleRequest{Name: "escalated", DisplayName: "Escalated"})
+	if status != fiber.StatusForbidden || body["code"] != "FORBIDDEN" {
+		t.Errorf("Expected status 403 for a regular user, got %d %v", status, body["code"])
+	}
+
+	if status, _ := sessionRequest(t, app, "GET", "/roles", ""); status != fiber.StatusUnauthorized {
+		t.Errorf("Expected status 401 without a token, got %d", status)
+	}
+
+	t.Log("✓ Role changes limited to tenant admins")
+}
+
+func TestRolesScopedPerTenant(t *testing.T) {
+	app, roles := setupRoleTestApp()
+	token := tenantAdminToken(t, app)
+
+	other := &models.Role{Name: "barista", DisplayName: "Barista"}
+	if err := roles.AddRole("acme", other, "products:read"); err != nil {
+		t.Fatalf("Failed to add role: %v", err)
+	}
+
+	// Roles of other tenants are invisible to the tenant admin
+	_, body := sessionRequest(t, app, "GET", "/roles", token)
+	for _, item := range body["data"].([]interface{}) {
+		if item.(map[string]interface{})["id"] == other.ID {
+			t.Fatal("Expected roles of other tenants to be hidden")
+		}
+	}
+	if status, _ := sessionRequest(t, app, "GET", "/roles/"+other.ID+"/permissions", token); status != fiber.StatusNotFound {
+		t.Errorf("Expected status 404 for another tenant's role, got %d", status)
+	}
+	status, _ := postJSON(t, app, "/users/roles", token, models.AssignRoleRequest{UserID: "customer-1", RoleID: other.ID})
+	if status != fiber.StatusNotFound {
+		t.Errorf("Expected status 404 assigning another tenant's role, got %d", status)
+	}
+
+	// The same name can be used by every tenant
+	status, _ = postJSON(t, app, "/roles", token, models.CreateRoleRequest{Name: "barista", DisplayName: "Barista"})
+	if status != fiber.StatusCreated {
+		t.Errorf("Expected status 201 reusing another tenant's role name, got %d", status)
+	}
+
+	// System admins choose the tenant
+	admin := systemAdminToken(t, app)
+	if status, _ := sessionRequest(t, app, "GET", "/roles", admin); status != fiber.StatusBadRequest {
+		t.Errorf("Expected status 400 without tenant_id, got %d", status)
+	}
+	if id := idByName(t, app, "/roles?tenant_id=acme", admin, "barista"); id != other.ID {
+		t.Errorf("Expected the acme barista role, got %s", id)
+	}
+
+	t.Log("✓ Roles scoped per tenant")
+}
+
+// permissionCase is a case of the permission matching suite shared with the gateway
+type permissionCase struct {
+	Name     string   `json:"name"`
+	Granted  []string `json:"granted"`
+	Required string   `json:"required"`
+	Expected bool     `json:"expected"`
+}
+
+func TestWildcardAndImpliedPermissions(t *testing.T) {
+	data, err := os.ReadFile("../shared/models/testdata/permission_cases.json")
 	if err != nil {
-		t.Fatalf("Error making request: %v", err)
+		t.Fatalf("Failed to read permission cases: %v", err)
+	}
+	var cases []permissionCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatalf("Failed to parse permission cases: %v", err)
+	}
+
+	for _, tc := range cases {
+		app, _ := setupRoleTestApp()
+		token := tenantAdminToken(t, app)
+		admin := systemAdminToken(t, app)
+
+		status, body := postJSON(t, app, "/roles", token, models.CreateRoleRequest{Name: "custom", DisplayName: "Custom"})
+		if status != fiber.StatusCreated {
+			t.Fatalf("%s: failed to create role: %d", tc.Name, status)
+		}
+		roleID := body["data"].(map[string]interface{})["id"].(string)
+
+		for _, name := range tc.Granted {
+			resource, action, found := strings.Cut(name, ":")
+			if !found {
+				action = "*"
+			}
+
+			// Grants may already exist as default permissions
+			status, body := postJSON(t, app, "/permissions", admin, models.CreatePermissionRequest{Name: name, Resource: resource, Action: action})
+			var permID string
+			switch status {
+			case fiber.StatusCreated:
+				permID = body["data"].(map[string]interface{})["id"].(string)
+			case fiber.StatusConflict:
+				permID = idByName(t, app, "/permissions", token, name)
+			default:
+				t.Fatalf("%s: failed to create permission %s: %d", tc.Name, name, status)
+			}
+
+			postJSON(t, app, "/roles/permissions", token, models.AssignPermissionRequest{RoleID: roleID, PermissionID: permID})
+		}
+		postJSON(t, app, "/users/roles", token, models.AssignRoleRequest{UserID: "custom-user", RoleID: roleID})
+
+		status, body = sessionRequest(t, app, "GET", "/users/custom-user/permissions?permission="+url.QueryEscape(tc.Required), token)
+		if status != fiber.StatusOK {
+			t.Fatalf("%s: expected status 200, got %d", tc.Name, status)
+		}
+		if body["granted"] != tc.Expected {
+			t.Errorf("%s: permission %q with %v granted = %v, expected %v", tc.Name, tc.Required, tc.Granted, body["granted"], tc.Expected)
+		}
+	}
+
+	t.Log("✓ Wildcard and implied permissions matched by role assignments")
+}
+
+func TestCreatePermissionRejectsPartialWildcards(t *testing.T) {
+	app, _ := setupRoleTestApp()
+	admin := systemAdminToken(t, app)
+
+	status, _ := postJSON(t, app, "/permissions", admin, models.CreatePermissionRequest{Name: "crm:*", Resource: "crm", Action: "*"})
+	if status != fiber.StatusCreated {
+		t.Fatalf("Expected status 201 for crm:*, got %d", status)
 	}
 
-	if resp.StatusCode != fiber.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+	status, body := postJSON(t, app, "/permissions", admin, models.CreatePermissionRequest{Name: "crm:re*", Resource: "crm", Action: "re*"})
+	if status != fiber.StatusBadRequest {
+		t.Fatalf("Expected status 400 for crm:re*, got %d", status)
+	}
+	if body["code"] != "VALIDATION_ERROR" {
+		t.Errorf("Expected VALIDATION_ERROR, got %v", body["code"])
 	}
 
-	var response map[string]interface{}
-	json.NewDecoder(resp.Body).Decode(&response)
+	t.Log("✓ Partial wildcards rejected")
+}
 
-	if response["roles"] == nil {
-		t.Error("Expected roles field in response")
+// rolePermissionNames returns the names of the permissions granted to a role
+func rolePermissionNames(t *testing.T, app *fiber.App, path, token string) []string {
+	status, body := sessionRequest(t, app, "GET", path, token)
+	if status != fiber.StatusOK {
+		t.Fatalf("GET %s failed with status %d", path, status)
+	}
+	names := []string{}
+	for _, item := range body["permissions"].([]interface{}) {
+		names = append(names, item.(map[string]interface{})["name"].(string))
 	}
+	return names
+}
 
-	roles := response["roles"].([]interface{})
-	if len(roles) != 1 {
-		t.Errorf("Expected 1 role, got %d", len(roles))
+func TestRoleTemplatesCloneAndPush(t *testing.T) {
+	app, roles := setupRoleTestApp()
+	admin := systemAdminToken(t, app)
+	token := tenantAdminToken(t, app)
+
+	roles.AddTemplate(&models.RoleTemplate{Name: "cashier", DisplayName: "Cashier", Module: "pos", Permissions: []string{"orders:write", "products:read"}})
+	roles.SeedTenant("acme")
+	roles.SeedTenant("globex")
+
+	status, body := sessionRequest(t, app, "GET", "/role-templates", token)
+	if status != fiber.StatusOK || body["count"] != float64(1) {
+		t.Fatalf("Expected one template, got %d %v", status, body["count"])
 	}
-}
\ No newline at end of file
+
+	// acme customises its seeded cashier role
+	acmeRole := idByName(t, app, "/roles?tenant_id=acme", admin, "cashier")
+	if status, _ := putJSON(t, app, "/roles/"+acmeRole+"?tenant_id=acme", admin, models.UpdateRoleRequest{DisplayName: "Till Operator"}); status != fiber.StatusOK {
+		t.Fatalf("Expected status 200 updating role, got %d", status)
+	}
+
+	// demo-corp clones the template into a custom role
+	status, body = postJSON(t, app, "/roles/clone", token, models.CloneRoleRequest{Template: "cashier", Name: "Senior_Cashier"})
+	if status != fiber.StatusCreated {
+		t.Fatalf("Expected status 201 cloning template, got %d", status)
+	}
+	clone := body["data"].(map[string]interface{})
+	if clone["name"] != "senior_cashier" || clone["is_system_role"] != false || clone["customized"] != true {
+		t.Errorf("Unexpected clone %v", clone)
+	}
+	if status, _ := postJSON(t, app, "/roles/clone", token, models.CloneRoleRequest{Template: "cashier", Name: "senior_cashier"}); status != fiber.StatusConflict {
+		t.Errorf("Expected status 409 cloning into an existing name, got %d", status)
+	}
+	if status, _ := postJSON(t, app, "/roles/clone", token, models.CloneRoleRequest{Template: "missing", Name: "other"}); status != fiber.StatusNotFound {
+		t.Errorf("Expected status 404 cloning a missing template, got %d", status)
+	}
+
+	// Only system admins update templates
+	update := models.UpdateRoleTemplateRequest{Permissions: []string{"orders:write", "products:read", "customers:read"}}
+	if status, _ := putJSON(t, app, "/role-templates/cashier", token, update); status != fiber.StatusForbidden {
+		t.Errorf("Expected status 403 for tenant admin, got %d", status)
+	}
+	status, body = putJSON(t, app, "/role-templates/cashier", admin, update)
+	if status != fiber.StatusOK {
+		t.Fatalf("Expected status 200 updating template, got %d", status)
+	}
+	if body["updated_roles"] != float64(1) {
+		t.Errorf("Expected only globex's role to be updated, got %v", body["updated_roles"])
+	}
+
+	hasCustomers := func(path string) bool {
+		for _, name := range rolePermissionNames(t, app, path, admin) {
+			if name == "customers:read" {
+				return true
+			}
+		}
+		return false
+	}
+	globexRole := idByName(t, app, "/roles?tenant_id=globex", admin, "cashier")
+	if !hasCustomers("/roles/" + globexRole + "/permissions?tenant_id=globex") {
+		t.Error("Expected the update to be pushed to the uncustomised role")
+	}
+	if hasCustomers("/roles/" + acmeRole + "/permissions?tenant_id=acme") {
+		t.Error("Expected the customised role to keep its permissions")
+	}
+	if hasCustomers("/roles/" + clone["id"].(string) + "/permissions?tenant_id=demo-corp") {
+		t.Error("Expected the clone to keep its permissions")
+	}
+
+	t.Log("✓ Role templates seeded, cloned and pushed to uncustomised roles")
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+func setupSessionTestApp() *fiber.App {
+	app := fiber.New()
+	authHandler := newTestAuthHandler()
+
+	app.Post("/login", authHandler.Login)
+	app.Get("/sessions", authHandler.RequireAuth, authHandler.RequireSystemAdmin, authHandler.GetSessions)
+	app.Get("/me/sessions", authHandler.RequireAuth, authHandler.GetMySessions)
+	app.Delete("/me/sessions", authHandler.RequireAuth, authHandler.RevokeOtherSessions)
+	app.Delete("/me/sessions/:id", authHandler.RequireAuth, authHandler.RevokeMySession)
+	app.Delete("/users/:id/sessions", authHandler.RequireAuth, authHandler.ForceLogoutUser)
+
+	return app
+}
+
+// loginAs logs in a demo user and returns the access token
+func loginAs(t *testing.T, app *fiber.App, email, password, tenantSlug, userAgent string) string {
+	loginBody, _ := json.Marshal(models.LoginRequest{Email: email, Password: password, TenantSlug: tenantSlug})
+	req, _ := http.NewRequest("POST", "/login", bytes.NewBuffer(loginBody))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := app.Test(req, 5000)
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("Login as %s failed: %v", email, err)
+	}
+
+	var loginResp models.LoginResponse
+	json.NewDecoder(resp.Body).Decode(&loginResp)
+	return loginResp.Token
+}
+
+// sessionRequest sends an authenticated request and returns the status code and decoded body
+func sessionRequest(t *testing.T, app *fiber.App, method, path, token string) (int, map[string]interface{}) {
+	req, _ := http.NewRequest(method, path, nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+
+	var body map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&body)
+	return resp.StatusCode, body
+}
+
+func TestListAndRevokeMySessions(t *testing.T) {
+	app := setupSessionTestApp()
+
+	laptop := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+	phone := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "phone")
+	tablet := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "tablet")
+
+	status, body := sessionRequest(t, app, "GET", "/me/sessions", laptop)
+	if status != 200 || body["count"].(float64) != 3 {
+		t.Fatalf("Expected 3 sessions, got %d %v", status, body)
+	}
+
+	var phoneSessionID string
+	for _, item := range body["sessions"].([]interface{}) {
+		session := item.(map[string]interface{})
+		if session["user_agent"] == "laptop" && session["current"] != true {
+			t.Fatal("Expected the laptop session to be marked current")
+		}
+		if session["user_agent"] == "phone" {
+			phoneSessionID = session["id"].(string)
+		}
+	}
+
+	// Revoke a single session
+	status, _ = sessionRequest(t, app, "DELETE", "/me/sessions/"+phoneSessionID, laptop)
+	if status != 200 {
+		t.Fatalf("Expected status 200 revoking session, got %d", status)
+	}
+	if status, _ := sessionRequest(t, app, "GET", "/me/sessions", phone); status != 401 {
+		t.Fatalf("Expected revoked session token to be rejected, got %d", status)
+	}
+
+	// Revoke all other sessions
+	status, body = sessionRequest(t, app, "DELETE", "/me/sessions", laptop)
+	if status != 200 || body["revoked"].(float64) != 1 {
+		t.Fatalf("Expected 1 other session revoked, got %d %v", status, body)
+	}
+	if status, _ := sessionRequest(t, app, "GET", "/me/sessions", tablet); status != 401 {
+		t.Fatalf("Expected other session token to be rejected, got %d", status)
+	}
+
+	status, body = sessionRequest(t, app, "GET", "/me/sessions", laptop)
+	if status != 200 || body["count"].(float64) != 1 {
+		t.Fatalf("Expected only the current session to remain, got %d %v", status, body)
+	}
+
+	t.Log("✓ Users can list and revoke their own sessions")
+}
+
+func TestCannotRevokeAnotherUsersSession(t *testing.T) {
+	app := setupSessionTestApp()
+
+	john := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+
+	_, body := sessionRequest(t, app, "GET", "/me/sessions", admin)
+	adminSessionID := body["sessions"].([]interface{})[0].(map[string]interface{})["id"].(string)
+
+	if status, _ := sessionRequest(t, app, "DELETE", "/me/sessions/"+adminSessionID, john); status != 404 {
+		t.Fatalf("Expected 404 revoking another user's session, got %d", status)
+	}
+
+	t.Log("✓ Sessions of other users cannot be revoked")
+}
+
+func TestTenantAdminForceLogout(t *testing.T) {
+	app := setupSessionTestApp()
+
+	john := loginAs(t, app, "john@demo-corp.zplus.com", "user123", "demo-corp", "laptop")
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+
+	// Regular users cannot force logout
+	if status, _ := sessionRequest(t, app, "DELETE", "/users/tenant-admin-1/sessions", john); status != 403 {
+		t.Fatalf("Expected 403 for regular user, got %d", status)
+	}
+
+	// Users outside the tenant are not found
+	if status, _ := sessionRequest(t, app, "DELETE", "/users/sys-admin-1/sessions", admin); status != 404 {
+		t.Fatalf("Expected 404 for user of another tenant, got %d", status)
+	}
+
+	status, body := sessionRequest(t, app, "DELETE", "/users/customer-1/sessions", admin)
+	if status != 200 || body["revoked"].(float64) != 1 {
+		t.Fatalf("Expected 1 session revoked, got %d %v", status, body)
+	}
+	if status, _ := sessionRequest(t, app, "GET", "/me/sessions", john); status != 401 {
+		t.Fatalf("Expected force-logged-out token to be rejected, got %d", status)
+	}
+
+	t.Log("✓ Tenant admins can force logout users of their tenant")
+}
+
+func TestAllSessionsRequiresSystemAdmin(t *testing.T) {
+	app := setupSessionTestApp()
+
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+	if status, _ := sessionRequest(t, app, "GET", "/sessions", admin); status != 403 {
+		t.Fatalf("Expected 403 for tenant admin, got %d", status)
+	}
+
+	systemAdmin := loginAs(t, app, "admin@zplus.com", "admin123", "system", "laptop")
+	if status, _ := sessionRequest(t, app, "GET", "/sessions", systemAdmin); status != 200 {
+		t.Fatalf("Expected 200 for system admin, got %d", status)
+	}
+
+	t.Log("✓ Platform-wide session listing restricted to system admins")
+}
+
+func TestRoleClaimDoesNotGrantAdminAccess(t *testing.T) {
+	users := newTestUserStore()
+	tokenManager := auth.NewTokenManager("your-secret-key", "zplus-saas")
+	authHandler := handlers.NewAuthHandler(users, tokenManager)
+
+	app := fiber.New()
+	app.Post("/login", authHandler.Login)
+	app.Get("/sessions", authHandler.RequireAuth, authHandler.RequireSystemAdmin, authHandler.GetSessions)
+	app.Delete("/users/:id/sessions", authHandler.RequireAuth, authHandler.ForceLogoutUser)
+
+	// A tenant role named system_admin does not make its holders system admins
+	mallory := &models.User{ID: "mallory-1", TenantID: "demo-corp", Email: "mallory@demo-corp.zplus.com", Roles: []string{"system_admin"}, Status: "active"}
+	users.AddUser("demo-corp", mallory, "mallory123")
+	token := loginAs(t, app, "mallory@demo-corp.zplus.com", "mallory123", "demo-corp", "laptop")
+	if status, _ := sessionRequest(t, app, "GET", "/sessions", token); status != 403 {
+		t.Fatalf("Expected 403 for a tenant role named system_admin, got %d", status)
+	}
+
+	// Neither does a token carrying the claim
+	forged, err := tokenManager.GenerateTokenPair("customer-1", "demo-corp", "system_admin")
+	if err != nil {
+		t.Fatalf("Failed to generate tokens: %v", err)
+	}
+	if status, _ := sessionRequest(t, app, "GET", "/sessions", forged.AccessToken); status != 403 {
+		t.Fatalf("Expected 403 for a system_admin claim of a tenant user, got %d", status)
+	}
+	if status, _ := sessionRequest(t, app, "DELETE", "/users/tenant-admin-1/sessions", forged.AccessToken); status != 403 {
+		t.Fatalf("Expected 403 for a tenant user with an admin claim, got %d", status)
+	}
+
+	// A demoted tenant admin loses access with the token issued before
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+	demoted, _ := users.GetUser("demo-corp", "tenant-admin-1")
+	demoted.Roles = []string{"user"}
+	if status, _ := sessionRequest(t, app, "DELETE", "/users/customer-1/sessions", admin); status != 403 {
+		t.Fatalf("Expected 403 for a demoted tenant admin, got %d", status)
+	}
+
+	t.Log("✓ Admin access is decided from stored accounts, not from the role claim")
+}
@@ -0,0 +1,219 @@
+package sso
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// discoveryTTL is how long a provider's discovery document is cached
+const discoveryTTL = time.Hour
+
+// oidcDiscovery is the part of the OpenID provider metadata the login flow needs
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// OIDCProvider runs the authorization code flow with PKCE against one OpenID Connect issuer.
+// Its discovery document and signing keys are cached, so providers should be reused.
+type OIDCProvider struct {
+	issuer    string
+	client    *http.Client
+	discovery *oidcDiscovery
+	keySet    *auth.RemoteKeySet
+	fetchedAt time.Time
+	mutex     sync.Mutex
+}
+
+// NewOIDCProvider creates a provider for an issuer
+func NewOIDCProvider(issuer string) *OIDCProvider {
+	return &OIDCProvider{
+		issuer: strings.TrimRight(issuer, "/"),
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// AuthCodeURL returns the authorization endpoint URL the user is redirected to
+func (p *OIDCProvider) AuthCodeURL(config *OIDCConfig, redirectURL, state, nonce, verifier string) (string, error) {
+	discovery, _, err := p.load()
+	if err != nil {
+		return "", err
+	}
+
+	scopes := config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "email", "profile"}
+	}
+
+	params := url.Values{}
+	params.Set("response_type", "code")
+	params.Set("client_id", config.ClientID)
+	params.Set("redirect_uri", redirectURL)
+	params.Set("scope", strings.Join(scopes, " "))
+	params.Set("state", state)
+	params.Set("nonce", nonce)
+	params.Set("code_challenge", PKCEChallenge(verifier))
+	params.Set("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+	return discovery.AuthorizationEndpoint + separator + params.Encode(), nil
+}
+
+// Exchange redeems an authorization code and returns the identity from the verified ID token
+func (p *OIDCProvider) Exchange(config *OIDCConfig, redirectURL, code, verifier, nonce string) (*Identity, error) {
+	discovery, keySet, err := p.load()
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", redirectURL)
+	form.Set("client_id", config.ClientID)
+	form.Set("code_verifier", verifier)
+
+	req, err := http.NewRequest("POST", discovery.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	if config.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(config.ClientID), url.QueryEscape(config.ClientSecret))
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange authorization code: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: token endpoint returned status %d", ErrInvalidResponse, resp.StatusCode)
+	}
+
+	var tokens struct {
+		IDToken string `json:"id_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tokens); err != nil || tokens.IDToken == "" {
+		return nil, fmt.Errorf("%w: no id_token in token response", ErrInvalidResponse)
+	}
+
+	return p.verifyIDToken(config, discovery, keySet, tokens.IDToken, nonce)
+}
+
+// PKCEChallenge returns the S256 code challenge of a verifier (RFC 7636)
+func PKCEChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// verifyIDToken checks the signature, issuer, audience, expiry and nonce of an ID token
+func (p *OIDCProvider) verifyIDToken(config *OIDCConfig, discovery *oidcDiscovery, keySet *auth.RemoteKeySet, idToken, nonce string) (*Identity, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := keySet.VerificationKey(kid)
+		if err != nil {
+			return nil, err
+		}
+		if key.SigningMethod() == nil || key.SigningMethod().Alg() != token.Method.Alg() {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return key.PublicKey, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	if !claims.VerifyIssuer(discovery.Issuer, true) {
+		return nil, fmt.Errorf("%w: unexpected issuer", ErrInvalidResponse)
+	}
+	if !claims.VerifyAudience(config.ClientID, true) {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidResponse)
+	}
+	if _, hasExpiry := claims["exp"]; !hasExpiry {
+		return nil, fmt.Errorf("%w: ID token has no expiry", ErrInvalidResponse)
+	}
+	if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrInvalidResponse)
+	}
+
+	// An address the IdP says is unverified cannot identify a tenant user
+	if verified, present := claims["email_verified"].(bool); present && !verified {
+		return nil, fmt.Errorf("%w: email address not verified", ErrInvalidResponse)
+	}
+
+	identity := &Identity{}
+	identity.Subject, _ = claims["sub"].(string)
+	identity.Email, _ = claims["email"].(string)
+	identity.FirstName, _ = claims["given_name"].(string)
+	identity.LastName, _ = claims["family_name"].(string)
+	if identity.FirstName == "" {
+		name, _ := claims["name"].(string)
+		identity.FirstName, identity.LastName = splitName(name)
+	}
+
+	groupsClaim := config.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+	identity.Groups = stringList(claims[groupsClaim])
+
+	if identity.Subject == "" || identity.Email == "" {
+		return nil, fmt.Errorf("%w: ID token has no subject or email", ErrInvalidResponse)
+	}
+	return identity, nil
+}
+
+// load returns the cached discovery document and key set, fetching them when needed
+func (p *OIDCProvider) load() (*oidcDiscovery, *auth.RemoteKeySet, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.discovery != nil && time.Since(p.fetchedAt) < discoveryTTL {
+		return p.discovery, p.keySet, nil
+	}
+
+	resp, err := p.client.Get(p.issuer + "/.well-known/openid-configuration")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch OIDC discovery: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, nil, fmt.Errorf("failed to fetch OIDC discovery: status %d", resp.StatusCode)
+	}
+
+	var discovery oidcDiscovery
+	if err := json.NewDecoder(resp.Body).Decode(&discovery); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode OIDC discovery: %v", err)
+	}
+	if strings.TrimRight(discovery.Issuer, "/") != p.issuer || discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return nil, nil, fmt.Errorf("incomplete OIDC discovery for %s", p.issuer)
+	}
+
+	if p.keySet == nil || p.discovery.JWKSURI != discovery.JWKSURI {
+		p.keySet = auth.NewRemoteKeySet(discovery.JWKSURI, discoveryTTL)
+	}
+	p.discovery = &discovery
+	p.fetchedAt = time.Now()
+	return p.discovery, p.keySet, nil
+}
@@ -0,0 +1,343 @@
+package sso
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/beevik/etree"
+	dsig "github.com/russellhaering/goxmldsig"
+	"github.com/russellhaering/goxmldsig/etreeutils"
+)
+
+// SAML constants
+const (
+	samlStatusSuccess = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	samlBindingPOST   = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	samlEmailFormat   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+
+	// samlClockSkew is the clock difference tolerated when checking assertion validity
+	samlClockSkew = 2 * time.Minute
+)
+
+// SAMLServiceProvider runs SP-initiated login with the HTTP-Redirect binding for requests
+// and the HTTP-POST binding for responses
+type SAMLServiceProvider struct {
+	config   *SAMLConfig
+	entityID string // Our entity ID, the audience of assertions
+	acsURL   string // Assertion consumer service receiving responses
+}
+
+// NewSAMLServiceProvider creates a service provider for a tenant's IdP
+func NewSAMLServiceProvider(config *SAMLConfig, entityID, acsURL string) *SAMLServiceProvider {
+	return &SAMLServiceProvider{
+		config:   config,
+		entityID: entityID,
+		acsURL:   acsURL,
+	}
+}
+
+// AuthnRequestURL returns the IdP URL starting a login. The response must answer requestID.
+func (sp *SAMLServiceProvider) AuthnRequestURL(requestID, relayState string) (string, error) {
+	request := fmt.Sprintf(`<samlp:AuthnRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="%s" Version="2.0" IssueInstant="%s" Destination="%s" AssertionConsumerServiceURL="%s" ProtocolBinding="%s"><saml:Issuer>%s</saml:Issuer><samlp:NameIDPolicy Format="%s" AllowCreate="true"/></samlp:AuthnRequest>`,
+		requestID, time.Now().UTC().Format(time.RFC3339), xmlEscape(sp.config.IdPSSOURL), xmlEscape(sp.acsURL),
+		samlBindingPOST, xmlEscape(sp.entityID), samlEmailFormat)
+
+	// HTTP-Redirect binding: raw DEFLATE, then base64
+	var compressed bytes.Buffer
+	writer, err := flate.NewWriter(&compressed, flate.DefaultCompression)
+	if err != nil {
+		return "", err
+	}
+	writer.Write([]byte(request))
+	writer.Close()
+
+	params := url.Values{}
+	params.Set("SAMLRequest", base64.StdEncoding.EncodeToString(compressed.Bytes()))
+	params.Set("RelayState", relayState)
+
+	separator := "?"
+	if strings.Contains(sp.config.IdPSSOURL, "?") {
+		separator = "&"
+	}
+	return sp.config.IdPSSOURL + separator + params.Encode(), nil
+}
+
+// ParseResponse verifies a base64 encoded SAMLResponse answering the given request and
+// returns the identity of its assertion. Only signed content is read.
+func (sp *SAMLServiceProvider) ParseResponse(encoded, requestID string) (*Identity, error) {
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(encoded), ""))
+	if err != nil {
+		return nil, fmt.Errorf("%w: response is not base64", ErrInvalidResponse)
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, fmt.Errorf("%w: malformed XML", ErrInvalidResponse)
+	}
+	response := doc.Root()
+	if response == nil || response.Tag != "Response" {
+		return nil, fmt.Errorf("%w: not a SAML response", ErrInvalidResponse)
+	}
+
+	assertion, err := sp.verifiedAssertion(response)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusCode := findChild(response, "Status", "StatusCode"); statusCode == nil || statusCode.SelectAttrValue("Value", "") != samlStatusSuccess {
+		return nil, fmt.Errorf("%w: login failed at the identity provider", ErrInvalidResponse)
+	}
+	if destination := response.SelectAttrValue("Destination", ""); destination != "" && destination != sp.acsURL {
+		return nil, fmt.Errorf("%w: unexpected destination", ErrInvalidResponse)
+	}
+	if inResponseTo := response.SelectAttrValue("InResponseTo", ""); inResponseTo != requestID {
+		return nil, fmt.Errorf("%w: response does not answer the login request", ErrInvalidResponse)
+	}
+
+	if err := sp.checkAssertion(assertion, requestID); err != nil {
+		return nil, err
+	}
+	return sp.identity(assertion)
+}
+
+// Metadata returns the SP metadata document to register with the IdP
+func (sp *SAMLServiceProvider) Metadata() []byte {
+	return []byte(fmt.Sprintf(`<?xml version="1.0" encoding="UTF-8"?>
+<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s">
+  <md:SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">
+    <md:NameIDFormat>%s</md:NameIDFormat>
+    <md:AssertionConsumerService Binding="%s" Location="%s" index="0" isDefault="true"/>
+  </md:SPSSODescriptor>
+</md:EntityDescriptor>
+`, xmlEscape(sp.entityID), samlEmailFormat, samlBindingPOST, xmlEscape(sp.acsURL)))
+}
+
+// verifiedAssertion returns the assertion covered by a valid signature of the IdP, either
+// on the whole response or on the assertion itself
+func (sp *SAMLServiceProvider) verifiedAssertion(response *etree.Element) (*etree.Element, error) {
+	cert, err := parseCertificate(sp.config.IdPCertificate)
+	if err != nil {
+		return nil, err
+	}
+	validator := dsig.NewDefaultValidationContext(&dsig.MemoryX509CertificateStore{
+		Roots: []*x509.Certificate{cert},
+	})
+
+	if findChild(response, "EncryptedAssertion") != nil {
+		return nil, fmt.Errorf("%w: encrypted assertions are not supported", ErrInvalidResponse)
+	}
+
+	validated, err := validator.Validate(response)
+	if err == nil {
+		return singleAssertion(validated)
+	}
+	if !errors.Is(err, dsig.ErrMissingSignature) {
+		return nil, fmt.Errorf("%w: invalid signature: %v", ErrInvalidResponse, err)
+	}
+
+	// Only the assertion is signed. It is detached with its namespace declarations so
+	// the signature can be checked on its own.
+	assertion, err := singleAssertion(response)
+	if err != nil {
+		return nil, err
+	}
+	nsContext, err := etreeutils.NSBuildParentContext(assertion)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+	detached, err := etreeutils.NSDetatch(nsContext, assertion)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidResponse, err)
+	}
+
+	validated, err = validator.Validate(detached)
+	if err != nil {
+		return nil, fmt.Errorf("%w: assertion is not signed by the identity provider", ErrInvalidResponse)
+	}
+	return validated, nil
+}
+
+// checkAssertion checks the issuer, validity period, audience and subject confirmation
+func (sp *SAMLServiceProvider) checkAssertion(assertion *etree.Element, requestID string) error {
+	now := time.Now()
+
+	// Without a configured entity ID any IdP trusted with the same certificate could log in
+	if sp.config.IdPEntityID == "" {
+		return fmt.Errorf("%w: identity provider entity ID is not configured", ErrInvalidResponse)
+	}
+	if issuer := findChild(assertion, "Issuer"); issuer == nil || strings.TrimSpace(issuer.Text()) != sp.config.IdPEntityID {
+		return fmt.Errorf("%w: unexpected issuer", ErrInvalidResponse)
+	}
+
+	conditions := findChild(assertion, "Conditions")
+	if conditions == nil {
+		return fmt.Errorf("%w: assertion has no conditions", ErrInvalidResponse)
+	}
+	if !withinValidity(conditions, now) {
+		return fmt.Errorf("%w: assertion expired or not yet valid", ErrInvalidResponse)
+	}
+
+	audienceMatched := false
+	for _, restriction := range childElements(conditions, "AudienceRestriction") {
+		for _, audience := range childElements(restriction, "Audience") {
+			if strings.TrimSpace(audience.Text()) == sp.entityID {
+				audienceMatched = true
+			}
+		}
+	}
+	if !audienceMatched {
+		return fmt.Errorf("%w: assertion is meant for another service", ErrInvalidResponse)
+	}
+
+	// A bearer confirmation must be addressed to us, answer our request and be current
+	subject := findChild(assertion, "Subject")
+	if subject == nil {
+		return fmt.Errorf("%w: assertion has no subject", ErrInvalidResponse)
+	}
+	for _, confirmation := range childElements(subject, "SubjectConfirmation") {
+		data := findChild(confirmation, "SubjectConfirmationData")
+		if data == nil {
+			continue
+		}
+		if data.SelectAttrValue("Recipient", "") == sp.acsURL &&
+			data.SelectAttrValue("InResponseTo", "") == requestID &&
+			withinValidity(data, now) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: no valid subject confirmation", ErrInvalidResponse)
+}
+
+// identity reads the user from the assertion's NameID and attributes
+func (sp *SAMLServiceProvider) identity(assertion *etree.Element) (*Identity, error) {
+	nameID := findChild(assertion, "Subject", "NameID")
+	if nameID == nil || strings.TrimSpace(nameID.Text()) == "" {
+		return nil, fmt.Errorf("%w: assertion has no NameID", ErrInvalidResponse)
+	}
+
+	attributes := make(map[string][]string)
+	if statement := findChild(assertion, "AttributeStatement"); statement != nil {
+		for _, attribute := range childElements(statement, "Attribute") {
+			name := attribute.SelectAttrValue("Name", "")
+			for _, value := range childElements(attribute, "AttributeValue") {
+				attributes[name] = append(attributes[name], strings.TrimSpace(value.Text()))
+			}
+		}
+	}
+
+	identity := &Identity{
+		Subject:   strings.TrimSpace(nameID.Text()),
+		Email:     firstValue(attributes, "email", "mail", "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress"),
+		FirstName: firstValue(attributes, "firstName", "givenName", "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname"),
+		LastName:  firstValue(attributes, "lastName", "sn", "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"),
+	}
+	if identity.Email == "" && strings.Contains(identity.Subject, "@") {
+		identity.Email = identity.Subject
+	}
+	if identity.Email == "" {
+		return nil, fmt.Errorf("%w: assertion has no email address", ErrInvalidResponse)
+	}
+
+	groupsAttribute := sp.config.GroupsAttribute
+	if groupsAttribute == "" {
+		groupsAttribute = "groups"
+	}
+	identity.Groups = attributes[groupsAttribute]
+
+	return identity, nil
+}
+
+// parseCertificate parses a PEM certificate, or a bare base64 DER certificate as found in IdP metadata
+func parseCertificate(data string) (*x509.Certificate, error) {
+	der := []byte(nil)
+	if block, _ := pem.Decode([]byte(data)); block != nil {
+		der = block.Bytes
+	} else {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(data), ""))
+		if err != nil {
+			return nil, errors.New("saml.idp_certificate is not a PEM or base64 certificate")
+		}
+		der = decoded
+	}
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, fmt.Errorf("invalid saml.idp_certificate: %v", err)
+	}
+	return cert, nil
+}
+
+// singleAssertion returns the only Assertion child of a response
+func singleAssertion(response *etree.Element) (*etree.Element, error) {
+	assertions := childElements(response, "Assertion")
+	if len(assertions) != 1 {
+		return nil, fmt.Errorf("%w: expected exactly one assertion", ErrInvalidResponse)
+	}
+	return assertions[0], nil
+}
+
+// withinValidity checks the NotBefore and NotOnOrAfter attributes of an element
+func withinValidity(el *etree.Element, now time.Time) bool {
+	if notBefore := el.SelectAttrValue("NotBefore", ""); notBefore != "" {
+		t, err := time.Parse(time.RFC3339, notBefore)
+		if err != nil || now.Add(samlClockSkew).Before(t) {
+			return false
+		}
+	}
+	if notOnOrAfter := el.SelectAttrValue("NotOnOrAfter", ""); notOnOrAfter != "" {
+		t, err := time.Parse(time.RFC3339, notOnOrAfter)
+		if err != nil || !now.Add(-samlClockSkew).Before(t) {
+			return false
+		}
+	}
+	return true
+}
+
+// findChild follows a path of child element local names, ignoring namespace prefixes
+func findChild(el *etree.Element, path ...string) *etree.Element {
+	for _, tag := range path {
+		children := childElements(el, tag)
+		if len(children) == 0 {
+			return nil
+		}
+		el = children[0]
+	}
+	return el
+}
+
+// childElements returns the direct children with a local name
+func childElements(el *etree.Element, tag string) []*etree.Element {
+	result := make([]*etree.Element, 0)
+	for _, child := range el.ChildElements() {
+		if child.Tag == tag {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+// firstValue returns the first value of the first attribute present
+func firstValue(attributes map[string][]string, names ...string) string {
+	for _, name := range names {
+		if values := attributes[name]; len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+// xmlEscape escapes text for use in XML attributes and elements
+func xmlEscape(text string) string {
+	var escaped bytes.Buffer
+	xml.EscapeText(&escaped, []byte(text))
+	return escaped.String()
+}
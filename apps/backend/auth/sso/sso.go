@@ -0,0 +1,192 @@
+package sso
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Supported single sign-on protocols
+const (
+	ProtocolOIDC = "oidc"
+	ProtocolSAML = "saml"
+)
+
+// SettingsKey is the key of the SSO configuration in Tenant.Settings
+const SettingsKey = "sso"
+
+// SSO errors
+var (
+	ErrNotConfigured   = errors.New("single sign-on is not configured")
+	ErrInvalidResponse = errors.New("invalid identity provider response")
+	ErrNoRole          = errors.New("no tenant role mapped for the user's groups")
+)
+
+// Config is the single sign-on configuration of a tenant
+type Config struct {
+	Enabled  bool   `json:"enabled"`
+	Protocol string `json:"protocol"` // "oidc" or "saml"
+
+	// Enforced disables password logins, except for tenant admins so a broken IdP
+	// configuration can still be fixed
+	Enforced bool `json:"enforced"`
+
+	OIDC *OIDCConfig `json:"oidc,omitempty"`
+	SAML *SAMLConfig `json:"saml,omitempty"`
+
+	// GroupRoles maps IdP group names to tenant role names
+	GroupRoles map[string]string `json:"group_roles"`
+	// DefaultRole is given to users without a mapped group. Without it they cannot log in.
+	DefaultRole string `json:"default_role"`
+}
+
+// OIDCConfig configures an OpenID Connect identity provider
+type OIDCConfig struct {
+	Issuer       string   `json:"issuer"` // Discovery is loaded from {issuer}/.well-known/openid-configuration
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret,omitempty"`
+	Scopes       []string `json:"scopes,omitempty"`       // Defaults to openid, email and profile
+	GroupsClaim  string   `json:"groups_claim,omitempty"` // Defaults to "groups"
+}
+
+// SAMLConfig configures a SAML 2.0 identity provider
+type SAMLConfig struct {
+	IdPEntityID     string `json:"idp_entity_id"`
+	IdPSSOURL       string `json:"idp_sso_url"`                // HTTP-Redirect binding endpoint
+	IdPCertificate  string `json:"idp_certificate"`            // PEM certificate signing responses or assertions
+	GroupsAttribute string `json:"groups_attribute,omitempty"` // Defaults to "groups"
+}
+
+// Identity is a user authenticated by an identity provider
+type Identity struct {
+	Subject   string
+	Email     string
+	FirstName string
+	LastName  string
+	Groups    []string
+}
+
+// ConfigFromSettings reads the SSO configuration from tenant settings
+func ConfigFromSettings(settings map[string]interface{}) (*Config, error) {
+	raw, exists := settings[SettingsKey]
+	if !exists || raw == nil {
+		return nil, ErrNotConfigured
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode SSO settings: %v", err)
+	}
+
+	var config Config
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("invalid SSO settings: %v", err)
+	}
+	return &config, nil
+}
+
+// Settings converts the configuration into its tenant settings value
+func (c *Config) Settings() (map[string]interface{}, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return nil, err
+	}
+
+	var value map[string]interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{SettingsKey: value}, nil
+}
+
+// Validate checks that the settings of the selected protocol are complete
+func (c *Config) Validate() error {
+	switch c.Protocol {
+	case ProtocolOIDC:
+		if c.OIDC == nil || c.OIDC.Issuer == "" || c.OIDC.ClientID == "" {
+			return errors.New("oidc.issuer and oidc.client_id are required")
+		}
+	case ProtocolSAML:
+		if c.SAML == nil || c.SAML.IdPEntityID == "" || c.SAML.IdPSSOURL == "" || c.SAML.IdPCertificate == "" {
+			return errors.New("saml.idp_entity_id, saml.idp_sso_url and saml.idp_certificate are required")
+		}
+		if _, err := parseCertificate(c.SAML.IdPCertificate); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unsupported protocol %q", c.Protocol)
+	}
+	return nil
+}
+
+// Roles returns the tenant roles of an identity's groups, or the default role when none is mapped
+func (c *Config) Roles(groups []string) []string {
+	roles := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, group := range groups {
+		role, mapped := c.GroupRoles[group]
+		if mapped && role != "" && !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+
+	if len(roles) == 0 && c.DefaultRole != "" {
+		roles = append(roles, c.DefaultRole)
+	}
+	return roles
+}
+
+// MappedRoles reports whether any of the groups maps to a role, i.e. the IdP decides the user's roles
+func (c *Config) MappedRoles(groups []string) bool {
+	for _, group := range groups {
+		if c.GroupRoles[group] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// RandomString returns a URL-safe random string, used for PKCE verifiers, nonces and request IDs
+func RandomString() (string, error) {
+	random := make([]byte, 32)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(random), nil
+}
+
+// stringList reads a claim or attribute that may be a single string or a list
+func stringList(value interface{}) []string {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				result = append(result, s)
+			}
+		}
+		return result
+	default:
+		return nil
+	}
+}
+
+// splitName splits a display name into first and last name
+func splitName(name string) (string, string) {
+	name = strings.TrimSpace(name)
+	if idx := strings.Index(name, " "); idx != -1 {
+		return name[:idx], strings.TrimSpace(name[idx+1:])
+	}
+	return name, ""
+}
@@ -0,0 +1,504 @@
+package main
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/beevik/etree"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang-jwt/jwt/v4"
+	dsig "github.com/russellhaering/goxmldsig"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/sso"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/store"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+const (
+	ssoTestBaseURL = "http://auth.test"
+	ssoTestAppURL  = "http://app.test"
+)
+
+// setupSSOTestApp returns an app with login and SSO routes and its user store
+func setupSSOTestApp() (*fiber.App, *store.MemoryUserStore) {
+	app := fiber.New()
+	users := newTestUserStore()
+	authHandler := handlers.NewAuthHandler(users, auth.NewTokenManager("your-secret-key", "zplus-saas"))
+	roles := newTestRoleStore()
+	roles.AddRole("demo-corp", &models.Role{Name: "sales_rep", DisplayName: "Sales Rep"}, "customers:read", "customers:write")
+	ssoHandler := handlers.NewSSOHandler(authHandler, roles, ssoTestBaseURL, ssoTestAppURL)
+
+	app.Post("/login", authHandler.Login)
+	app.Get("/sso/:tenant/login", ssoHandler.Login)
+	app.Get("/sso/:tenant/oidc/callback", ssoHandler.OIDCCallback)
+	app.Post("/sso/:tenant/saml/acs", ssoHandler.SAMLACS)
+	app.Get("/sso/:tenant/saml/metadata", ssoHandler.SAMLMetadata)
+	app.Post("/sso/token", ssoHandler.Token)
+	app.Get("/sso/:tenant/config", authHandler.RequireAuth, ssoHandler.GetConfig)
+	app.Put("/sso/:tenant/config", authHandler.RequireAuth, ssoHandler.UpdateConfig)
+
+	return app, users
+}
+
+// ssoRequest sends a request and returns the response, which is usually a redirect
+func ssoRequest(t *testing.T, app *fiber.App, method, path, contentType string, body io.Reader) *http.Response {
+	req, _ := http.NewRequest(method, path, body)
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, path, err)
+	}
+	return resp
+}
+
+// redirectQuery returns the query of a redirect response
+func redirectQuery(t *testing.T, resp *http.Response, prefix string) url.Values {
+	location := resp.Header.Get("Location")
+	if resp.StatusCode != http.StatusFound || !strings.HasPrefix(location, prefix) {
+		t.Fatalf("Expected redirect to %s, got %d %q", prefix, resp.StatusCode, location)
+	}
+	redirect, _ := url.Parse(location)
+	return redirect.Query()
+}
+
+// redeemSSOCode exchanges the code of an SSO callback for a login
+func redeemSSOCode(t *testing.T, app *fiber.App, resp *http.Response) map[string]interface{} {
+	query := redirectQuery(t, resp, ssoTestAppURL+"/sso/callback")
+	if query.Get("code") == "" {
+		t.Fatalf("Expected a login code, got error %q", query.Get("error"))
+	}
+
+	status, body := postJSON(t, app, "/sso/token", "", models.SSOTokenRequest{Code: query.Get("code")})
+	if status != 200 || body["token"] == nil {
+		t.Fatalf("Expected status 200 redeeming the code, got %d %v", status, body)
+	}
+	return body
+}
+
+// mockOIDCProvider is a minimal OpenID provider issuing ID tokens for codes handed out by the test
+type mockOIDCProvider struct {
+	server     *httptest.Server
+	keyManager *auth.KeyManager
+	codes      map[string]mockOIDCCode
+	forge      bool // Sign ID tokens with an unpublished key under the published key ID
+	mutex      sync.Mutex
+}
+
+// mockOIDCCode is an authorization code with the login it completes
+type mockOIDCCode struct {
+	challenge string
+	nonce     string
+	email     string
+	groups    []string
+}
+
+func newMockOIDCProvider(t *testing.T) *mockOIDCProvider {
+	provider := &mockOIDCProvider{keyManager: auth.NewKeyManager(time.Hour), codes: make(map[string]mockOIDCCode)}
+	if _, err := provider.keyManager.Rotate(auth.AlgorithmRS256); err != nil {
+		t.Fatalf("Failed to generate IdP key: %v", err)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 provider.server.URL,
+			"authorization_endpoint": provider.server.URL + "/authorize",
+			"token_endpoint":         provider.server.URL + "/token",
+			"jwks_uri":               provider.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(provider.keyManager.JWKS())
+	})
+	mux.HandleFunc("/token", provider.token)
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+
+	return provider
+}
+
+// authorize logs a user in at the authorization URL the auth service redirected to and
+// returns the callback query the IdP would redirect back with
+func (p *mockOIDCProvider) authorize(t *testing.T, authURL url.Values, email string, groups ...string) string {
+	if authURL.Get("code_challenge_method") != "S256" || authURL.Get("client_id") != "zplus-client" {
+		t.Fatalf("Expected PKCE authorization request, got %v", authURL)
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	code := fmt.Sprintf("code-%d", len(p.codes)+1)
+	p.codes[code] = mockOIDCCode{challenge: authURL.Get("code_challenge"), nonce: authURL.Get("nonce"), email: email, groups: groups}
+	return url.Values{"code": {code}, "state": {authURL.Get("state")}}.Encode()
+}
+
+// token redeems a code when the PKCE verifier matches its challenge
+func (p *mockOIDCProvider) token(w http.ResponseWriter, r *http.Request) {
+	r.ParseForm()
+	p.mutex.Lock()
+	code, exists := p.codes[r.Form.Get("code")]
+	delete(p.codes, r.Form.Get("code"))
+	p.mutex.Unlock()
+
+	clientID, secret, _ := r.BasicAuth()
+	if !exists || clientID != "zplus-client" || secret != "s3cret" || sso.PKCEChallenge(r.Form.Get("code_verifier")) != code.challenge {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+		return
+	}
+
+	key, _ := p.keyManager.ActiveKey()
+	if p.forge {
+		published := key.ID
+		key, _ = auth.GenerateSigningKey(auth.AlgorithmRS256)
+		key.ID = published
+	}
+	idToken := jwt.NewWithClaims(key.SigningMethod(), jwt.MapClaims{
+		"iss":            p.server.URL,
+		"aud":            "zplus-client",
+		"sub":            "idp|" + code.email,
+		"email":          code.email,
+		"email_verified": true,
+		"name":           "Jane Roe",
+		"groups":         code.groups,
+		"nonce":          code.nonce,
+		"iat":            time.Now().Unix(),
+		"exp":            time.Now().Add(5 * time.Minute).Unix(),
+	})
+	idToken.Header["kid"] = key.ID
+	signed, _ := idToken.SignedString(key.PrivateKey)
+
+	json.NewEncoder(w).Encode(map[string]string{"access_token": "at", "token_type": "Bearer", "id_token": signed})
+}
+
+func TestOIDCLoginProvisionsUsers(t *testing.T) {
+	app, users := setupSSOTestApp()
+	idp := newMockOIDCProvider(t)
+	users.SetTenantSettings("demo-corp", map[string]interface{}{"sso": map[string]interface{}{
+		"enabled":     true,
+		"protocol":    "oidc",
+		"oidc":        map[string]interface{}{"issuer": idp.server.URL, "client_id": "zplus-client", "client_secret": "s3cret"},
+		"group_roles": map[string]interface{}{"engineering": "developer", "finance": "accountant"},
+	}})
+
+	login := func(email string, groups ...string) *http.Response {
+		authURL := redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/login", "", nil), idp.server.URL+"/authorize")
+		if authURL.Get("redirect_uri") != ssoTestBaseURL+"/sso/demo-corp/oidc/callback" {
+			t.Fatalf("Unexpected redirect_uri %q", authURL.Get("redirect_uri"))
+		}
+		return ssoRequest(t, app, "GET", "/sso/demo-corp/oidc/callback?"+idp.authorize(t, authURL, email, groups...), "", nil)
+	}
+
+	// First login creates the user with the roles of its groups
+	body := redeemSSOCode(t, app, login("jane@demo-corp.zplus.com", "engineering", "finance", "all-staff"))
+	user := body["user"].(map[string]interface{})
+	if roles := stringList(user["roles"]); len(roles) != 2 || roles[0] != "developer" || roles[1] != "accountant" {
+		t.Fatalf("Expected developer and accountant roles, got %v", roles)
+	}
+	if user["first_name"] != "Jane" || user["status"] != "active" {
+		t.Fatalf("Expected provisioned active user Jane, got %v", user)
+	}
+
+	// Later logins follow group changes
+	body = redeemSSOCode(t, app, login("jane@demo-corp.zplus.com", "finance"))
+	if roles := stringList(body["user"].(map[string]interface{})["roles"]); len(roles) != 1 || roles[0] != "accountant" {
+		t.Fatalf("Expected roles to follow groups, got %v", roles)
+	}
+
+	// Unknown users without a mapped group are refused, existing users keep their roles
+	if query := redirectQuery(t, login("new@demo-corp.zplus.com", "all-staff"), ssoTestAppURL); query.Get("error") != "SSO_NO_ROLE" {
+		t.Fatalf("Expected SSO_NO_ROLE, got %v", query)
+	}
+	body = redeemSSOCode(t, app, login("john@demo-corp.zplus.com"))
+	if roles := stringList(body["user"].(map[string]interface{})["roles"]); len(roles) != 1 || roles[0] != "user" {
+		t.Fatalf("Expected existing user to keep its roles, got %v", roles)
+	}
+
+	// Only mapped roles follow the groups, other roles are kept
+	body = redeemSSOCode(t, app, login("admin@demo-corp.zplus.com", "engineering"))
+	if roles := stringList(body["user"].(map[string]interface{})["roles"]); len(roles) != 2 || roles[0] != "tenant_admin" || roles[1] != "developer" {
+		t.Fatalf("Expected tenant_admin to be kept next to developer, got %v", roles)
+	}
+	body = redeemSSOCode(t, app, login("admin@demo-corp.zplus.com", "all-staff"))
+	if roles := stringList(body["user"].(map[string]interface{})["roles"]); len(roles) != 1 || roles[0] != "tenant_admin" {
+		t.Fatalf("Expected only the mapped role to be removed, got %v", roles)
+	}
+
+	// The state and the login code are single use
+	authURL := redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/login", "", nil), idp.server.URL+"/authorize")
+	callback := "/sso/demo-corp/oidc/callback?" + idp.authorize(t, authURL, "jane@demo-corp.zplus.com", "finance")
+	code := redirectQuery(t, ssoRequest(t, app, "GET", callback, "", nil), ssoTestAppURL).Get("code")
+	if query := redirectQuery(t, ssoRequest(t, app, "GET", callback, "", nil), ssoTestAppURL); query.Get("error") != "INVALID_SSO_STATE" {
+		t.Fatalf("Expected replayed state to be rejected, got %v", query)
+	}
+	postJSON(t, app, "/sso/token", "", models.SSOTokenRequest{Code: code})
+	if status, _ := postJSON(t, app, "/sso/token", "", models.SSOTokenRequest{Code: code}); status != 400 {
+		t.Fatalf("Expected reused login code to be rejected, got %d", status)
+	}
+
+	t.Log("✓ OIDC logins with PKCE provision users and map groups to roles")
+}
+
+func TestOIDCLoginRejectsForgedTokens(t *testing.T) {
+	app, users := setupSSOTestApp()
+	idp := newMockOIDCProvider(t)
+	users.SetTenantSettings("demo-corp", map[string]interface{}{"sso": map[string]interface{}{
+		"enabled":      true,
+		"protocol":     "oidc",
+		"oidc":         map[string]interface{}{"issuer": idp.server.URL, "client_id": "zplus-client", "client_secret": "s3cret"},
+		"default_role": "user",
+	}})
+
+	// A code issued for another login's PKCE challenge cannot be redeemed
+	authURL := redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/login", "", nil), idp.server.URL+"/authorize")
+	otherURL := redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/login", "", nil), idp.server.URL+"/authorize")
+	stolen, _ := url.ParseQuery(idp.authorize(t, otherURL, "jane@demo-corp.zplus.com"))
+	callback := url.Values{"code": {stolen.Get("code")}, "state": {authURL.Get("state")}}
+	if query := redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/oidc/callback?"+callback.Encode(), "", nil), ssoTestAppURL); query.Get("error") != "SSO_FAILED" {
+		t.Fatalf("Expected PKCE mismatch to fail, got %v", query)
+	}
+
+	// ID tokens signed by another key are refused
+	authURL = redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/login", "", nil), idp.server.URL+"/authorize")
+	callbackQuery := idp.authorize(t, authURL, "jane@demo-corp.zplus.com")
+	idp.forge = true
+	if query := redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/oidc/callback?"+callbackQuery, "", nil), ssoTestAppURL); query.Get("error") != "SSO_FAILED" {
+		t.Fatalf("Expected token signed by an unknown key to fail, got %v", query)
+	}
+
+	t.Log("✓ OIDC logins require the PKCE verifier and a token signed by the IdP")
+}
+
+// mockSAMLIdP signs SAML responses like an identity provider
+type mockSAMLIdP struct {
+	keyStore dsig.X509KeyStore
+}
+
+// certificatePEM returns the IdP certificate to configure at the service provider
+func (idp *mockSAMLIdP) certificatePEM(t *testing.T) string {
+	_, cert, err := idp.keyStore.GetKeyPair()
+	if err != nil {
+		t.Fatalf("Failed to get IdP certificate: %v", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert}))
+}
+
+// response returns a base64 SAMLResponse answering requestID with a signed assertion.
+// tamper is applied to the assertion after signing.
+func (idp *mockSAMLIdP) response(t *testing.T, requestID, email string, groups []string, tamper func(assertion *etree.Element)) string {
+	now := time.Now().UTC()
+	acsURL := ssoTestBaseURL + "/sso/demo-corp/saml/acs"
+
+	var values strings.Builder
+	for _, group := range groups {
+		values.WriteString("<saml:AttributeValue>" + group + "</saml:AttributeValue>")
+	}
+	assertionXML := fmt.Sprintf(`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_a1" Version="2.0" IssueInstant="%[1]s">`+
+		`<saml:Issuer>https://idp.test</saml:Issuer>`+
+		`<saml:Subject><saml:NameID Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress">%[3]s</saml:NameID>`+
+		`<saml:SubjectConfirmation Method="urn:oasis:names:tc:SAML:2.0:cm:bearer"><saml:SubjectConfirmationData InResponseTo="%[4]s" Recipient="%[5]s" NotOnOrAfter="%[2]s"/></saml:SubjectConfirmation></saml:Subject>`+
+		`<saml:Conditions NotBefore="%[1]s" NotOnOrAfter="%[2]s"><saml:AudienceRestriction><saml:Audience>%[6]s</saml:Audience></saml:AudienceRestriction></saml:Conditions>`+
+		`<saml:AttributeStatement><saml:Attribute Name="firstName"><saml:AttributeValue>Sam</saml:AttributeValue></saml:Attribute>`+
+		`<saml:Attribute Name="groups">%[7]s</saml:Attribute></saml:AttributeStatement></saml:Assertion>`,
+		now.Format(time.RFC3339), now.Add(5*time.Minute).Format(time.RFC3339), email, requestID, acsURL,
+		ssoTestBaseURL+"/sso/demo-corp/saml/metadata", values.String())
+
+	assertionDoc := etree.NewDocument()
+	if err := assertionDoc.ReadFromString(assertionXML); err != nil {
+		t.Fatalf("Failed to build assertion: %v", err)
+	}
+	signer := dsig.NewDefaultSigningContext(idp.keyStore)
+	signer.Canonicalizer = dsig.MakeC14N10ExclusiveCanonicalizerWithPrefixList("")
+	assertion, err := signer.SignEnveloped(assertionDoc.Root())
+	if err != nil {
+		t.Fatalf("Failed to sign assertion: %v", err)
+	}
+	if tamper != nil {
+		tamper(assertion)
+	}
+
+	doc := etree.NewDocument()
+	response := doc.CreateElement("samlp:Response")
+	response.CreateAttr("xmlns:samlp", "urn:oasis:names:tc:SAML:2.0:protocol")
+	response.CreateAttr("ID", "_r1")
+	response.CreateAttr("Version", "2.0")
+	response.CreateAttr("InResponseTo", requestID)
+	response.CreateAttr("Destination", acsURL)
+	response.CreateElement("samlp:Status").CreateElement("samlp:StatusCode").CreateAttr("Value", "urn:oasis:names:tc:SAML:2.0:status:Success")
+	response.AddChild(assertion)
+
+	data, err := doc.WriteToBytes()
+	if err != nil {
+		t.Fatalf("Failed to write response: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// samlLogin starts a SAML login and returns the AuthnRequest ID and the RelayState
+func samlLogin(t *testing.T, app *fiber.App) (string, string) {
+	query := redirectQuery(t, ssoRequest(t, app, "GET", "/sso/demo-corp/login", "", nil), "https://idp.test/sso")
+
+	compressed, err := base64.StdEncoding.DecodeString(query.Get("SAMLRequest"))
+	if err != nil {
+		t.Fatalf("SAMLRequest is not base64: %v", err)
+	}
+	request := etree.NewDocument()
+	if _, err := request.ReadFrom(flate.NewReader(bytes.NewReader(compressed))); err != nil {
+		t.Fatalf("SAMLRequest is not deflated XML: %v", err)
+	}
+	if request.Root().SelectAttrValue("AssertionConsumerServiceURL", "") != ssoTestBaseURL+"/sso/demo-corp/saml/acs" {
+		t.Fatalf("Unexpected AuthnRequest %v", request.Root().Attr)
+	}
+	return request.Root().SelectAttrValue("ID", ""), query.Get("RelayState")
+}
+
+// postSAMLResponse posts a response to the ACS like the IdP's auto-submitted form
+func postSAMLResponse(t *testing.T, app *fiber.App, response, relayState string) *http.Response {
+	form := url.Values{"SAMLResponse": {response}, "RelayState": {relayState}}
+	return ssoRequest(t, app, "POST", "/sso/demo-corp/saml/acs", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+}
+
+func TestSAMLLoginWithSignedAssertions(t *testing.T) {
+	app, _ := setupSSOTestApp()
+	idp := &mockSAMLIdP{keyStore: dsig.RandomKeyStoreForTest()}
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+
+	// Tenant admins configure SSO for their own tenant
+	config := sso.Config{
+		Enabled:    true,
+		Protocol:   sso.ProtocolSAML,
+		SAML:       &sso.SAMLConfig{IdPEntityID: "https://idp.test", IdPSSOURL: "https://idp.test/sso", IdPCertificate: idp.certificatePEM(t)},
+		GroupRoles: map[string]string{"sales": "sales_rep"},
+	}
+	body, _ := json.Marshal(config)
+	req, _ := http.NewRequest("PUT", "/sso/demo-corp/config", bytes.NewBuffer(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+admin)
+	resp, err := app.Test(req, 5000)
+	if err != nil || resp.StatusCode != 200 {
+		t.Fatalf("Expected status 200 updating SSO config, got %v %v", resp.StatusCode, err)
+	}
+	var configResp models.SSOConfigResponse
+	json.NewDecoder(resp.Body).Decode(&configResp)
+	if configResp.SAMLACSURL != ssoTestBaseURL+"/sso/demo-corp/saml/acs" {
+		t.Fatalf("Expected ACS URL in response, got %+v", configResp)
+	}
+
+	// A valid response creates the user
+	requestID, relayState := samlLogin(t, app)
+	login := redeemSSOCode(t, app, postSAMLResponse(t, app, idp.response(t, requestID, "sam@demo-corp.zplus.com", []string{"sales"}, nil), relayState))
+	user := login["user"].(map[string]interface{})
+	if roles := stringList(user["roles"]); user["email"] != "sam@demo-corp.zplus.com" || len(roles) != 1 || roles[0] != "sales_rep" {
+		t.Fatalf("Expected provisioned sales_rep Sam, got %v", user)
+	}
+
+	// Assertions modified after signing are refused
+	requestID, relayState = samlLogin(t, app)
+	tampered := idp.response(t, requestID, "sam@demo-corp.zplus.com", []string{"sales"}, func(assertion *etree.Element) {
+		assertion.FindElement(".//NameID").SetText("admin@demo-corp.zplus.com")
+	})
+	if query := redirectQuery(t, postSAMLResponse(t, app, tampered, relayState), ssoTestAppURL); query.Get("error") != "SSO_FAILED" {
+		t.Fatalf("Expected tampered assertion to fail, got %v", query)
+	}
+
+	// Responses answering another request are refused
+	_, relayState = samlLogin(t, app)
+	if query := redirectQuery(t, postSAMLResponse(t, app, idp.response(t, "_other", "sam@demo-corp.zplus.com", []string{"sales"}, nil), relayState), ssoTestAppURL); query.Get("error") != "SSO_FAILED" {
+		t.Fatalf("Expected response to another request to fail, got %v", query)
+	}
+
+	// Signatures of other keys are refused
+	requestID, relayState = samlLogin(t, app)
+	forger := &mockSAMLIdP{keyStore: dsig.RandomKeyStoreForTest()}
+	if query := redirectQuery(t, postSAMLResponse(t, app, forger.response(t, requestID, "sam@demo-corp.zplus.com", []string{"sales"}, nil), relayState), ssoTestAppURL); query.Get("error") != "SSO_FAILED" {
+		t.Fatalf("Expected assertion signed by another key to fail, got %v", query)
+	}
+
+	t.Log("✓ SAML logins accept only signed assertions answering the login request")
+}
+
+func TestSSOEnforcementAndConfigAccess(t *testing.T) {
+	app, users := setupSSOTestApp()
+	users.SetTenantSettings("demo-corp", map[string]interface{}{"sso": map[string]interface{}{
+		"enabled":  true,
+		"enforced": true,
+		"protocol": "oidc",
+		"oidc":     map[string]interface{}{"issuer": "https://idp.test", "client_id": "zplus-client", "client_secret": "s3cret"},
+	}})
+
+	// Passwords are refused except for tenant admins
+	status, body := postJSON(t, app, "/login", "", models.LoginRequest{Email: "john@demo-corp.zplus.com", Password: "user123", TenantSlug: "demo-corp"})
+	if status != 403 || body["code"] != "SSO_REQUIRED" {
+		t.Fatalf("Expected SSO_REQUIRED, got %d %v", status, body)
+	}
+	admin := loginAs(t, app, "admin@demo-corp.zplus.com", "demo123", "demo-corp", "laptop")
+
+	// The client secret is never returned
+	status, body = sessionRequest(t, app, "GET", "/sso/demo-corp/config", admin)
+	oidc, _ := body["config"].(map[string]interface{})["oidc"].(map[string]interface{})
+	if status != 200 || oidc["client_secret"] != "********" {
+		t.Fatalf("Expected masked client secret, got %d %v", status, body)
+	}
+
+	// Only admins of the tenant read its configuration
+	systemAdmin := loginAs(t, app, "admin@zplus.com", "admin123", "system", "laptop")
+	if status, _ := sessionRequest(t, app, "GET", "/sso/demo-corp/config", systemAdmin); status != 403 {
+		t.Fatalf("Expected 403 for system admin token, got %d", status)
+	}
+	if status, _ := sessionRequest(t, app, "GET", "/sso/other-corp/config", admin); status != 404 {
+		t.Fatalf("Expected 404 for another tenant, got %d", status)
+	}
+
+	// Administrator roles cannot be granted by the IdP
+	for _, config := range []map[string]interface{}{
+		{"group_roles": map[string]interface{}{"admins": "system_admin"}},
+		{"group_roles": map[string]interface{}{"admins": "tenant_admin"}},
+		{"default_role": "System_Admin"},
+	} {
+		if status, body := putJSON(t, app, "/sso/demo-corp/config", admin, config); status != 400 || body["code"] != "ROLE_NAME_RESERVED" {
+			t.Fatalf("Expected reserved role to be refused in %v, got %d %v", config, status, body)
+		}
+	}
+
+	// Only roles the tenant has can be mapped
+	for _, config := range []map[string]interface{}{
+		{"group_roles": map[string]interface{}{"engineering": "developer"}},
+		{"default_role": "guest"},
+	} {
+		if status, body := putJSON(t, app, "/sso/demo-corp/config", admin, config); status != 400 || body["code"] != "INVALID_ROLE" {
+			t.Fatalf("Expected unknown role to be refused in %v, got %d %v", config, status, body)
+		}
+	}
+
+	// SAML identity providers are identified by their entity ID
+	noEntityID := map[string]interface{}{
+		"enabled":  true,
+		"protocol": "saml",
+		"saml":     map[string]interface{}{"idp_sso_url": "https://idp.test/sso", "idp_certificate": "-----BEGIN CERTIFICATE-----"},
+	}
+	if status, body := putJSON(t, app, "/sso/demo-corp/config", admin, noEntityID); status != 400 || body["code"] != "VALIDATION_ERROR" {
+		t.Fatalf("Expected SAML configuration without entity ID to be refused, got %d %v", status, body)
+	}
+
+	if status, _ := sessionRequest(t, app, "GET", "/sso/unknown/login", ""); status != 404 {
+		t.Fatalf("Expected 404 for tenant without SSO, got %d", status)
+	}
+
+	t.Log("✓ Enforced SSO refuses passwords except for tenant admins")
+}
@@ -0,0 +1,683 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	sharedmodels "github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// systemAdminPermissions are granted to active system administrators
+var systemAdminPermissions = []string{
+	"system:manage",
+	"tenants:read",
+	"tenants:write",
+	"users:read",
+	"users:write",
+}
+
+// systemSupportPermissions are granted to the other system users, such as support staff
+var systemSupportPermissions = []string{
+	"tenants:read",
+	"users:read",
+}
+
+// recoveryCodeAttempts limits how often using a recovery code is retried when concurrent
+// logins keep changing the user's codes
+const recoveryCodeAttempts = 3
+
+// DatabaseUserStore authenticates tenant users against the tenant users table
+// and system administrators against system.system_users
+type DatabaseUserStore struct {
+	db            *gorm.DB
+	tenantService *services.TenantService
+	trialPlan     string        // Name of the plan new tenants are trialing
+	trialPeriod   time.Duration // Length of the trial of new tenants
+	notifier      services.Notifier
+	events        *services.EventBus
+}
+
+// NewDatabaseUserStore creates a new database-backed user store
+func NewDatabaseUserStore(db *gorm.DB) *DatabaseUserStore {
+	return &DatabaseUserStore{
+		db:            db,
+		tenantService: services.NewTenantService(db),
+		trialPlan:     "Basic",
+		trialPeriod:   14 * 24 * time.Hour,
+	}
+}
+
+// SetTrial configures the plan and trial length of tenants created by registration
+func (s *DatabaseUserStore) SetTrial(planName string, period time.Duration) {
+	s.trialPlan = planName
+	s.trialPeriod = period
+}
+
+// SetNotifier sets the notifier welcoming the first admin of tenants created by registration
+func (s *DatabaseUserStore) SetNotifier(notifier services.Notifier) {
+	s.notifier = notifier
+}
+
+// SetEventBus sets the event bus used to publish changes of tenants, users and their roles
+func (s *DatabaseUserStore) SetEventBus(events *services.EventBus) {
+	s.events = events
+	s.tenantService.SetEventBus(events)
+}
+
+// Authenticate verifies user credentials within a tenant or the system scope
+func (s *DatabaseUserStore) Authenticate(tenantSlug, email, password string) (*models.User, error) {
+	if tenantSlug == SystemTenantSlug {
+		return s.authenticateSystemUser(email, password)
+	}
+
+	tenant, err := s.getActiveTenant(tenantSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantUser, err := services.NewUserService(s.db, tenant.ID).GetUserByEmail(email)
+	if err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(tenantUser.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return fromTenantUser(tenantUser), nil
+}
+
+// GetUser returns a tenant user or, for the system tenant, a system user
+func (s *DatabaseUserStore) GetUser(tenantID, userID string) (*models.User, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	if tenantID == SystemTenantSlug {
+		var systemUser sharedmodels.SystemUser
+		if err := s.db.First(&systemUser, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, ErrUserNotFound
+			}
+			return nil, fmt.Errorf("failed to get system user: %v", err)
+		}
+		return fromSystemUser(&systemUser), nil
+	}
+
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, ErrTenantNotFound
+	}
+
+	tenantUser, err := services.NewUserService(s.db, tenantUUID).GetUser(id)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return fromTenantUser(tenantUser), nil
+}
+
+// ListUsers returns the users of a tenant, or all system users for the system tenant
+func (s *DatabaseUserStore) ListUsers(tenantSlug string) ([]*models.User, error) {
+	if tenantSlug == SystemTenantSlug {
+		var systemUsers []*sharedmodels.SystemUser
+		if err := s.db.Order("created_at DESC").Find(&systemUsers).Error; err != nil {
+			return nil, fmt.Errorf("failed to list system users: %v", err)
+		}
+		users := make([]*models.User, 0, len(systemUsers))
+		for _, systemUser := range systemUsers {
+			users = append(users, fromSystemUser(systemUser))
+		}
+		return users, nil
+	}
+
+	tenant, err := s.tenantService.GetTenantBySlug(tenantSlug)
+	if err != nil {
+		return nil, ErrTenantNotFound
+	}
+
+	tenantUsers, _, err := services.NewUserService(s.db, tenant.ID).ListUsers(services.UserFilter{}, 0, 100)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*models.User, 0, len(tenantUsers))
+	for _, tenantUser := range tenantUsers {
+		users = append(users, fromTenantUser(tenantUser))
+	}
+	return users, nil
+}
+
+// RecordLogin updates the last login timestamp of tenant users
+func (s *DatabaseUserStore) RecordLogin(user *models.User) error {
+	if user.TenantID == SystemTenantSlug {
+		return nil
+	}
+
+	tenantID, err := uuid.Parse(user.TenantID)
+	if err != nil {
+		return ErrTenantNotFound
+	}
+	userID, err := uuid.Parse(user.ID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	return services.NewUserService(s.db, tenantID).UpdateLastLogin(userID)
+}
+
+// CreateTenant onboards a trial tenant with its trial subscription and its first user.
+// A tenant that fails to be set up is rolled back entirely.
+func (s *DatabaseUserStore) CreateTenant(name, slug string, admin NewUser) (*models.User, error) {
+	if !validTenantSlug(slug) {
+		return nil, ErrInvalidSlug
+	}
+	if _, err := s.tenantService.GetTenantBySlug(slug); err == nil {
+		return nil, ErrSlugTaken
+	}
+
+	var plan sharedmodels.Plan
+	if err := s.db.Where("name = ?", s.trialPlan).First(&plan).Error; err != nil {
+		return nil, fmt.Errorf("failed to get trial plan %q: %v", s.trialPlan, err)
+	}
+
+	onboarding := services.NewOnboardingService(s.db)
+	onboarding.SetNotifier(s.notifier)
+	record, err := onboarding.Onboard(services.OnboardingInput{
+		Tenant: services.CreateTenantInput{
+			Name:   name,
+			Slug:   slug,
+			PlanID: &plan.ID,
+			Status: "trial",
+		},
+		// The first user administers the tenant with the role seeded from its template
+		Admin: &services.OnboardingAdmin{
+			Email:     admin.Email,
+			Password:  admin.Password,
+			FirstName: admin.FirstName,
+			LastName:  admin.LastName,
+			Role:      admin.Role,
+			Status:    admin.Status,
+		},
+		TrialPeriod: s.trialPeriod,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "already exists") {
+			return nil, ErrSlugTaken
+		}
+		return nil, err
+	}
+
+	tenantUser, err := services.NewUserService(s.db, record.TenantID).GetUserByEmail(admin.Email)
+	if err != nil {
+		return nil, err
+	}
+	return fromTenantUser(tenantUser), nil
+}
+
+// CreateUser adds a user to an existing tenant with one of the tenant's roles
+func (s *DatabaseUserStore) CreateUser(tenantID string, newUser NewUser) (*models.User, error) {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, ErrTenantNotFound
+	}
+
+	userService := services.NewUserService(s.db, tenantUUID)
+	if _, err := userService.GetUserByEmail(newUser.Email); err == nil {
+		return nil, ErrEmailTaken
+	}
+
+	var role sharedmodels.Role
+	err = tenancy.New(s.db, tenantUUID).Transaction(func(tx *gorm.DB) error {
+		return tx.Where("tenant_id = ? AND name = ?", tenantUUID, newUser.Role).First(&role).Error
+	})
+	if err == gorm.ErrRecordNotFound {
+		return nil, ErrRoleNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get role: %v", err)
+	}
+
+	tenantUser, err := userService.CreateUser(services.CreateUserInput{
+		Email:     newUser.Email,
+		Password:  newUser.Password,
+		FirstName: newUser.FirstName,
+		LastName:  newUser.LastName,
+		RoleIDs:   []uuid.UUID{role.ID},
+		Status:    newUser.Status,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromTenantUser(tenantUser), nil
+}
+
+// SetUserStatus changes the status of a tenant user
+func (s *DatabaseUserStore) SetUserStatus(tenantID, userID, status string) error {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return ErrTenantNotFound
+	}
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	if _, err := s.userService(tenantUUID).UpdateUser(id, services.UpdateUserInput{Status: &status}); err != nil {
+		if err.Error() == "user not found" {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// FindUser returns a tenant user, or a system user for the system tenant, by email
+func (s *DatabaseUserStore) FindUser(tenantSlug, email string) (*models.User, error) {
+	if tenantSlug == SystemTenantSlug {
+		var systemUser sharedmodels.SystemUser
+		if err := s.db.Where("email = ?", strings.ToLower(email)).First(&systemUser).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return nil, ErrUserNotFound
+			}
+			return nil, fmt.Errorf("failed to get system user: %v", err)
+		}
+		return fromSystemUser(&systemUser), nil
+	}
+
+	tenant, err := s.tenantService.GetTenantBySlug(tenantSlug)
+	if err != nil {
+		if err.Error() == "tenant not found" {
+			return nil, ErrTenantNotFound
+		}
+		return nil, err
+	}
+
+	tenantUser, err := services.NewUserService(s.db, tenant.ID).GetUserByEmail(email)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+
+	return fromTenantUser(tenantUser), nil
+}
+
+// SetPassword replaces the password of a tenant user or system user
+func (s *DatabaseUserStore) SetPassword(tenantID, userID, password string) error {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	if tenantID == SystemTenantSlug {
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("failed to hash password: %v", err)
+		}
+		result := s.db.Model(&sharedmodels.SystemUser{}).Where("id = ?", id).Update("password_hash", string(hashedPassword))
+		if result.Error != nil {
+			return fmt.Errorf("failed to update password: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrUserNotFound
+		}
+		return nil
+	}
+
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return ErrTenantNotFound
+	}
+
+	if err := services.NewUserService(s.db, tenantUUID).SetPassword(id, password); err != nil {
+		if err.Error() == "user not found" {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// UpdateMFA stores the MFA fields of a tenant user. System users do not support MFA yet.
+func (s *DatabaseUserStore) UpdateMFA(user *models.User) error {
+	if user.TenantID == SystemTenantSlug {
+		return ErrNotSupported
+	}
+
+	tenantID, err := uuid.Parse(user.TenantID)
+	if err != nil {
+		return ErrTenantNotFound
+	}
+	id, err := uuid.Parse(user.ID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	var secret *string
+	if user.MFASecret != "" {
+		secret = &user.MFASecret
+	}
+	recoveryCodes := user.MFARecoveryCodes
+	if recoveryCodes == nil {
+		recoveryCodes = []string{}
+	}
+
+	return tenancy.New(s.db, tenantID).Transaction(func(tx *gorm.DB) error {
+		// Select writes the zero values too, e.g. when MFA is disabled
+		result := tx.Model(&sharedmodels.TenantUser{}).
+			Where("id = ? AND tenant_id = ?", id, tenantID).
+			Select("mfa_enabled", "mfa_secret", "mfa_recovery_codes").
+			Updates(&sharedmodels.TenantUser{
+				MFAEnabled:       user.MFAEnabled,
+				MFASecret:        secret,
+				MFARecoveryCodes: recoveryCodes,
+			})
+		if result.Error != nil {
+			return fmt.Errorf("failed to update MFA settings: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return ErrUserNotFound
+		}
+		return nil
+	})
+}
+
+// UseRecoveryCode removes an unused recovery code of a tenant user. The remaining codes are
+// only written while the stored codes are still the ones read, so a code logs in once even
+// when two logins use it at the same time.
+func (s *DatabaseUserStore) UseRecoveryCode(user *models.User, hash string) (bool, error) {
+	if user.TenantID == SystemTenantSlug {
+		return false, ErrNotSupported
+	}
+
+	tenantID, err := uuid.Parse(user.TenantID)
+	if err != nil {
+		return false, ErrTenantNotFound
+	}
+	id, err := uuid.Parse(user.ID)
+	if err != nil {
+		return false, ErrUserNotFound
+	}
+
+	db := tenancy.New(s.db, tenantID)
+	for attempt := 0; attempt < recoveryCodeAttempts; attempt++ {
+		var stored sharedmodels.TenantUser
+		err := db.Transaction(func(tx *gorm.DB) error {
+			return tx.Select("mfa_recovery_codes").Where("id = ? AND tenant_id = ?", id, tenantID).First(&stored).Error
+		})
+		if err == gorm.ErrRecordNotFound {
+			return false, ErrUserNotFound
+		}
+		if err != nil {
+			return false, fmt.Errorf("failed to get recovery codes: %v", err)
+		}
+
+		remaining, found := withoutRecoveryCode(stored.MFARecoveryCodes, hash)
+		if !found {
+			return false, nil
+		}
+		read, err := json.Marshal(stored.MFARecoveryCodes)
+		if err != nil {
+			return false, err
+		}
+
+		var updated int64
+		err = db.Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&sharedmodels.TenantUser{}).
+				Where("id = ? AND tenant_id = ? AND mfa_recovery_codes = CAST(? AS jsonb)", id, tenantID, string(read)).
+				Select("mfa_recovery_codes").
+				Updates(&sharedmodels.TenantUser{MFARecoveryCodes: remaining})
+			updated = result.RowsAffected
+			return result.Error
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to update recovery codes: %v", err)
+		}
+		if updated == 1 {
+			user.MFARecoveryCodes = remaining
+			return true, nil
+		}
+		// Another login changed the codes since they were read
+	}
+	return false, nil
+}
+
+// TenantSettings returns the settings of a tenant
+func (s *DatabaseUserStore) TenantSettings(tenantID string) (map[string]interface{}, error) {
+	if tenantID == SystemTenantSlug {
+		return map[string]interface{}{}, nil
+	}
+
+	id, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, ErrTenantNotFound
+	}
+
+	tenant, err := s.tenantService.GetTenant(id)
+	if err != nil {
+		if err.Error() == "tenant not found" {
+			return nil, ErrTenantNotFound
+		}
+		return nil, err
+	}
+
+	if tenant.Settings == nil {
+		return map[string]interface{}{}, nil
+	}
+	return tenant.Settings, nil
+}
+
+// UpdateTenantSettings sets the given top-level keys of a tenant's settings
+func (s *DatabaseUserStore) UpdateTenantSettings(tenantID string, settings map[string]interface{}) error {
+	current, err := s.TenantSettings(tenantID)
+	if err != nil {
+		return err
+	}
+	if tenantID == SystemTenantSlug {
+		return ErrNotSupported
+	}
+
+	merged := make(map[string]interface{}, len(current)+len(settings))
+	for key, value := range current {
+		merged[key] = value
+	}
+	for key, value := range settings {
+		merged[key] = value
+	}
+
+	id, _ := uuid.Parse(tenantID)
+	if _, err := s.tenantService.UpdateTenant(id, services.UpdateTenantInput{Settings: merged}); err != nil {
+		if err.Error() == "tenant not found" {
+			return ErrTenantNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// TenantID returns the ID of an active tenant by slug
+func (s *DatabaseUserStore) TenantID(tenantSlug string) (string, error) {
+	tenant, err := s.getActiveTenant(tenantSlug)
+	if err != nil {
+		if err == ErrInvalidCredentials {
+			return "", ErrTenantNotFound
+		}
+		return "", err
+	}
+	return tenant.ID.String(), nil
+}
+
+// SetUserRoles replaces the roles of a tenant user. All roles must exist in the tenant.
+func (s *DatabaseUserStore) SetUserRoles(tenantID, userID string, roles []string) error {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return ErrTenantNotFound
+	}
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return ErrUserNotFound
+	}
+
+	roleIDs := make([]uuid.UUID, 0, len(roles))
+	err = tenancy.New(s.db, tenantUUID).Transaction(func(tx *gorm.DB) error {
+		for _, name := range roles {
+			var role sharedmodels.Role
+			err := tx.Where("tenant_id = ? AND name = ?", tenantUUID, name).First(&role).Error
+			if err == gorm.ErrRecordNotFound {
+				return ErrRoleNotFound
+			}
+			if err != nil {
+				return fmt.Errorf("failed to get role: %v", err)
+			}
+			roleIDs = append(roleIDs, role.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := s.userService(tenantUUID).AssignRoles(id, services.RoleModeReplace, roleIDs, nil); err != nil {
+		if err.Error() == "user not found" {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// Helper methods
+
+// withoutRecoveryCode returns the codes without a code hash and whether it was found
+func withoutRecoveryCode(codes []string, hash string) ([]string, bool) {
+	for i, code := range codes {
+		if code != hash {
+			continue
+		}
+		remaining := make([]string, 0, len(codes)-1)
+		remaining = append(remaining, codes[:i]...)
+		return append(remaining, codes[i+1:]...), true
+	}
+	return codes, false
+}
+
+// userService returns the user service of a tenant, publishing its changes on the event bus
+func (s *DatabaseUserStore) userService(tenantID uuid.UUID) *services.UserService {
+	userService := services.NewUserService(s.db, tenantID)
+	userService.SetEventBus(s.events)
+	return userService
+}
+
+func (s *DatabaseUserStore) authenticateSystemUser(email, password string) (*models.User, error) {
+	var systemUser sharedmodels.SystemUser
+	if err := s.db.Where("email = ?", strings.ToLower(email)).First(&systemUser).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("failed to get system user: %v", err)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(systemUser.PasswordHash), []byte(password)); err != nil {
+		return nil, ErrInvalidCredentials
+	}
+
+	return fromSystemUser(&systemUser), nil
+}
+
+func (s *DatabaseUserStore) getActiveTenant(slug string) (*sharedmodels.Tenant, error) {
+	tenant, err := s.tenantService.GetTenantBySlug(slug)
+	if err != nil {
+		if err.Error() == "tenant not found" {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, err
+	}
+
+	if tenant.Status != "active" && tenant.Status != "trial" {
+		return nil, ErrTenantInactive
+	}
+
+	return tenant, nil
+}
+
+// fromTenantUser converts a tenant user row into the auth service user model
+func fromTenantUser(tenantUser *sharedmodels.TenantUser) *models.User {
+	roles := make([]string, 0, len(tenantUser.Roles))
+	for _, role := range tenantUser.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	permissions := tenantUser.GetPermissions()
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	user := &models.User{
+		ID:          tenantUser.ID.String(),
+		TenantID:    tenantUser.TenantID.String(),
+		Email:       tenantUser.Email,
+		FirstName:   tenantUser.FirstName,
+		LastName:    tenantUser.LastName,
+		Roles:       roles,
+		Permissions: permissions,
+		IsAdmin:     false,
+		Status:      tenantUser.Status,
+		CreatedAt:   tenantUser.CreatedAt,
+		UpdatedAt:   tenantUser.UpdatedAt,
+	}
+	user.MFAEnabled = tenantUser.MFAEnabled
+	user.MFARecoveryCodes = tenantUser.MFARecoveryCodes
+	if tenantUser.MFASecret != nil {
+		user.MFASecret = *tenantUser.MFASecret
+	}
+
+	return user
+}
+
+// fromSystemUser converts a system user row into the auth service user model
+func fromSystemUser(systemUser *sharedmodels.SystemUser) *models.User {
+	firstName, lastName := systemUser.Name, ""
+	if idx := strings.Index(systemUser.Name, " "); idx != -1 {
+		firstName, lastName = systemUser.Name[:idx], systemUser.Name[idx+1:]
+	}
+
+	status := "active"
+	if !systemUser.IsActive {
+		status = "inactive"
+	}
+
+	permissions := systemSupportPermissions
+	if systemUser.IsAdministrator() {
+		permissions = systemAdminPermissions
+	}
+
+	return &models.User{
+		ID:          systemUser.ID.String(),
+		TenantID:    SystemTenantSlug,
+		Email:       systemUser.Email,
+		FirstName:   firstName,
+		LastName:    lastName,
+		Roles:       []string{systemUser.Role},
+		Permissions: permissions,
+		IsAdmin:     systemUser.IsAdministrator(),
+		Status:      status,
+		CreatedAt:   systemUser.CreatedAt,
+		UpdatedAt:   systemUser.UpdatedAt,
+	}
+}
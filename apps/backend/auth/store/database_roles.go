@@ -0,0 +1,554 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	sharedmodels "github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// DatabaseRoleStore keeps roles, permissions and their assignments in the tenant
+// roles, permissions, role_permissions and user_roles tables
+type DatabaseRoleStore struct {
+	db     *gorm.DB
+	events *services.EventBus
+}
+
+// NewDatabaseRoleStore creates a new database-backed role store
+func NewDatabaseRoleStore(db *gorm.DB) *DatabaseRoleStore {
+	return &DatabaseRoleStore{
+		db: db,
+	}
+}
+
+// SetEventBus sets the event bus used to publish changes of roles, their permissions and
+// their assignments
+func (s *DatabaseRoleStore) SetEventBus(events *services.EventBus) {
+	s.events = events
+}
+
+// ListRoles returns the roles of a tenant ordered by name
+func (s *DatabaseRoleStore) ListRoles(tenantID string) ([]*models.Role, error) {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, ErrTenantNotFound
+	}
+
+	var roles []sharedmodels.Role
+	err = tenancy.New(s.db, tenantUUID).Transaction(func(tx *gorm.DB) error {
+		return tx.Where("tenant_id = ?", tenantUUID).Order("name").Find(&roles).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list roles: %v", err)
+	}
+
+	result := make([]*models.Role, 0, len(roles))
+	for i := range roles {
+		result = append(result, fromSharedRole(&roles[i]))
+	}
+	return result, nil
+}
+
+// GetRole returns a role of the tenant by ID
+func (s *DatabaseRoleStore) GetRole(tenantID, roleID string) (*models.Role, error) {
+	var role *sharedmodels.Role
+	err := s.transaction(tenantID, func(tx *gorm.DB, tenantUUID uuid.UUID) error {
+		var err error
+		role, err = getRole(tx, tenantUUID, roleID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromSharedRole(role), nil
+}
+
+// CreateRole adds a role to the tenant. Role names are unique within a tenant.
+func (s *DatabaseRoleStore) CreateRole(tenantID string, role *models.Role) error {
+	return s.transaction(tenantID, func(tx *gorm.DB, tenantUUID uuid.UUID) error {
+		var count int64
+		if err := tx.Model(&sharedmodels.Role{}).
+			Where("tenant_id = ? AND LOWER(name) = ?", tenantUUID, strings.ToLower(role.Name)).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check role name: %v", err)
+		}
+		if count > 0 {
+			return ErrRoleExists
+		}
+
+		row := &sharedmodels.Role{
+			TenantID:     tenantUUID,
+			Name:         role.Name,
+			DisplayName:  role.DisplayName,
+			Description:  optionalString(role.Description),
+			IsSystemRole: role.IsSystemRole,
+		}
+		if err := tx.Create(row).Error; err != nil {
+			return fmt.Errorf("failed to create role: %v", err)
+		}
+
+		*role = *fromSharedRole(row)
+		return nil
+	})
+}
+
+// UpdateRole stores the display name and description of a role
+func (s *DatabaseRoleStore) UpdateRole(tenantID string, role *models.Role) error {
+	return s.transaction(tenantID, func(tx *gorm.DB, tenantUUID uuid.UUID) error {
+		row, err := getRole(tx, tenantUUID, role.ID)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(row).Updates(map[string]interface{}{
+			"display_name": role.DisplayName,
+			"description":  optionalString(role.Description),
+			"customized":   row.TemplateID != nil,
+		}).Error
+		if err != nil {
+			return fmt.Errorf("failed to update role: %v", err)
+		}
+
+		*role = *fromSharedRole(row)
+		return nil
+	})
+}
+
+// DeleteRole removes a role together with its permission and user assignments. The row
+// is deleted permanently so the name can be reused, as names are unique per tenant.
+func (s *DatabaseRoleStore) DeleteRole(tenantID, roleID string) error {
+	err := s.transaction(tenantID, func(tx *gorm.DB, tenantUUID uuid.UUID) error {
+		role, err := getRole(tx, tenantUUID, roleID)
+		if err != nil {
+			return err
+		}
+		if role.IsSystemRole {
+			return ErrSystemRole
+		}
+
+		if err := tx.Where("role_id = ?", role.ID).Delete(&sharedmodels.RolePermission{}).Error; err != nil {
+			return fmt.Errorf("failed to remove role permissions: %v", err)
+		}
+		if err := tx.Where("role_id = ?", role.ID).Delete(&sharedmodels.UserRole{}).Error; err != nil {
+			return fmt.Errorf("failed to remove role assignments: %v", err)
+		}
+		if err := tx.Unscoped().Delete(role).Error; err != nil {
+			return fmt.Errorf("failed to delete role: %v", err)
+		}
+		return nil
+	})
+	if err == nil {
+		s.publishRolesChanged(tenantID, "")
+	}
+	return err
+}
+
+// ListPermissions returns the permission catalogue of a tenant ordered by name
+func (s *DatabaseRoleStore) ListPermissions(tenantID string) ([]*models.Permission, error) {
+	var permissions []sharedmodels.Permission
+	err := s.transaction(tenantID, func(tx *gorm.DB, _ uuid.UUID) error {
+		if err := tx.Order("name").Find(&permissions).Error; err != nil {
+			return fmt.Errorf("failed to list permissions: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromSharedPermissions(permissions), nil
+}
+
+// CreatePermission adds a permission to the catalogue. Each tenant schema keeps a copy of
+// the catalogue, so the permission is added to every tenant with the same ID.
+func (s *DatabaseRoleStore) CreatePermission(permission *models.Permission) error {
+	row := &sharedmodels.Permission{
+		ID:          uuid.New(),
+		Name:        permission.Name,
+		Resource:    permission.Resource,
+		Action:      permission.Action,
+		Description: optionalString(permission.Description),
+	}
+
+	var tenantIDs []uuid.UUID
+	if err := s.db.Model(&sharedmodels.Tenant{}).Order("created_at").Pluck("id", &tenantIDs).Error; err != nil {
+		return fmt.Errorf("failed to list tenants: %v", err)
+	}
+
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		created := 0
+		for _, tenantID := range tenantIDs {
+			err := tenancy.New(tx, tenantID).Transaction(func(tenantTx *gorm.DB) error {
+				var count int64
+				if err := tenantTx.Model(&sharedmodels.Permission{}).
+					Where("LOWER(name) = ?", strings.ToLower(permission.Name)).
+					Count(&count).Error; err != nil {
+					return fmt.Errorf("failed to check permission name: %v", err)
+				}
+				if count > 0 {
+					return nil
+				}
+				created++
+				copied := *row
+				return tenantTx.Create(&copied).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create permission in tenant %s: %v", tenantID, err)
+			}
+		}
+		if len(tenantIDs) > 0 && created == 0 {
+			return ErrPermissionExists
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	row.CreatedAt = time.Now()
+	*permission = *fromSharedPermission(row)
+	return nil
+}
+
+// GetRolePermissions returns the permissions granted to a role of the tenant
+func (s *DatabaseRoleStore) GetRolePermissions(tenantID, roleID string) ([]*models.Permission, error) {
+	var permissions []sharedmodels.Permission
+	err := s.transaction(tenantID, func(tx *gorm.DB, tenantUUID uuid.UUID) error {
+		role, err := getRole(tx, tenantUUID, roleID)
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(role).Order("name").Association("Permissions").Find(&permissions); err != nil {
+			return fmt.Errorf("failed to get role permissions: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return fromSharedPermissions(permissions), nil
+}
+
+// AssignPermission grants a permission to a role of the tenant
+func (s *DatabaseRoleStore) AssignPermission(tenantID, roleID, permissionID string) error {
+	err := s.transaction(tenantID, func(tx *gorm.DB, tenantUUID uuid.UUID) error {
+		role, err := getRole(tx, tenantUUID, roleID)
+		if err != nil {
+			return err
+		}
+
+		permissionUUID, err := uuid.Parse(permissionID)
+		if err != nil {
+			return ErrPermissionNotFound
+		}
+		var permission sharedmodels.Permission
+		if err := tx.First(&permission, permissionUUID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrPermissionNotFound
+			}
+			return fmt.Errorf("failed to get permission: %v", err)
+		}
+
+		var count int64
+		if err := tx.Model(&sharedmodels.RolePermission{}).
+			Where("role_id = ? AND permission_id = ?", role.ID, permission.ID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check role permission: %v", err)
+		}
+		if count > 0 {
+			return ErrAlreadyAssigned
+		}
+
+		if err := tx.Create(&sharedmodels.RolePermission{RoleID: role.ID, PermissionID: permission.ID}).Error; err != nil {
+			return fmt.Errorf("failed to assign permission: %v", err)
+		}
+		// Template updates no longer apply once the tenant changed the permissions
+		if role.TemplateID != nil {
+			if err := tx.Model(role).Update("customized", true).Error; err != nil {
+				return fmt.Errorf("failed to mark role as customized: %v", err)
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		s.publishRolesChanged(tenantID, "")
+	}
+	return err
+}
+
+// GetUserRoles returns the roles a user of the tenant holds now. Time-bound assignments
+// outside their window are left out.
+func (s *DatabaseRoleStore) GetUserRoles(tenantID, userID string) ([]*models.Role, error) {
+	user, err := s.getActiveUser(tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*models.Role, 0, len(user.Roles))
+	for i := range user.Roles {
+		roles = append(roles, fromSharedRole(&user.Roles[i]))
+	}
+	return roles, nil
+}
+
+// AssignRole assigns a role of the tenant to one of its users in addition to their other roles
+func (s *DatabaseRoleStore) AssignRole(tenantID, userID, roleID string) error {
+	err := s.transaction(tenantID, func(tx *gorm.DB, tenantUUID uuid.UUID) error {
+		user, err := getUser(tx, tenantUUID, userID)
+		if err != nil {
+			return err
+		}
+		role, err := getRole(tx, tenantUUID, roleID)
+		if err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&sharedmodels.UserRole{}).
+			Where("user_id = ? AND role_id = ?", user.ID, role.ID).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check user role: %v", err)
+		}
+		if count > 0 {
+			return ErrAlreadyAssigned
+		}
+
+		if err := tx.Create(&sharedmodels.UserRole{UserID: user.ID, RoleID: role.ID}).Error; err != nil {
+			return fmt.Errorf("failed to assign role: %v", err)
+		}
+		return nil
+	})
+	if err == nil {
+		s.publishRolesChanged(tenantID, userID)
+	}
+	return err
+}
+
+// GetUserPermissions returns the distinct permission names granted by a user's current roles
+func (s *DatabaseRoleStore) GetUserPermissions(tenantID, userID string) ([]string, error) {
+	user, err := s.getActiveUser(tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := user.GetPermissions()
+	if permissions == nil {
+		permissions = []string{}
+	}
+	return permissions, nil
+}
+
+// ListTemplates returns the system role templates, creating the default ones if missing
+func (s *DatabaseRoleStore) ListTemplates() ([]*models.RoleTemplate, error) {
+	templateService := services.NewRoleTemplateService(s.db)
+	if err := templateService.EnsureDefaultTemplates(); err != nil {
+		return nil, err
+	}
+	templates, err := templateService.ListTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.RoleTemplate, 0, len(templates))
+	for i := range templates {
+		result = append(result, fromRoleTemplate(&templates[i]))
+	}
+	return result, nil
+}
+
+// UpdateTemplate changes a role template and pushes it to the uncustomised tenant roles
+func (s *DatabaseRoleStore) UpdateTemplate(name string, update models.UpdateRoleTemplateRequest) (*models.RoleTemplate, int, error) {
+	templateService := services.NewRoleTemplateService(s.db)
+	templateService.SetEventBus(s.events)
+	template, updated, err := templateService.UpdateTemplate(name, services.UpdateRoleTemplateInput{
+		DisplayName: update.DisplayName,
+		Description: update.Description,
+		Permissions: update.Permissions,
+	})
+	if err != nil {
+		if err.Error() == "role template not found" {
+			return nil, 0, ErrTemplateNotFound
+		}
+		return nil, 0, err
+	}
+	return fromRoleTemplate(template), updated, nil
+}
+
+// CloneTemplate creates a custom role in the tenant with the permissions of a template
+func (s *DatabaseRoleStore) CloneTemplate(tenantID, templateName string, role *models.Role) error {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return ErrTenantNotFound
+	}
+
+	clone, err := services.NewRoleTemplateService(s.db).CloneTemplate(tenantUUID, templateName, services.CloneRoleTemplateInput{
+		Name:        role.Name,
+		DisplayName: role.DisplayName,
+		Description: optionalString(role.Description),
+	})
+	if err != nil {
+		switch err.Error() {
+		case "role template not found":
+			return ErrTemplateNotFound
+		case "role already exists":
+			return ErrRoleExists
+		}
+		return err
+	}
+
+	*role = *fromSharedRole(clone)
+	return nil
+}
+
+// Helper methods
+
+// publishRolesChanged tells subscribers that the roles of a tenant changed, or only those
+// of one user when userID is not empty
+func (s *DatabaseRoleStore) publishRolesChanged(tenantID, userID string) {
+	event := services.Event{Type: services.EventRolesChanged}
+	event.TenantID, _ = uuid.Parse(tenantID)
+	if userID != "" {
+		event.UserID, _ = uuid.Parse(userID)
+	}
+	s.events.Publish(event)
+}
+
+// transaction runs fn in a transaction scoped to the schema of the tenant
+func (s *DatabaseRoleStore) transaction(tenantID string, fn func(tx *gorm.DB, tenantUUID uuid.UUID) error) error {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return ErrTenantNotFound
+	}
+	return tenancy.New(s.db, tenantUUID).Transaction(func(tx *gorm.DB) error {
+		return fn(tx, tenantUUID)
+	})
+}
+
+// getActiveUser returns a user of the tenant with the roles and permissions granted now
+func (s *DatabaseRoleStore) getActiveUser(tenantID, userID string) (*sharedmodels.TenantUser, error) {
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, ErrTenantNotFound
+	}
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	user, err := services.NewUserService(s.db, tenantUUID).GetUser(id)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return nil, ErrUserNotFound
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+func getRole(tx *gorm.DB, tenantID uuid.UUID, roleID string) (*sharedmodels.Role, error) {
+	id, err := uuid.Parse(roleID)
+	if err != nil {
+		return nil, ErrRoleNotFound
+	}
+
+	var role sharedmodels.Role
+	if err := tx.Where("tenant_id = ?", tenantID).First(&role, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("failed to get role: %v", err)
+	}
+	return &role, nil
+}
+
+func getUser(tx *gorm.DB, tenantID uuid.UUID, userID string) (*sharedmodels.TenantUser, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, ErrUserNotFound
+	}
+
+	var user sharedmodels.TenantUser
+	if err := tx.Where("tenant_id = ?", tenantID).First(&user, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user: %v", err)
+	}
+	return &user, nil
+}
+
+// fromSharedRole converts a tenant role row into the auth service role model
+func fromSharedRole(role *sharedmodels.Role) *models.Role {
+	result := &models.Role{
+		ID:              role.ID.String(),
+		TenantID:        role.TenantID.String(),
+		Name:            role.Name,
+		DisplayName:     role.DisplayName,
+		IsSystemRole:    role.IsSystemRole,
+		TemplateVersion: role.TemplateVersion,
+		Customized:      role.Customized,
+		CreatedAt:       role.CreatedAt,
+		UpdatedAt:       role.UpdatedAt,
+	}
+	if role.Description != nil {
+		result.Description = *role.Description
+	}
+	if role.TemplateID != nil {
+		result.TemplateID = role.TemplateID.String()
+	}
+	return result
+}
+
+// fromRoleTemplate converts a role template row into the auth service template model
+func fromRoleTemplate(template *sharedmodels.RoleTemplate) *models.RoleTemplate {
+	result := &models.RoleTemplate{
+		ID:          template.ID.String(),
+		Name:        template.Name,
+		DisplayName: template.DisplayName,
+		Module:      template.Module,
+		Permissions: template.Permissions,
+		Version:     template.Version,
+		UpdatedAt:   template.UpdatedAt,
+	}
+	if template.Description != nil {
+		result.Description = *template.Description
+	}
+	return result
+}
+
+// fromSharedPermission converts a permission row into the auth service permission model
+func fromSharedPermission(permission *sharedmodels.Permission) *models.Permission {
+	result := &models.Permission{
+		ID:        permission.ID.String(),
+		Name:      permission.Name,
+		Resource:  permission.Resource,
+		Action:    permission.Action,
+		CreatedAt: permission.CreatedAt,
+	}
+	if permission.Description != nil {
+		result.Description = *permission.Description
+	}
+	return result
+}
+
+func fromSharedPermissions(permissions []sharedmodels.Permission) []*models.Permission {
+	result := make([]*models.Permission, 0, len(permissions))
+	for i := range permissions {
+		result = append(result, fromSharedPermission(&permissions[i]))
+	}
+	return result
+}
+
+// optionalString returns nil for an empty string
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
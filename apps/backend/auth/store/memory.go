@@ -0,0 +1,287 @@
+package store
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+)
+
+// MemoryUserStore keeps users in memory, keyed by email and tenant slug.
+// It is intended for tests and local development without a database.
+type MemoryUserStore struct {
+	users          map[string]*models.User // key: email|tenant_slug
+	tenantSettings map[string]map[string]interface{}
+	mutex          sync.RWMutex
+}
+
+// NewMemoryUserStore creates an empty in-memory user store
+func NewMemoryUserStore() *MemoryUserStore {
+	return &MemoryUserStore{
+		users:          make(map[string]*models.User),
+		tenantSettings: make(map[string]map[string]interface{}),
+	}
+}
+
+// AddUser adds a user to a tenant and hashes the given password
+func (s *MemoryUserStore) AddUser(tenantSlug string, user *models.User, password string) error {
+	if err := user.HashPassword(password); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.users[memoryUserKey(user.Email, tenantSlug)] = user
+	return nil
+}
+
+// Authenticate verifies user credentials
+func (s *MemoryUserStore) Authenticate(tenantSlug, email, password string) (*models.User, error) {
+	s.mutex.RLock()
+	user, exists := s.users[memoryUserKey(email, tenantSlug)]
+	s.mutex.RUnlock()
+
+	if !exists || !user.CheckPassword(password) {
+		return nil, ErrInvalidCredentials
+	}
+	return user, nil
+}
+
+// GetUser returns a user by tenant ID and user ID
+func (s *MemoryUserStore) GetUser(tenantID, userID string) (*models.User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, user := range s.users {
+		if user.ID == userID && user.TenantID == tenantID {
+			return user, nil
+		}
+	}
+	return nil, ErrUserNotFound
+}
+
+// ListUsers returns the users of a tenant
+func (s *MemoryUserStore) ListUsers(tenantSlug string) ([]*models.User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	users := make([]*models.User, 0)
+	for key, user := range s.users {
+		if strings.HasSuffix(key, "|"+tenantSlug) {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// RecordLogin updates the user's last modification time
+func (s *MemoryUserStore) RecordLogin(user *models.User) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	user.UpdatedAt = time.Now()
+	return nil
+}
+
+// CreateTenant creates a tenant by adding its first user. The tenant ID is the slug.
+func (s *MemoryUserStore) CreateTenant(name, slug string, admin NewUser) (*models.User, error) {
+	if !validTenantSlug(slug) {
+		return nil, ErrInvalidSlug
+	}
+
+	s.mutex.RLock()
+	taken := s.hasTenant(slug)
+	s.mutex.RUnlock()
+	if taken {
+		return nil, ErrSlugTaken
+	}
+
+	return s.CreateUser(slug, admin)
+}
+
+// CreateUser adds a user to an existing tenant
+func (s *MemoryUserStore) CreateUser(tenantID string, newUser NewUser) (*models.User, error) {
+	user := &models.User{
+		ID:          uuid.New().String(),
+		TenantID:    tenantID,
+		Email:       strings.ToLower(strings.TrimSpace(newUser.Email)),
+		FirstName:   newUser.FirstName,
+		LastName:    newUser.LastName,
+		Roles:       []string{newUser.Role},
+		Permissions: []string{},
+		Status:      newUser.Status,
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+	if err := user.HashPassword(newUser.Password); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := memoryUserKey(user.Email, tenantID)
+	if _, exists := s.users[key]; exists {
+		return nil, ErrEmailTaken
+	}
+	s.users[key] = user
+	return user, nil
+}
+
+// SetUserStatus changes the status of a user
+func (s *MemoryUserStore) SetUserStatus(tenantID, userID, status string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, user := range s.users {
+		if user.ID == userID && user.TenantID == tenantID {
+			user.Status = status
+			user.UpdatedAt = time.Now()
+			return nil
+		}
+	}
+	return ErrUserNotFound
+}
+
+// FindUser returns a user by tenant slug and email
+func (s *MemoryUserStore) FindUser(tenantSlug, email string) (*models.User, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	user, exists := s.users[memoryUserKey(email, tenantSlug)]
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+	return user, nil
+}
+
+// SetPassword replaces the password of a user
+func (s *MemoryUserStore) SetPassword(tenantID, userID, password string) error {
+	user, err := s.GetUser(tenantID, userID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	user.UpdatedAt = time.Now()
+	return user.HashPassword(password)
+}
+
+// UpdateMFA stores the MFA fields of a user
+func (s *MemoryUserStore) UpdateMFA(user *models.User) error {
+	stored, err := s.GetUser(user.TenantID, user.ID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	stored.MFAEnabled = user.MFAEnabled
+	stored.MFASecret = user.MFASecret
+	stored.MFARecoveryCodes = user.MFARecoveryCodes
+	stored.UpdatedAt = time.Now()
+	return nil
+}
+
+// UseRecoveryCode removes an unused recovery code of a user
+func (s *MemoryUserStore) UseRecoveryCode(user *models.User, hash string) (bool, error) {
+	stored, err := s.GetUser(user.TenantID, user.ID)
+	if err != nil {
+		return false, err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	remaining, found := withoutRecoveryCode(stored.MFARecoveryCodes, hash)
+	if !found {
+		return false, nil
+	}
+	stored.MFARecoveryCodes = remaining
+	stored.UpdatedAt = time.Now()
+	user.MFARecoveryCodes = remaining
+	return true, nil
+}
+
+// SetTenantSettings replaces the settings of a tenant
+func (s *MemoryUserStore) SetTenantSettings(tenantID string, settings map[string]interface{}) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.tenantSettings[tenantID] = settings
+}
+
+// TenantSettings returns the settings of a tenant, empty when none were set
+func (s *MemoryUserStore) TenantSettings(tenantID string) (map[string]interface{}, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if settings, exists := s.tenantSettings[tenantID]; exists {
+		return settings, nil
+	}
+	if !s.hasTenant(tenantID) {
+		return nil, ErrTenantNotFound
+	}
+	return map[string]interface{}{}, nil
+}
+
+// UpdateTenantSettings sets the given top-level keys of a tenant's settings
+func (s *MemoryUserStore) UpdateTenantSettings(tenantID string, settings map[string]interface{}) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.hasTenant(tenantID) {
+		return ErrTenantNotFound
+	}
+
+	merged := make(map[string]interface{}, len(s.tenantSettings[tenantID])+len(settings))
+	for key, value := range s.tenantSettings[tenantID] {
+		merged[key] = value
+	}
+	for key, value := range settings {
+		merged[key] = value
+	}
+	s.tenantSettings[tenantID] = merged
+	return nil
+}
+
+// TenantID returns the tenant ID of a slug, which is the slug itself
+func (s *MemoryUserStore) TenantID(tenantSlug string) (string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if !s.hasTenant(tenantSlug) {
+		return "", ErrTenantNotFound
+	}
+	return tenantSlug, nil
+}
+
+// SetUserRoles replaces the roles of a user
+func (s *MemoryUserStore) SetUserRoles(tenantID, userID string, roles []string) error {
+	user, err := s.GetUser(tenantID, userID)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	user.Roles = append([]string{}, roles...)
+	user.UpdatedAt = time.Now()
+	return nil
+}
+
+// hasTenant reports whether any user belongs to the tenant. The caller must hold the mutex.
+func (s *MemoryUserStore) hasTenant(tenantSlug string) bool {
+	for key := range s.users {
+		if strings.HasSuffix(key, "|"+tenantSlug) {
+			return true
+		}
+	}
+	return false
+}
+
+func memoryUserKey(email, tenantSlug string) string {
+	return fmt.Sprintf("%s|%s", strings.ToLower(email), strings.ToLower(tenantSlug))
+}
@@ -0,0 +1,463 @@
+package store
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	sharedmodels "github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+)
+
+// MemoryRoleStore keeps roles, permissions and assignments in memory.
+// It is intended for tests and local development without a database.
+type MemoryRoleStore struct {
+	roles           map[string]*models.Role         // key: role ID
+	permissions     map[string]*models.Permission   // key: permission ID
+	rolePermissions map[string][]string             // key: role ID
+	userRoles       map[string][]string             // key: tenant_id|user_id
+	templates       map[string]*models.RoleTemplate // key: template name
+	mutex           sync.RWMutex
+}
+
+// NewMemoryRoleStore creates an empty in-memory role store
+func NewMemoryRoleStore() *MemoryRoleStore {
+	return &MemoryRoleStore{
+		roles:           make(map[string]*models.Role),
+		permissions:     make(map[string]*models.Permission),
+		rolePermissions: make(map[string][]string),
+		userRoles:       make(map[string][]string),
+		templates:       make(map[string]*models.RoleTemplate),
+	}
+}
+
+// AddRole adds a role to a tenant and grants it the named catalogue permissions
+func (s *MemoryRoleStore) AddRole(tenantID string, role *models.Role, permissions ...string) error {
+	if err := s.CreateRole(tenantID, role); err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, name := range permissions {
+		permission := s.findPermission(name)
+		if permission == nil {
+			return ErrPermissionNotFound
+		}
+		s.rolePermissions[role.ID] = append(s.rolePermissions[role.ID], permission.ID)
+	}
+	return nil
+}
+
+// AddTemplate adds a role template at version 1
+func (s *MemoryRoleStore) AddTemplate(template *models.RoleTemplate) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	template.ID = uuid.New().String()
+	template.Version = 1
+	template.UpdatedAt = time.Now()
+	copied := *template
+	s.templates[template.Name] = &copied
+}
+
+// SeedTenant creates a system role for every template the tenant has no role of the same name for
+func (s *MemoryRoleStore) SeedTenant(tenantID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, template := range s.templates {
+		if s.findRole(tenantID, template.Name) != nil {
+			continue
+		}
+		role := &models.Role{
+			ID:           uuid.New().String(),
+			TenantID:     tenantID,
+			Name:         template.Name,
+			IsSystemRole: true,
+			CreatedAt:    time.Now(),
+		}
+		s.applyTemplate(role, template)
+		s.roles[role.ID] = role
+	}
+	return nil
+}
+
+// ListRoles returns the roles of a tenant ordered by name
+func (s *MemoryRoleStore) ListRoles(tenantID string) ([]*models.Role, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	roles := []*models.Role{}
+	for _, role := range s.roles {
+		if role.TenantID == tenantID {
+			copied := *role
+			roles = append(roles, &copied)
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i].Name < roles[j].Name })
+	return roles, nil
+}
+
+// GetRole returns a role of the tenant by ID
+func (s *MemoryRoleStore) GetRole(tenantID, roleID string) (*models.Role, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	role, err := s.getRole(tenantID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	copied := *role
+	return &copied, nil
+}
+
+// CreateRole adds a role to the tenant. Role names are unique within a tenant.
+func (s *MemoryRoleStore) CreateRole(tenantID string, role *models.Role) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.findRole(tenantID, role.Name) != nil {
+		return ErrRoleExists
+	}
+
+	now := time.Now()
+	role.ID = uuid.New().String()
+	role.TenantID = tenantID
+	role.CreatedAt = now
+	role.UpdatedAt = now
+
+	copied := *role
+	s.roles[role.ID] = &copied
+	return nil
+}
+
+// UpdateRole stores the display name and description of a role
+func (s *MemoryRoleStore) UpdateRole(tenantID string, role *models.Role) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	existing, err := s.getRole(tenantID, role.ID)
+	if err != nil {
+		return err
+	}
+	existing.DisplayName = role.DisplayName
+	existing.Description = role.Description
+	existing.Customized = existing.TemplateID != ""
+	existing.UpdatedAt = time.Now()
+
+	*role = *existing
+	return nil
+}
+
+// DeleteRole removes a role together with its permission and user assignments
+func (s *MemoryRoleStore) DeleteRole(tenantID, roleID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	role, err := s.getRole(tenantID, roleID)
+	if err != nil {
+		return err
+	}
+	if role.IsSystemRole {
+		return ErrSystemRole
+	}
+
+	delete(s.roles, roleID)
+	delete(s.rolePermissions, roleID)
+	for key, roleIDs := range s.userRoles {
+		s.userRoles[key] = removeID(roleIDs, roleID)
+	}
+	return nil
+}
+
+// ListPermissions returns the permission catalogue ordered by name. The catalogue is the
+// same for every tenant.
+func (s *MemoryRoleStore) ListPermissions(tenantID string) ([]*models.Permission, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	permissions := make([]*models.Permission, 0, len(s.permissions))
+	for _, permission := range s.permissions {
+		copied := *permission
+		permissions = append(permissions, &copied)
+	}
+	sort.Slice(permissions, func(i, j int) bool { return permissions[i].Name < permissions[j].Name })
+	return permissions, nil
+}
+
+// CreatePermission adds a permission to the catalogue
+func (s *MemoryRoleStore) CreatePermission(permission *models.Permission) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.findPermission(permission.Name) != nil {
+		return ErrPermissionExists
+	}
+
+	permission.ID = uuid.New().String()
+	permission.CreatedAt = time.Now()
+
+	copied := *permission
+	s.permissions[permission.ID] = &copied
+	return nil
+}
+
+// GetRolePermissions returns the permissions granted to a role of the tenant
+func (s *MemoryRoleStore) GetRolePermissions(tenantID, roleID string) ([]*models.Permission, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	if _, err := s.getRole(tenantID, roleID); err != nil {
+		return nil, err
+	}
+
+	permissions := make([]*models.Permission, 0, len(s.rolePermissions[roleID]))
+	for _, permissionID := range s.rolePermissions[roleID] {
+		if permission, exists := s.permissions[permissionID]; exists {
+			copied := *permission
+			permissions = append(permissions, &copied)
+		}
+	}
+	return permissions, nil
+}
+
+// AssignPermission grants a permission to a role of the tenant
+func (s *MemoryRoleStore) AssignPermission(tenantID, roleID, permissionID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	role, err := s.getRole(tenantID, roleID)
+	if err != nil {
+		return err
+	}
+	if _, exists := s.permissions[permissionID]; !exists {
+		return ErrPermissionNotFound
+	}
+	if containsID(s.rolePermissions[roleID], permissionID) {
+		return ErrAlreadyAssigned
+	}
+
+	s.rolePermissions[roleID] = append(s.rolePermissions[roleID], permissionID)
+	role.Customized = role.TemplateID != ""
+	return nil
+}
+
+// GetUserRoles returns the roles assigned to a user of the tenant
+func (s *MemoryRoleStore) GetUserRoles(tenantID, userID string) ([]*models.Role, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	roleIDs := s.userRoles[memoryUserRoleKey(tenantID, userID)]
+	roles := make([]*models.Role, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		if role, exists := s.roles[roleID]; exists {
+			copied := *role
+			roles = append(roles, &copied)
+		}
+	}
+	return roles, nil
+}
+
+// AssignRole assigns a role of the tenant to one of its users
+func (s *MemoryRoleStore) AssignRole(tenantID, userID, roleID string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if _, err := s.getRole(tenantID, roleID); err != nil {
+		return err
+	}
+
+	key := memoryUserRoleKey(tenantID, userID)
+	if containsID(s.userRoles[key], roleID) {
+		return ErrAlreadyAssigned
+	}
+	s.userRoles[key] = append(s.userRoles[key], roleID)
+	return nil
+}
+
+// GetUserPermissions returns the distinct permission names granted by a user's roles
+func (s *MemoryRoleStore) GetUserPermissions(tenantID, userID string) ([]string, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	permissions := []string{}
+	seen := make(map[string]bool)
+	for _, roleID := range s.userRoles[memoryUserRoleKey(tenantID, userID)] {
+		for _, permissionID := range s.rolePermissions[roleID] {
+			if permission, exists := s.permissions[permissionID]; exists && !seen[permission.Name] {
+				seen[permission.Name] = true
+				permissions = append(permissions, permission.Name)
+			}
+		}
+	}
+	return permissions, nil
+}
+
+// ListTemplates returns the role templates ordered by module and name
+func (s *MemoryRoleStore) ListTemplates() ([]*models.RoleTemplate, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	templates := make([]*models.RoleTemplate, 0, len(s.templates))
+	for _, template := range s.templates {
+		copied := *template
+		templates = append(templates, &copied)
+	}
+	sort.Slice(templates, func(i, j int) bool {
+		if templates[i].Module != templates[j].Module {
+			return templates[i].Module < templates[j].Module
+		}
+		return templates[i].Name < templates[j].Name
+	})
+	return templates, nil
+}
+
+// UpdateTemplate changes a role template and applies it to the uncustomised roles created from it
+func (s *MemoryRoleStore) UpdateTemplate(name string, update models.UpdateRoleTemplateRequest) (*models.RoleTemplate, int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	template, exists := s.templates[name]
+	if !exists {
+		return nil, 0, ErrTemplateNotFound
+	}
+	for _, permission := range update.Permissions {
+		if err := sharedmodels.ValidatePermission(permission); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if update.DisplayName != nil {
+		template.DisplayName = *update.DisplayName
+	}
+	if update.Description != nil {
+		template.Description = *update.Description
+	}
+	if update.Permissions != nil {
+		template.Permissions = update.Permissions
+	}
+	template.Version++
+	template.UpdatedAt = time.Now()
+
+	updated := 0
+	for _, role := range s.roles {
+		if role.TemplateID == template.ID && !role.Customized && role.TemplateVersion < template.Version {
+			s.applyTemplate(role, template)
+			updated++
+		}
+	}
+
+	copied := *template
+	return &copied, updated, nil
+}
+
+// CloneTemplate creates a custom role in the tenant with the permissions of a template
+func (s *MemoryRoleStore) CloneTemplate(tenantID, templateName string, role *models.Role) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	template, exists := s.templates[templateName]
+	if !exists {
+		return ErrTemplateNotFound
+	}
+	if s.findRole(tenantID, role.Name) != nil {
+		return ErrRoleExists
+	}
+
+	displayName, description := role.DisplayName, role.Description
+	role.ID = uuid.New().String()
+	role.TenantID = tenantID
+	role.IsSystemRole = false
+	role.CreatedAt = time.Now()
+	s.applyTemplate(role, template)
+	if displayName != "" {
+		role.DisplayName = displayName
+	}
+	if description != "" {
+		role.Description = description
+	}
+	role.Customized = true
+
+	copied := *role
+	s.roles[role.ID] = &copied
+	return nil
+}
+
+// applyTemplate sets a role's details and permissions to the template's, adding missing
+// permissions to the catalogue. The caller must hold the mutex.
+func (s *MemoryRoleStore) applyTemplate(role *models.Role, template *models.RoleTemplate) {
+	role.DisplayName = template.DisplayName
+	role.Description = template.Description
+	role.TemplateID = template.ID
+	role.TemplateVersion = template.Version
+	role.UpdatedAt = time.Now()
+
+	permissionIDs := make([]string, 0, len(template.Permissions))
+	for _, name := range template.Permissions {
+		name = sharedmodels.NormalizePermission(name)
+		permission := s.findPermission(name)
+		if permission == nil {
+			resource, action, _ := strings.Cut(name, ":")
+			permission = &models.Permission{ID: uuid.New().String(), Name: name, Resource: resource, Action: action, CreatedAt: time.Now()}
+			s.permissions[permission.ID] = permission
+		}
+		permissionIDs = append(permissionIDs, permission.ID)
+	}
+	s.rolePermissions[role.ID] = permissionIDs
+}
+
+// findRole returns a role of the tenant by name. The caller must hold the mutex.
+func (s *MemoryRoleStore) findRole(tenantID, name string) *models.Role {
+	for _, role := range s.roles {
+		if role.TenantID == tenantID && strings.EqualFold(role.Name, name) {
+			return role
+		}
+	}
+	return nil
+}
+
+// getRole returns a role of the tenant. The caller must hold the mutex.
+func (s *MemoryRoleStore) getRole(tenantID, roleID string) (*models.Role, error) {
+	role, exists := s.roles[roleID]
+	if !exists || role.TenantID != tenantID {
+		return nil, ErrRoleNotFound
+	}
+	return role, nil
+}
+
+// findPermission returns a catalogue permission by name. The caller must hold the mutex.
+func (s *MemoryRoleStore) findPermission(name string) *models.Permission {
+	for _, permission := range s.permissions {
+		if strings.EqualFold(permission.Name, name) {
+			return permission
+		}
+	}
+	return nil
+}
+
+func memoryUserRoleKey(tenantID, userID string) string {
+	return tenantID + "|" + userID
+}
+
+func containsID(ids []string, id string) bool {
+	for _, existing := range ids {
+		if existing == id {
+			return true
+		}
+	}
+	return false
+}
+
+func removeID(ids []string, id string) []string {
+	result := make([]string, 0, len(ids))
+	for _, existing := range ids {
+		if existing != id {
+			result = append(result, existing)
+		}
+	}
+	return result
+}
@@ -0,0 +1,168 @@
+package store
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/auth/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// SystemTenantSlug is the tenant slug system administrators use to log in
+const SystemTenantSlug = "system"
+
+// SystemAdminRole is the role claim of system administrators. Tenant users never hold it.
+const SystemAdminRole = "system_admin"
+
+// Common store errors
+var (
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrTenantNotFound     = errors.New("tenant not found")
+	ErrTenantInactive     = errors.New("tenant is not active")
+	ErrEmailTaken         = errors.New("email already registered")
+	ErrSlugTaken          = errors.New("tenant slug already taken")
+	ErrInvalidSlug        = errors.New("invalid tenant slug")
+	ErrNotSupported       = errors.New("not supported for this account")
+	ErrRoleNotFound       = errors.New("role not found")
+	ErrRoleExists         = errors.New("role already exists")
+	ErrSystemRole         = errors.New("system roles cannot be deleted")
+	ErrPermissionNotFound = errors.New("permission not found")
+	ErrPermissionExists   = errors.New("permission already exists")
+	ErrAlreadyAssigned    = errors.New("already assigned")
+	ErrTemplateNotFound   = errors.New("role template not found")
+	ErrReservedRole       = errors.New("role name is reserved")
+)
+
+// NewUser describes an account created through registration
+type NewUser struct {
+	Email     string
+	Password  string
+	FirstName string
+	LastName  string
+	Role      string // Role name within the tenant
+	Status    string // "pending" until the email address is verified
+}
+
+// UserStore loads and authenticates users for the auth service
+type UserStore interface {
+	// Authenticate verifies the credentials of a user in the tenant identified by slug.
+	// Inactive users are returned without error so the caller can report the account status.
+	Authenticate(tenantSlug, email, password string) (*models.User, error)
+
+	// GetUser returns a user by tenant ID and user ID
+	GetUser(tenantID, userID string) (*models.User, error)
+
+	// ListUsers returns the users of the tenant identified by slug
+	ListUsers(tenantSlug string) ([]*models.User, error)
+
+	// RecordLogin stores the user's last login time
+	RecordLogin(user *models.User) error
+
+	// CreateTenant creates a trial tenant with a trial subscription and admin as its first user
+	CreateTenant(name, slug string, admin NewUser) (*models.User, error)
+
+	// CreateUser adds a user to an existing tenant. It fails with ErrRoleNotFound when the
+	// tenant does not have the user's role.
+	CreateUser(tenantID string, user NewUser) (*models.User, error)
+
+	// SetUserStatus changes the status of a user, e.g. to activate a verified account
+	SetUserStatus(tenantID, userID, status string) error
+
+	// FindUser returns a user by tenant slug and email without checking the password
+	FindUser(tenantSlug, email string) (*models.User, error)
+
+	// SetPassword replaces the password of a user
+	SetPassword(tenantID, userID, password string) error
+
+	// UpdateMFA stores the MFA secret, enabled flag and recovery code hashes of a user
+	UpdateMFA(user *models.User) error
+
+	// UseRecoveryCode removes a recovery code hash from the user's unused codes. It reports
+	// false when the user has no such code, also when a concurrent login just used it.
+	UseRecoveryCode(user *models.User, hash string) (bool, error)
+
+	// TenantSettings returns the settings of a tenant by ID
+	TenantSettings(tenantID string) (map[string]interface{}, error)
+
+	// UpdateTenantSettings sets the given top-level keys of a tenant's settings
+	UpdateTenantSettings(tenantID string, settings map[string]interface{}) error
+
+	// TenantID returns the ID of an active tenant by slug
+	TenantID(tenantSlug string) (string, error)
+
+	// SetUserRoles replaces the roles of a user. It fails with ErrRoleNotFound for roles
+	// the tenant does not have.
+	SetUserRoles(tenantID, userID string, roles []string) error
+}
+
+// RoleStore persists the roles of each tenant, the permission catalogue shared by all
+// tenants and the assignments between them. Roles and assignments are scoped by tenant ID.
+type RoleStore interface {
+	// ListRoles returns the roles of a tenant
+	ListRoles(tenantID string) ([]*models.Role, error)
+
+	// GetRole returns a role of the tenant by ID
+	GetRole(tenantID, roleID string) (*models.Role, error)
+
+	// CreateRole adds a role to the tenant and sets its ID
+	CreateRole(tenantID string, role *models.Role) error
+
+	// UpdateRole stores the display name and description of a role
+	UpdateRole(tenantID string, role *models.Role) error
+
+	// DeleteRole removes a role and its assignments. System roles cannot be deleted.
+	DeleteRole(tenantID, roleID string) error
+
+	// ListPermissions returns the permission catalogue as seen by the tenant
+	ListPermissions(tenantID string) ([]*models.Permission, error)
+
+	// CreatePermission adds a permission to the catalogue and sets its ID
+	CreatePermission(permission *models.Permission) error
+
+	// GetRolePermissions returns the permissions granted to a role of the tenant
+	GetRolePermissions(tenantID, roleID string) ([]*models.Permission, error)
+
+	// AssignPermission grants a permission to a role of the tenant
+	AssignPermission(tenantID, roleID, permissionID string) error
+
+	// GetUserRoles returns the roles assigned to a user of the tenant
+	GetUserRoles(tenantID, userID string) ([]*models.Role, error)
+
+	// AssignRole assigns a role of the tenant to one of its users
+	AssignRole(tenantID, userID, roleID string) error
+
+	// GetUserPermissions returns the distinct permission names granted by a user's roles
+	GetUserPermissions(tenantID, userID string) ([]string, error)
+
+	// ListTemplates returns the system role templates
+	ListTemplates() ([]*models.RoleTemplate, error)
+
+	// UpdateTemplate changes a role template and applies it to the tenant roles created
+	// from it that were not customised. It returns the number of roles updated.
+	UpdateTemplate(name string, update models.UpdateRoleTemplateRequest) (*models.RoleTemplate, int, error)
+
+	// CloneTemplate creates a custom role in the tenant with the permissions of a template
+	// and sets the role's ID
+	CloneTemplate(tenantID, templateName string, role *models.Role) error
+}
+
+// ReservedRoleName reports whether tenants cannot create a role of this name: the system
+// admin role, or the name of a system role template seeded into every tenant
+func ReservedRoleName(name string) bool {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == SystemAdminRole {
+		return true
+	}
+	for _, template := range services.DefaultRoleTemplates {
+		if template.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// validTenantSlug checks the slug format and that it is not reserved
+func validTenantSlug(slug string) bool {
+	return slug != SystemTenantSlug && services.IsValidSlug(slug)
+}
@@ -0,0 +1,484 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// fakeRedisServer is a minimal stand-in speaking the Redis protocol for the
+// commands used by auth.RedisStore. Set REDIS_TEST_ADDR to run against a real server.
+type fakeRedisServer struct {
+	listener    net.Listener
+	values      map[string]string
+	sets        map[string]map[string]bool
+	expiry      map[string]time.Time
+	subscribers map[string][]net.Conn // Connections subscribed to each channel
+	mutex       sync.Mutex
+}
+
+func newFakeRedisServer(t *testing.T) *fakeRedisServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to start fake redis: %v", err)
+	}
+
+	server := &fakeRedisServer{
+		listener:    listener,
+		values:      make(map[string]string),
+		sets:        make(map[string]map[string]bool),
+		expiry:      make(map[string]time.Time),
+		subscribers: make(map[string][]net.Conn),
+	}
+	go server.serve()
+	t.Cleanup(func() { listener.Close() })
+
+	return server
+}
+
+func (s *fakeRedisServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeRedisServer) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeRedisServer) handle(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+
+	for {
+		args, err := readFakeCommand(reader)
+		if err != nil {
+			s.unsubscribe(conn)
+			return
+		}
+		if strings.ToUpper(args[0]) == "SUBSCRIBE" {
+			s.subscribe(conn, args[1])
+			continue
+		}
+		io.WriteString(conn, s.execute(args))
+	}
+}
+
+// subscribe confirms a subscription and sends the channel's messages to the connection
+func (s *fakeRedisServer) subscribe(conn net.Conn, channel string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	fmt.Fprintf(conn, "*3\r\n$9\r\nsubscribe\r\n$%d\r\n%s\r\n:1\r\n", len(channel), channel)
+	s.subscribers[channel] = append(s.subscribers[channel], conn)
+}
+
+func (s *fakeRedisServer) unsubscribe(conn net.Conn) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for channel, conns := range s.subscribers {
+		for i, subscriber := range conns {
+			if subscriber == conn {
+				s.subscribers[channel] = append(conns[:i:i], conns[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func readFakeCommand(reader *bufio.Reader) ([]string, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	count, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+
+	args := make([]string, count)
+	for i := range args {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		size, _ := strconv.Atoi(strings.TrimSpace(line[1:]))
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		args[i] = string(data[:size])
+	}
+	return args, nil
+}
+
+func (s *fakeRedisServer) execute(args []string) string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for key, expiresAt := range s.expiry {
+		if time.Now().After(expiresAt) {
+			delete(s.values, key)
+			delete(s.sets, key)
+			delete(s.expiry, key)
+		}
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "PING":
+		return "+PONG\r\n"
+	case "AUTH", "SELECT":
+		return "+OK\r\n"
+	case "SET":
+		key := args[1]
+		var ttl time.Duration
+		nx := false
+		for i := 3; i < len(args); i++ {
+			switch strings.ToUpper(args[i]) {
+			case "NX":
+				nx = true
+			case "PX":
+				ms, _ := strconv.Atoi(args[i+1])
+				ttl = time.Duration(ms) * time.Millisecond
+				i++
+			}
+		}
+		if _, exists := s.values[key]; exists && nx {
+			return "$-1\r\n"
+		}
+		s.values[key] = args[2]
+		delete(s.expiry, key)
+		if ttl > 0 {
+			s.expiry[key] = time.Now().Add(ttl)
+		}
+		return "+OK\r\n"
+	case "GET":
+		value, exists := s.values[args[1]]
+		if !exists {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(value), value)
+	case "DEL":
+		for _, key := range args[1:] {
+			delete(s.values, key)
+			delete(s.sets, key)
+			delete(s.expiry, key)
+		}
+		return fmt.Sprintf(":%d\r\n", len(args)-1)
+	case "INCR":
+		count, _ := strconv.Atoi(s.values[args[1]])
+		s.values[args[1]] = strconv.Itoa(count + 1)
+		return fmt.Sprintf(":%d\r\n", count+1)
+	case "PEXPIRE":
+		ms, _ := strconv.Atoi(args[2])
+		s.expiry[args[1]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+		return ":1\r\n"
+	case "PERSIST":
+		delete(s.expiry, args[1])
+		return ":1\r\n"
+	case "SADD":
+		if s.sets[args[1]] == nil {
+			s.sets[args[1]] = make(map[string]bool)
+		}
+		for _, member := range args[2:] {
+			s.sets[args[1]][member] = true
+		}
+		return fmt.Sprintf(":%d\r\n", len(args)-2)
+	case "SREM":
+		for _, member := range args[2:] {
+			delete(s.sets[args[1]], member)
+		}
+		return fmt.Sprintf(":%d\r\n", len(args)-2)
+	case "SMEMBERS":
+		members := s.sets[args[1]]
+		reply := fmt.Sprintf("*%d\r\n", len(members))
+		for member := range members {
+			reply += fmt.Sprintf("$%d\r\n%s\r\n", len(member), member)
+		}
+		return reply
+	case "PUBLISH":
+		for _, conn := range s.subscribers[args[1]] {
+			fmt.Fprintf(conn, "*3\r\n$7\r\nmessage\r\n$%d\r\n%s\r\n$%d\r\n%s\r\n", len(args[1]), args[1], len(args[2]), args[2])
+		}
+		return fmt.Sprintf(":%d\r\n", len(s.subscribers[args[1]]))
+	case "EVAL":
+		keyCount, _ := strconv.Atoi(args[2])
+		return s.eval(args[1], args[3:3+keyCount], args[3+keyCount:])
+	default:
+		return fmt.Sprintf("-ERR unknown command '%s'\r\n", args[0])
+	}
+}
+
+// eval runs the scripts of auth.RedisStore, which it recognises by their first line.
+// The caller holds the mutex, so scripts run atomically as on a real server.
+func (s *fakeRedisServer) eval(script string, keys, argv []string) string {
+	switch strings.SplitN(script, "\n", 2)[0] {
+	case "-- compare_and_swap":
+		if value, exists := s.values[keys[0]]; !exists || value != argv[0] {
+			return ":0\r\n"
+		}
+		s.values[keys[0]] = argv[1]
+		delete(s.expiry, keys[0])
+		if ms, _ := strconv.Atoi(argv[2]); ms > 0 {
+			s.expiry[keys[0]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+		}
+		return ":1\r\n"
+	case "-- compare_and_delete":
+		if value, exists := s.values[keys[0]]; !exists || value != argv[0] {
+			return ":0\r\n"
+		}
+		delete(s.values, keys[0])
+		delete(s.expiry, keys[0])
+		return ":1\r\n"
+	case "-- incr_expire":
+		count, _ := strconv.Atoi(s.values[keys[0]])
+		s.values[keys[0]] = strconv.Itoa(count + 1)
+		if ms, _ := strconv.Atoi(argv[0]); count == 0 && ms > 0 {
+			s.expiry[keys[0]] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+		}
+		return fmt.Sprintf(":%d\r\n", count+1)
+	default:
+		return "-NOSCRIPT unknown script\r\n"
+	}
+}
+
+// newTestRedisStore connects a RedisStore to REDIS_TEST_ADDR or a fake server
+func newTestRedisStore(t *testing.T, addr string) *auth.RedisStore {
+	store, err := auth.NewRedisStore(auth.RedisOptions{
+		Addr:      addr,
+		KeyPrefix: fmt.Sprintf("zplus:test:%d:", time.Now().UnixNano()),
+	})
+	if err != nil {
+		t.Fatalf("Failed to connect redis store: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func testRedisAddr(t *testing.T) string {
+	if addr := os.Getenv("REDIS_TEST_ADDR"); addr != "" {
+		return addr
+	}
+	return newFakeRedisServer(t).Addr()
+}
+
+func TestTokenStores(t *testing.T) {
+	stores := map[string]auth.Store{
+		"memory": auth.NewMemoryStore(),
+		"redis":  newTestRedisStore(t, testRedisAddr(t)),
+	}
+
+	for name, store := range stores {
+		if _, err := store.Get("missing"); err != auth.ErrNotFound {
+			t.Fatalf("%s: expected ErrNotFound, got %v", name, err)
+		}
+
+		store.Set("key", []byte("value"), time.Minute)
+		if value, _ := store.Get("key"); string(value) != "value" {
+			t.Fatalf("%s: expected value, got %q", name, value)
+		}
+
+		if stored, _ := store.SetNX("key", []byte("other"), time.Minute); stored {
+			t.Fatalf("%s: SetNX should not overwrite an existing key", name)
+		}
+		if stored, _ := store.SetNX("fresh", []byte("1"), time.Minute); !stored {
+			t.Fatalf("%s: SetNX should store a new key", name)
+		}
+
+		store.Set("short", []byte("1"), 50*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+		if _, err := store.Get("short"); err != auth.ErrNotFound {
+			t.Fatalf("%s: expected key to expire, got %v", name, err)
+		}
+
+		if swapped, _ := store.CompareAndSwap("key", []byte("other"), []byte("next"), time.Minute); swapped {
+			t.Fatalf("%s: CompareAndSwap should not replace a value that changed", name)
+		}
+		if swapped, _ := store.CompareAndSwap("key", []byte("value"), []byte("next"), time.Minute); !swapped {
+			t.Fatalf("%s: CompareAndSwap should replace an unchanged value", name)
+		}
+		if value, _ := store.Get("key"); string(value) != "next" {
+			t.Fatalf("%s: expected swapped value, got %q", name, value)
+		}
+		if swapped, _ := store.CompareAndSwap("missing", []byte(""), []byte("1"), time.Minute); swapped {
+			t.Fatalf("%s: CompareAndSwap should not create a key", name)
+		}
+
+		if deleted, _ := store.CompareAndDelete("key", []byte("value")); deleted {
+			t.Fatalf("%s: CompareAndDelete should not remove a value that changed", name)
+		}
+		if deleted, _ := store.CompareAndDelete("key", []byte("next")); !deleted {
+			t.Fatalf("%s: CompareAndDelete should remove an unchanged value", name)
+		}
+		if _, err := store.Get("key"); err != auth.ErrNotFound {
+			t.Fatalf("%s: expected deleted key, got %v", name, err)
+		}
+
+		store.Incr("counter", time.Minute)
+		if count, _ := store.Incr("counter", time.Minute); count != 2 {
+			t.Fatalf("%s: expected counter 2, got %d", name, count)
+		}
+		store.Incr("window", 50*time.Millisecond)
+		time.Sleep(100 * time.Millisecond)
+		if count, _ := store.Incr("window", 50*time.Millisecond); count != 1 {
+			t.Fatalf("%s: expected counter to restart after its window, got %d", name, count)
+		}
+
+		sessions := auth.NewSessionManager(store, 50*time.Millisecond)
+		sessions.CreateSession("token-1", "family-1", "user-1", "demo-corp", "admin@demo-corp.zplus.com", "127.0.0.1", "test")
+		time.Sleep(100 * time.Millisecond)
+		if members, _ := store.SetMembers("user_sessions:user-1"); len(members) != 0 {
+			t.Fatalf("%s: expected session index to expire with its sessions, got %v", name, members)
+		}
+
+		store.SetAdd("set", "a", "b", "c")
+		store.SetRemove("set", "b")
+		members, _ := store.SetMembers("set")
+		if len(members) != 2 {
+			t.Fatalf("%s: expected 2 set members, got %v", name, members)
+		}
+
+		store.Delete("key", "set")
+		if _, err := store.Get("key"); err != auth.ErrNotFound {
+			t.Fatalf("%s: expected deleted key to be gone, got %v", name, err)
+		}
+	}
+
+	t.Log("✓ Memory and redis token stores behave the same")
+}
+
+func TestRedisStoreTimesOut(t *testing.T) {
+	// A server that accepts connections but never answers
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer listener.Close()
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	started := time.Now()
+	_, err = auth.NewRedisStore(auth.RedisOptions{Addr: listener.Addr().String(), Timeout: 100 * time.Millisecond})
+	if err == nil {
+		t.Fatal("Expected an unresponsive server to fail the connection")
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Fatalf("Expected the command to time out quickly, took %v", elapsed)
+	}
+
+	t.Log("✓ Redis commands time out on an unresponsive server")
+}
+
+func TestLogoutVisibleAcrossServices(t *testing.T) {
+	addr := testRedisAddr(t)
+	prefix := fmt.Sprintf("zplus:test:%d:", time.Now().UnixNano())
+
+	// The auth service issues tokens, the gateway only verifies them
+	keyManager := auth.NewKeyManager(time.Hour)
+	keyManager.Rotate(auth.AlgorithmEdDSA)
+
+	authStore, _ := auth.NewRedisStore(auth.RedisOptions{Addr: addr, KeyPrefix: prefix})
+	gatewayStore, _ := auth.NewRedisStore(auth.RedisOptions{Addr: addr, KeyPrefix: prefix})
+	defer authStore.Close()
+	defer gatewayStore.Close()
+
+	authService := auth.NewKeyedTokenManager(keyManager, "zplus-saas")
+	authService.SetStore(authStore)
+	gateway := auth.NewVerifyingTokenManager(keyManager, "zplus-saas")
+	gateway.SetStore(gatewayStore)
+
+	tokens, err := authService.GenerateTokenPair("user-1", "demo-corp", "tenant_admin")
+	if err != nil {
+		t.Fatalf("Failed to generate tokens: %v", err)
+	}
+	authService.CreateSession(tokens.AccessClaims.TokenID, tokens.FamilyID, "user-1", "demo-corp", "admin@demo-corp.zplus.com", "127.0.0.1", "test")
+
+	if _, err := gateway.ValidateToken(tokens.AccessToken); err != nil {
+		t.Fatalf("Gateway should accept a live token: %v", err)
+	}
+	sessions, _ := gateway.GetUserSessions("user-1")
+	if len(sessions) != 1 {
+		t.Fatalf("Gateway should see the session created by the auth service, got %d", len(sessions))
+	}
+
+	if err := authService.InvalidateToken(tokens.AccessToken); err != nil {
+		t.Fatalf("Logout failed: %v", err)
+	}
+
+	if _, err := gateway.ValidateToken(tokens.AccessToken); err == nil {
+		t.Fatal("Gateway should reject a token logged out at the auth service")
+	}
+	sessions, _ = gateway.GetUserSessions("user-1")
+	if len(sessions) != 0 {
+		t.Fatalf("Expected no sessions after logout, got %d", len(sessions))
+	}
+
+	t.Log("✓ Logout at the auth service is enforced by the gateway")
+}
+
+func TestEventsRelayedAcrossServices(t *testing.T) {
+	addr := testRedisAddr(t)
+	prefix := fmt.Sprintf("zplus:test:%d:", time.Now().UnixNano())
+
+	authStore, _ := auth.NewRedisStore(auth.RedisOptions{Addr: addr, KeyPrefix: prefix})
+	gatewayStore, _ := auth.NewRedisStore(auth.RedisOptions{Addr: addr, KeyPrefix: prefix})
+	defer authStore.Close()
+	defer gatewayStore.Close()
+
+	// The auth service and a gateway replica each relay their bus over redis
+	authEvents := services.NewEventBus()
+	gatewayEvents := services.NewEventBus()
+	for _, relay := range []struct {
+		events *services.EventBus
+		store  *auth.RedisStore
+	}{{authEvents, authStore}, {gatewayEvents, gatewayStore}} {
+		stop, err := relay.events.Relay(relay.store, services.EventsChannel)
+		if err != nil {
+			t.Fatalf("Failed to relay events: %v", err)
+		}
+		defer stop()
+	}
+
+	local := make(chan services.Event, 10)
+	remote := make(chan services.Event, 10)
+	authEvents.Subscribe(services.EventRolesChanged, func(event services.Event) { local <- event })
+	gatewayEvents.Subscribe(services.EventRolesChanged, func(event services.Event) { remote <- event })
+
+	changed := services.Event{Type: services.EventRolesChanged, TenantID: uuid.New(), UserID: uuid.New()}
+	authEvents.Publish(changed)
+
+	select {
+	case event := <-remote:
+		if event != changed {
+			t.Fatalf("Expected %+v, got %+v", changed, event)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected the gateway to receive the event published by the auth service")
+	}
+
+	// The publishing bus delivers its own event once, not again when it comes back
+	time.Sleep(100 * time.Millisecond)
+	if len(local) != 1 {
+		t.Fatalf("Expected the event to be delivered once locally, got %d", len(local))
+	}
+
+	t.Log("✓ Events published by one service reach the buses of the others")
+}
@@ -0,0 +1,83 @@
+package clients
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// APIError is an error response returned by another service
+type APIError struct {
+	Status  int
+	Code    string
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return e.Message
+}
+
+// AuthClient calls the auth service for flows that need its user store and mailer
+type AuthClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewAuthClient creates a client for the auth service at baseURL
+func NewAuthClient(baseURL string) *AuthClient {
+	return &AuthClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// ForgotPassword asks the auth service to email a password reset link
+func (c *AuthClient) ForgotPassword(email, tenantSlug string) error {
+	return c.post("/forgot-password", map[string]string{
+		"email":       email,
+		"tenant_slug": tenantSlug,
+	})
+}
+
+// ResetPassword sets a new password with a reset token
+func (c *AuthClient) ResetPassword(token, password string) error {
+	return c.post("/reset-password", map[string]string{
+		"token":    token,
+		"password": password,
+	})
+}
+
+// post sends a JSON request and converts error responses into an APIError
+func (c *AuthClient) post(path string, payload interface{}) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Post(c.baseURL+path, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to reach auth service: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 400 {
+		return nil
+	}
+
+	apiErr := &APIError{Status: resp.StatusCode}
+	var errorResponse struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&errorResponse); err == nil {
+		apiErr.Code = errorResponse.Code
+		apiErr.Message = errorResponse.Message
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = fmt.Sprintf("auth service returned status %d", resp.StatusCode)
+	}
+	return apiErr
+}
@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+const defaultSearchPath = `"$user", public`
+
+// fakeDriver is the statement log shared by the fake databases of the tests. It records
+// every statement with its arguments and fails the first one containing failOn.
+type fakeDriver struct {
+	mu         sync.Mutex
+	failOn     string
+	statements []string
+	args       [][]driver.NamedValue
+}
+
+// fakeDatabase answers the queries of a fake database. Rows left nil find nothing.
+type fakeDatabase interface {
+	log() *fakeDriver
+	query(c *fakeConn, query string, args []driver.NamedValue) (driver.Rows, error)
+}
+
+// fakeExecer is implemented by fake databases answering statements other than queries.
+// A nil result affects one row.
+type fakeExecer interface {
+	exec(c *fakeConn, query string, args []driver.NamedValue) (driver.Result, error)
+}
+
+func (d *fakeDriver) log() *fakeDriver {
+	return d
+}
+
+// record logs a statement and returns the error it fails with, if any
+func (d *fakeDriver) record(query string, args []driver.NamedValue) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.statements = append(d.statements, query)
+	d.args = append(d.args, args)
+	if d.failOn != "" && strings.Contains(query, d.failOn) {
+		d.failOn = ""
+		return fmt.Errorf("connection reset by peer")
+	}
+	return nil
+}
+
+// index returns the position of the first statement after from containing part, or -1
+func (d *fakeDriver) index(part string, from int) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i := from; i < len(d.statements); i++ {
+		if strings.Contains(d.statements[i], part) {
+			return i
+		}
+	}
+	return -1
+}
+
+// ordered reports whether statements containing the parts ran in the given order
+func (d *fakeDriver) ordered(parts ...string) error {
+	position := 0
+	for _, part := range parts {
+		i := d.index(part, position)
+		if i < 0 {
+			return fmt.Errorf("no %q after statement %d in:\n%s", part, position, strings.Join(d.statements, "\n"))
+		}
+		position = i + 1
+	}
+	return nil
+}
+
+// fakeConnector opens the connections of a fake database
+type fakeConnector struct {
+	database fakeDatabase
+}
+
+func (f fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{database: f.database, session: defaultSearchPath}, nil
+}
+
+func (f fakeConnector) Driver() driver.Driver {
+	return f
+}
+
+func (f fakeConnector) Open(string) (driver.Conn, error) {
+	return f.Connect(context.Background())
+}
+
+// fakeConn keeps a search_path per connection the way Postgres does: set_config(..., true)
+// lasts until the transaction ends and is undone by rolling back to a savepoint
+type fakeConn struct {
+	database   fakeDatabase
+	session    string
+	local      *string
+	inTx       bool
+	savepoints []*string
+}
+
+func (c *fakeConn) searchPath() string {
+	if c.local != nil {
+		return *c.local
+	}
+	return c.session
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepared statements are not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	c.inTx = true
+	return c, nil
+}
+
+// Commit and Rollback end the transaction, which discards its local settings
+func (c *fakeConn) Commit() error {
+	c.inTx, c.local, c.savepoints = false, nil, nil
+	return nil
+}
+
+func (c *fakeConn) Rollback() error {
+	return c.Commit()
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if err := c.database.log().record(query, args); err != nil {
+		return nil, err
+	}
+	switch {
+	case strings.HasPrefix(query, "SAVEPOINT "):
+		c.savepoints = append(c.savepoints, c.local)
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "ROLLBACK TO SAVEPOINT "):
+		if len(c.savepoints) > 0 {
+			c.local = c.savepoints[len(c.savepoints)-1]
+		}
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(query, "RELEASE SAVEPOINT "):
+		if len(c.savepoints) > 0 {
+			c.savepoints = c.savepoints[:len(c.savepoints)-1]
+		}
+		return driver.RowsAffected(0), nil
+	case strings.Contains(query, "set_config('search_path'"):
+		// A local setting has no effect outside a transaction, a session one outlives it
+		value := args[0].Value.(string)
+		if !strings.Contains(query, ", true)") {
+			c.session = value
+		} else if c.inTx {
+			c.local = &value
+		}
+		return driver.RowsAffected(0), nil
+	}
+
+	if execer, ok := c.database.(fakeExecer); ok {
+		if result, err := execer.exec(c, query, args); result != nil || err != nil {
+			return result, err
+		}
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if err := c.database.log().record(query, args); err != nil {
+		return nil, err
+	}
+	if strings.Contains(query, "current_setting('search_path')") {
+		return &fakeRows{columns: []string{"current_setting"}, values: [][]driver.Value{{c.searchPath()}}}, nil
+	}
+
+	rows, err := c.database.query(c, query, args)
+	if rows == nil && err == nil {
+		rows = &fakeRows{columns: []string{"id"}}
+	}
+	return rows, err
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+// fakeColumn returns rows of one column
+func fakeColumn(column string, values ...driver.Value) *fakeRows {
+	rows := &fakeRows{columns: []string{column}}
+	for _, value := range values {
+		rows.values = append(rows.values, []driver.Value{value})
+	}
+	return rows
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
+
+// openFakeDB opens a gorm handle over a fake database
+func openFakeDB(t *testing.T, database fakeDatabase) *gorm.DB {
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(fakeConnector{database: database})}), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	return db
+}
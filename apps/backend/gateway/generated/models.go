@@ -20,6 +20,31 @@ type TenantEntity interface {
 	GetUpdatedAt() string
 }
 
+// API key of a service account; only its prefix is ever shown after creation
+type APIKey struct {
+	ID         string  `json:"id"`
+	Prefix     string  `json:"prefix"`
+	ExpiresAt  *string `json:"expiresAt,omitempty"`
+	LastUsedAt *string `json:"lastUsedAt,omitempty"`
+	RevokedAt  *string `json:"revokedAt,omitempty"`
+	Active     bool    `json:"active"`
+	CreatedAt  string  `json:"createdAt"`
+}
+
+type APIKeyPayload struct {
+	ServiceAccount *ServiceAccount `json:"serviceAccount"`
+	APIKey         *APIKey         `json:"apiKey"`
+	Key            string          `json:"key"`
+}
+
+type AssignRolesInput struct {
+	UserIds    []string           `json:"userIds"`
+	RoleIds    []string           `json:"roleIds"`
+	Mode       RoleAssignmentMode `json:"mode"`
+	ValidFrom  *string            `json:"validFrom,omitempty"`
+	ValidUntil *string            `json:"validUntil,omitempty"`
+}
+
 type AuthPayload struct {
 	Token        string `json:"token"`
 	RefreshToken string `json:"refreshToken"`
@@ -92,6 +117,14 @@ type CreateRoleInput struct {
 	PermissionIds []string `json:"permissionIds,omitempty"`
 }
 
+type CreateServiceAccountInput struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	AllowedIps  []string `json:"allowedIps,omitempty"`
+	ExpiresAt   *string  `json:"expiresAt,omitempty"`
+}
+
 type CreateUserInput struct {
 	Email     string   `json:"email"`
 	FirstName string   `json:"firstName"`
@@ -212,6 +245,11 @@ type FloatRangeFilter struct {
 	Max *float64 `json:"max,omitempty"`
 }
 
+type ForgotPasswordInput struct {
+	Email      string `json:"email"`
+	TenantSlug string `json:"tenantSlug"`
+}
+
 type HRMActivity struct {
 	ID          string          `json:"id"`
 	TenantID    types.TenantID  `json:"tenantId"`
@@ -368,6 +406,11 @@ type ProductFilter struct {
 type Query struct {
 }
 
+type ResetPasswordInput struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
 // Role-based access control
 type Role struct {
 	ID          string         `json:"id"`
@@ -401,6 +444,29 @@ type RoleFilter struct {
 	Search *string `json:"search,omitempty"`
 }
 
+// Non-human identity of a tenant used by integrations
+type ServiceAccount struct {
+	ID          string         `json:"id"`
+	TenantID    types.TenantID `json:"tenantId"`
+	Name        string         `json:"name"`
+	Description *string        `json:"description,omitempty"`
+	Permissions []string       `json:"permissions"`
+	AllowedIps  []string       `json:"allowedIps"`
+	Keys        []*APIKey      `json:"keys"`
+	CreatedAt   string         `json:"createdAt"`
+	UpdatedAt   string         `json:"updatedAt"`
+}
+
+// A signed-in device or browser of the current user
+type Session struct {
+	ID        string `json:"id"`
+	IPAddress string `json:"ipAddress"`
+	UserAgent string `json:"userAgent"`
+	CreatedAt string `json:"createdAt"`
+	LastSeen  string `json:"lastSeen"`
+	Current   bool   `json:"current"`
+}
+
 type Subscription struct {
 }
 
@@ -1055,13 +1121,71 @@ func (e ProductStatus) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type RoleAssignmentMode string
+
+const (
+	RoleAssignmentModeAdd     RoleAssignmentMode = "ADD"
+	RoleAssignmentModeRemove  RoleAssignmentMode = "REMOVE"
+	RoleAssignmentModeReplace RoleAssignmentMode = "REPLACE"
+)
+
+var AllRoleAssignmentMode = []RoleAssignmentMode{
+	RoleAssignmentModeAdd,
+	RoleAssignmentModeRemove,
+	RoleAssignmentModeReplace,
+}
+
+func (e RoleAssignmentMode) IsValid() bool {
+	switch e {
+	case RoleAssignmentModeAdd, RoleAssignmentModeRemove, RoleAssignmentModeReplace:
+		return true
+	}
+	return false
+}
+
+func (e RoleAssignmentMode) String() string {
+	return string(e)
+}
+
+func (e *RoleAssignmentMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RoleAssignmentMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RoleAssignmentMode", str)
+	}
+	return nil
+}
+
+func (e RoleAssignmentMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RoleAssignmentMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RoleAssignmentMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type TenantStatus string
 
 const (
-	TenantStatusActive    TenantStatus = "ACTIVE"
-	TenantStatusSuspended TenantStatus = "SUSPENDED"
-	TenantStatusTrial     TenantStatus = "TRIAL"
-	TenantStatusExpired   TenantStatus = "EXPIRED"
+	TenantStatusActive          TenantStatus = "ACTIVE"
+	TenantStatusSuspended       TenantStatus = "SUSPENDED"
+	TenantStatusTrial           TenantStatus = "TRIAL"
+	TenantStatusExpired         TenantStatus = "EXPIRED"
+	TenantStatusPendingDeletion TenantStatus = "PENDING_DELETION"
 )
 
 var AllTenantStatus = []TenantStatus{
@@ -1069,11 +1193,12 @@ var AllTenantStatus = []TenantStatus{
 	TenantStatusSuspended,
 	TenantStatusTrial,
 	TenantStatusExpired,
+	TenantStatusPendingDeletion,
 }
 
 func (e TenantStatus) IsValid() bool {
 	switch e {
-	case TenantStatusActive, TenantStatusSuspended, TenantStatusTrial, TenantStatusExpired:
+	case TenantStatusActive, TenantStatusSuspended, TenantStatusTrial, TenantStatusExpired, TenantStatusPendingDeletion:
 		return true
 	}
 	return false
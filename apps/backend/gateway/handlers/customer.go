@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// CustomerHandler handles tenant-scoped customer endpoints, restricted by access policies
+type CustomerHandler struct {
+	getCustomerService func(tenantID string) *services.CustomerService
+}
+
+// NewCustomerHandler creates a new customer handler
+func NewCustomerHandler(getCustomerService func(tenantID string) *services.CustomerService) *CustomerHandler {
+	return &CustomerHandler{
+		getCustomerService: getCustomerService,
+	}
+}
+
+// GetCustomers retrieves the customers the user may read with pagination and filtering
+func (h *CustomerHandler) GetCustomers(c *fiber.Ctx) error {
+	if status, body := checkResourceAccess(c, "customers", "read"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	customerService := h.getCustomerService(string(middleware.GetTenantContext(c).ID))
+	if customerService == nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Customer service not available",
+		})
+	}
+
+	// Parse pagination params
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 10)
+	if limit > 100 {
+		limit = 100 // Max limit
+	}
+	offset := (page - 1) * limit
+
+	// Parse filters
+	filter := services.CustomerFilter{
+		Status: c.Query("status"),
+		Search: c.Query("search"),
+	}
+	if tags := c.Query("tags"); tags != "" {
+		filter.Tags = strings.Split(tags, ",")
+	}
+
+	customers, total, err := customerService.ListCustomers(middleware.GetUserContext(c).PolicySubject(), filter, offset, limit)
+	if err != nil {
+		return internalError(c, "Failed to retrieve customers", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data": customers,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+			"pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetCustomer retrieves a single customer by ID if the user may read it
+func (h *CustomerHandler) GetCustomer(c *fiber.Ctx) error {
+	if status, body := checkResourceAccess(c, "customers", "read"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	customerService := h.getCustomerService(string(middleware.GetTenantContext(c).ID))
+	if customerService == nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Customer service not available",
+		})
+	}
+
+	customerID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid customer ID",
+			"message": "Customer ID must be a valid UUID",
+		})
+	}
+
+	customer, err := customerService.GetCustomer(middleware.GetUserContext(c).PolicySubject(), customerID)
+	if err != nil {
+		return recordError(c, err, "customer")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": customer,
+	})
+}
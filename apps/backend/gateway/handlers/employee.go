@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// EmployeeHandler handles tenant-scoped employee endpoints, restricted by access policies
+type EmployeeHandler struct {
+	getEmployeeService func(tenantID string) *services.EmployeeService
+}
+
+// NewEmployeeHandler creates a new employee handler
+func NewEmployeeHandler(getEmployeeService func(tenantID string) *services.EmployeeService) *EmployeeHandler {
+	return &EmployeeHandler{
+		getEmployeeService: getEmployeeService,
+	}
+}
+
+// GetEmployees retrieves the employees the user may read with pagination and filtering
+func (h *EmployeeHandler) GetEmployees(c *fiber.Ctx) error {
+	if status, body := checkResourceAccess(c, "employees", "read"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	employeeService := h.getEmployeeService(string(middleware.GetTenantContext(c).ID))
+	if employeeService == nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Employee service not available",
+		})
+	}
+
+	// Parse pagination params
+	page := c.QueryInt("page", 1)
+	limit := c.QueryInt("limit", 10)
+	if limit > 100 {
+		limit = 100 // Max limit
+	}
+	offset := (page - 1) * limit
+
+	// Parse filters
+	filter := services.EmployeeFilter{
+		Status:       c.Query("status"),
+		DepartmentID: c.Query("department_id"),
+		Position:     c.Query("position"),
+		Search:       c.Query("search"),
+	}
+
+	employees, total, err := employeeService.ListEmployees(middleware.GetUserContext(c).PolicySubject(), filter, offset, limit)
+	if err != nil {
+		return internalError(c, "Failed to retrieve employees", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data": employees,
+		"pagination": fiber.Map{
+			"page":  page,
+			"limit": limit,
+			"total": total,
+			"pages": (total + int64(limit) - 1) / int64(limit),
+		},
+	})
+}
+
+// GetEmployee retrieves a single employee by ID if the user may read it
+func (h *EmployeeHandler) GetEmployee(c *fiber.Ctx) error {
+	if status, body := checkResourceAccess(c, "employees", "read"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	employeeService := h.getEmployeeService(string(middleware.GetTenantContext(c).ID))
+	if employeeService == nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error": "Employee service not available",
+		})
+	}
+
+	employeeID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid employee ID",
+			"message": "Employee ID must be a valid UUID",
+		})
+	}
+
+	employee, err := employeeService.GetEmployee(middleware.GetUserContext(c).PolicySubject(), employeeID)
+	if err != nil {
+		return recordError(c, err, "employee")
+	}
+
+	return c.JSON(fiber.Map{
+		"data": employee,
+	})
+}
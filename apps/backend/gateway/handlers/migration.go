@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/migrations"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// SchemaMigrator applies and reports the system and tenant schema migrations
+type SchemaMigrator interface {
+	MigrateSystem() ([]migrations.Migration, error)
+	SystemStatus() (*migrations.Status, error)
+	MigrateAllTenants() ([]services.TenantMigrationResult, error)
+	TenantStatuses() ([]services.TenantMigrationStatus, error)
+}
+
+// MigrationHandler lets system admins inspect and roll forward schema migrations
+type MigrationHandler struct {
+	migrator SchemaMigrator
+}
+
+// NewMigrationHandler creates a new migration handler
+func NewMigrationHandler(migrator SchemaMigrator) *MigrationHandler {
+	return &MigrationHandler{
+		migrator: migrator,
+	}
+}
+
+// GetMigrationStatus reports the migration status of the system schema and of every tenant schema
+func (h *MigrationHandler) GetMigrationStatus(c *fiber.Ctx) error {
+	if status, body := requireSystemAdmin(c); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	system, err := h.migrator.SystemStatus()
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error":   "Failed to retrieve migration status",
+			"message": err.Error(),
+		})
+	}
+	tenants, err := h.migrator.TenantStatuses()
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error":   "Failed to retrieve migration status",
+			"message": err.Error(),
+		})
+	}
+
+	pending := 0
+	for _, tenant := range tenants {
+		if !tenant.UpToDate() {
+			pending++
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"system":          system,
+		"tenants":         tenants,
+		"pending_tenants": pending,
+	})
+}
+
+// RunMigrations applies the pending system migrations and rolls every tenant schema
+// forward. Tenants that fail are reported without stopping the others.
+func (h *MigrationHandler) RunMigrations(c *fiber.Ctx) error {
+	if status, body := requireSystemAdmin(c); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	applied, err := h.migrator.MigrateSystem()
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error":   "Failed to migrate system schema",
+			"message": err.Error(),
+		})
+	}
+	if applied == nil {
+		applied = []migrations.Migration{}
+	}
+	results, err := h.migrator.MigrateAllTenants()
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error":   "Failed to migrate tenant schemas",
+			"message": err.Error(),
+		})
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"system_applied": applied,
+		"tenants":        results,
+		"failed_tenants": failed,
+	})
+}
+
+// requireSystemAdmin checks that the request is made by a system administrator
+func requireSystemAdmin(c *fiber.Ctx) (int, fiber.Map) {
+	requestCtx := &types.RequestContext{User: middleware.GetUserContext(c)}
+	if !requestCtx.IsAuthenticated() {
+		return 401, fiber.Map{"error": "Authentication required"}
+	}
+	if !requestCtx.IsSystemAdmin() {
+		return 403, fiber.Map{"error": "System admin access required"}
+	}
+	return 0, nil
+}
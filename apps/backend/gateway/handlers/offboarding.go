@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// TenantOffboarder deletes tenants: they are exported, kept for a grace period and purged
+type TenantOffboarder interface {
+	RequestDeletion(tenantID uuid.UUID, input services.RequestDeletionInput) (*models.TenantDeletion, error)
+	GetDeletion(tenantID uuid.UUID) (*models.TenantDeletion, error)
+	Export(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error)
+	DownloadExport(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error)
+	Restore(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error)
+	Purge(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error)
+}
+
+// OffboardingHandler lets system admins delete, export, restore and purge tenants
+type OffboardingHandler struct {
+	offboarder TenantOffboarder
+}
+
+// NewOffboardingHandler creates a new offboarding handler
+func NewOffboardingHandler(offboarder TenantOffboarder) *OffboardingHandler {
+	return &OffboardingHandler{
+		offboarder: offboarder,
+	}
+}
+
+// DeleteTenant marks a tenant pending deletion and exports its data. The tenant is
+// purged once its grace period ends.
+func (h *OffboardingHandler) DeleteTenant(c *fiber.Ctx) error {
+	tenantID, actorID, status, body := parseOffboardingRequest(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	var input services.RequestDeletionInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"error":   "Invalid request body",
+				"message": err.Error(),
+			})
+		}
+	}
+	input.RequestedBy = actorID
+
+	deletion, err := h.offboarder.RequestDeletion(tenantID, input)
+	if err != nil {
+		return offboardingError(c, "Failed to delete tenant", err)
+	}
+
+	return c.Status(202).JSON(fiber.Map{
+		"data":    deletion,
+		"message": "Tenant scheduled for deletion",
+	})
+}
+
+// GetDeletion returns the latest deletion of a tenant
+func (h *OffboardingHandler) GetDeletion(c *fiber.Ctx) error {
+	tenantID, _, status, body := parseOffboardingRequest(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	deletion, err := h.offboarder.GetDeletion(tenantID)
+	if err != nil {
+		return offboardingError(c, "Failed to retrieve tenant deletion", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data": deletion,
+	})
+}
+
+// ExportTenant exports the data of a tenant pending deletion again
+func (h *OffboardingHandler) ExportTenant(c *fiber.Ctx) error {
+	tenantID, actorID, status, body := parseOffboardingRequest(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	deletion, err := h.offboarder.Export(tenantID, actorID)
+	if err != nil {
+		return offboardingError(c, "Failed to export tenant", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data":    deletion,
+		"message": "Tenant exported successfully",
+	})
+}
+
+// DownloadExport sends the data export of a tenant pending deletion
+func (h *OffboardingHandler) DownloadExport(c *fiber.Ctx) error {
+	tenantID, actorID, status, body := parseOffboardingRequest(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	deletion, err := h.offboarder.DownloadExport(tenantID, actorID)
+	if err != nil {
+		return offboardingError(c, "Failed to download tenant export", err)
+	}
+
+	return c.Download(deletion.ExportPath, fmt.Sprintf("%s-export.zip", deletion.Slug))
+}
+
+// RestoreTenant cancels the deletion of a tenant within its grace period
+func (h *OffboardingHandler) RestoreTenant(c *fiber.Ctx) error {
+	tenantID, actorID, status, body := parseOffboardingRequest(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	deletion, err := h.offboarder.Restore(tenantID, actorID)
+	if err != nil {
+		return offboardingError(c, "Failed to restore tenant", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data":    deletion,
+		"message": "Tenant restored successfully",
+	})
+}
+
+// PurgeTenant drops the data and system rows of a tenant whose grace period has ended
+func (h *OffboardingHandler) PurgeTenant(c *fiber.Ctx) error {
+	tenantID, actorID, status, body := parseOffboardingRequest(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	deletion, err := h.offboarder.Purge(tenantID, actorID)
+	if err != nil {
+		return offboardingError(c, "Failed to purge tenant", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data":    deletion,
+		"message": "Tenant purged successfully",
+	})
+}
+
+// parseOffboardingRequest checks the caller is a system admin and returns the tenant ID
+// from the path and the caller's ID, or the status and body of the error response
+func parseOffboardingRequest(c *fiber.Ctx) (uuid.UUID, *uuid.UUID, int, fiber.Map) {
+	if status, body := requireSystemAdmin(c); body != nil {
+		return uuid.Nil, nil, status, body
+	}
+
+	tenantID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return uuid.Nil, nil, 400, fiber.Map{
+			"error":   "Invalid tenant ID",
+			"message": "Tenant ID must be a valid UUID",
+		}
+	}
+
+	var actorID *uuid.UUID
+	if id, err := uuid.Parse(middleware.GetUserContext(c).ID); err == nil {
+		actorID = &id
+	}
+	return tenantID, actorID, 0, nil
+}
+
+// offboardingError sends the response for an offboarding error
+func offboardingError(c *fiber.Ctx, message string, err error) error {
+	status := 500
+	switch {
+	case err.Error() == "tenant not found" || err.Error() == "tenant deletion not found":
+		status = 404
+	case errors.Is(err, services.ErrNoPendingDeletion), errors.Is(err, services.ErrExportUnavailable):
+		status = 404
+	case errors.Is(err, services.ErrDeletionPending), errors.Is(err, services.ErrGracePeriod):
+		status = 409
+	}
+	return c.Status(status).JSON(fiber.Map{
+		"error":   message,
+		"message": err.Error(),
+	})
+}
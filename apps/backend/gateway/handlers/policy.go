@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// PolicyHandler handles management of a tenant's access policies by tenant admins
+type PolicyHandler struct {
+	getPolicyService func(tenantID string) *services.PolicyService
+}
+
+// NewPolicyHandler creates a new policy handler
+func NewPolicyHandler(getPolicyService func(tenantID string) *services.PolicyService) *PolicyHandler {
+	return &PolicyHandler{
+		getPolicyService: getPolicyService,
+	}
+}
+
+// GetPolicies lists the tenant's access policies
+func (h *PolicyHandler) GetPolicies(c *fiber.Ctx) error {
+	policyService, status, body := h.adminPolicyService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	policies, err := policyService.ListPolicies()
+	if err != nil {
+		return internalError(c, "Failed to retrieve policies", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"data": policies,
+	})
+}
+
+// CreatePolicy adds an access policy to the tenant
+func (h *PolicyHandler) CreatePolicy(c *fiber.Ctx) error {
+	policyService, status, body := h.adminPolicyService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	var policy models.AccessPolicy
+	if err := c.BodyParser(&policy); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"message": "Please provide valid JSON data",
+		})
+	}
+	policy.ID = uuid.Nil
+
+	if err := policy.Validate(); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid policy",
+			"message": err.Error(),
+		})
+	}
+
+	if err := policyService.CreatePolicy(&policy); err != nil {
+		return internalError(c, "Failed to create policy", err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"data":    policy,
+		"message": "Policy created successfully",
+	})
+}
+
+// DeletePolicy removes an access policy from the tenant
+func (h *PolicyHandler) DeletePolicy(c *fiber.Ctx) error {
+	policyService, status, body := h.adminPolicyService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	policyID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid policy ID",
+			"message": "Policy ID must be a valid UUID",
+		})
+	}
+
+	if err := policyService.DeletePolicy(policyID); err != nil {
+		return recordError(c, err, "policy")
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Policy deleted successfully",
+	})
+}
+
+// adminPolicyService returns the policy service of the current tenant if the user is one of its admins
+func (h *PolicyHandler) adminPolicyService(c *fiber.Ctx) (*services.PolicyService, int, fiber.Map) {
+	requestCtx := &types.RequestContext{
+		Tenant: middleware.GetTenantContext(c),
+		User:   middleware.GetUserContext(c),
+	}
+	if requestCtx.Tenant == nil {
+		return nil, 400, fiber.Map{"error": "Tenant context not available"}
+	}
+	if !requestCtx.IsAuthenticated() {
+		return nil, 401, fiber.Map{"error": "Authentication required"}
+	}
+	if requestCtx.ValidateTenantAccess() != nil || !requestCtx.IsTenantAdmin() {
+		return nil, 403, fiber.Map{"error": "Tenant admin access required"}
+	}
+
+	policyService := h.getPolicyService(string(requestCtx.Tenant.ID))
+	if policyService == nil {
+		return nil, 500, fiber.Map{"error": "Policy service not available"}
+	}
+	return policyService, 0, nil
+}
+
+// checkResourceAccess checks that the request has a tenant and a user whose roles grant
+// the action on the resource. It returns the error response otherwise.
+func checkResourceAccess(c *fiber.Ctx, resource, action string) (int, fiber.Map) {
+	if middleware.GetTenantContext(c) == nil {
+		return 400, fiber.Map{"error": "Tenant context not available"}
+	}
+
+	userCtx := middleware.GetUserContext(c)
+	if userCtx == nil {
+		return 401, fiber.Map{"error": "Authentication required"}
+	}
+	if !userCtx.CanAccessResource(resource, action) {
+		return 403, fiber.Map{
+			"error":   "Insufficient permissions",
+			"message": "Missing permission " + resource + ":" + action,
+		}
+	}
+	return 0, nil
+}
+
+// recordError responds to an error loading a single record, with 403 when an access
+// policy denies access to it
+func recordError(c *fiber.Ctx, err error, kind string) error {
+	if errors.Is(err, services.ErrAccessDenied) {
+		return c.Status(403).JSON(fiber.Map{
+			"error":   "Access denied",
+			"message": "An access policy does not allow access to this " + kind,
+		})
+	}
+	if err.Error() == kind+" not found" {
+		return c.Status(404).JSON(fiber.Map{
+			"error":   "Not found",
+			"message": "No " + kind + " found with the specified ID",
+		})
+	}
+	return internalError(c, "Failed to retrieve "+kind, err)
+}
+
+// internalError responds with a 500 naming the failed operation. The cause is only
+// logged, as database errors carry SQL and schema details.
+func internalError(c *fiber.Ctx, message string, err error) error {
+	log.Printf("%s: %v", message, err)
+	return c.Status(500).JSON(fiber.Map{
+		"error": message,
+	})
+}
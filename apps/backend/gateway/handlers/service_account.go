@@ -0,0 +1,276 @@
+package handlers
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// ServiceAccountHandler handles management of a tenant's service accounts and their
+// API keys by tenant admins
+type ServiceAccountHandler struct {
+	getServiceAccountService func(tenantID string) *services.ServiceAccountService
+}
+
+// NewServiceAccountHandler creates a new service account handler
+func NewServiceAccountHandler(getServiceAccountService func(tenantID string) *services.ServiceAccountService) *ServiceAccountHandler {
+	return &ServiceAccountHandler{
+		getServiceAccountService: getServiceAccountService,
+	}
+}
+
+// apiKeyInput is the request body for issuing and rotating API keys
+type apiKeyInput struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// GetServiceAccounts lists the tenant's service accounts with their keys
+func (h *ServiceAccountHandler) GetServiceAccounts(c *fiber.Ctx) error {
+	accountService, status, body := h.adminServiceAccountService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	accounts, err := accountService.ListServiceAccounts()
+	if err != nil {
+		return c.Status(500).JSON(fiber.Map{
+			"error":   "Failed to retrieve service accounts",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"data": accounts,
+	})
+}
+
+// CreateServiceAccount creates a service account with its first API key. The key is
+// only included in this response.
+func (h *ServiceAccountHandler) CreateServiceAccount(c *fiber.Ctx) error {
+	accountService, status, body := h.adminServiceAccountService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	var input services.CreateServiceAccountInput
+	if err := c.BodyParser(&input); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"message": "Please provide valid JSON data",
+		})
+	}
+
+	// Admins can only delegate permissions they hold themselves
+	userCtx := middleware.GetUserContext(c)
+	for _, permission := range input.Permissions {
+		if !userCtx.HasPermission(permission) {
+			return c.Status(403).JSON(fiber.Map{
+				"error":   "Insufficient permissions",
+				"message": "Cannot grant permission " + permission,
+			})
+		}
+	}
+	if creatorID, err := uuid.Parse(userCtx.ID); err == nil {
+		input.CreatedBy = &creatorID
+	}
+
+	account, key, err := accountService.CreateServiceAccount(input)
+	if err != nil {
+		return serviceAccountError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"data":    account,
+		"api_key": key,
+		"message": "Service account created successfully. Store the API key now, it is not shown again",
+	})
+}
+
+// DeleteServiceAccount revokes the keys of a service account and deletes it
+func (h *ServiceAccountHandler) DeleteServiceAccount(c *fiber.Ctx) error {
+	accountService, status, body := h.adminServiceAccountService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	accountID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid service account ID",
+			"message": "Service account ID must be a valid UUID",
+		})
+	}
+
+	if err := accountService.DeleteServiceAccount(accountID); err != nil {
+		return serviceAccountError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Service account deleted successfully",
+	})
+}
+
+// CreateAPIKey issues another API key for a service account
+func (h *ServiceAccountHandler) CreateAPIKey(c *fiber.Ctx) error {
+	accountService, status, body := h.adminServiceAccountService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	accountID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid service account ID",
+			"message": "Service account ID must be a valid UUID",
+		})
+	}
+
+	var input apiKeyInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"error":   "Invalid request body",
+				"message": "Please provide valid JSON data",
+			})
+		}
+	}
+
+	apiKey, key, err := accountService.CreateKey(accountID, input.ExpiresAt)
+	if err != nil {
+		return serviceAccountError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"data":    apiKey,
+		"api_key": key,
+		"message": "API key created successfully. Store it now, it is not shown again",
+	})
+}
+
+// RotateAPIKey revokes an API key and issues its replacement
+func (h *ServiceAccountHandler) RotateAPIKey(c *fiber.Ctx) error {
+	accountService, status, body := h.adminServiceAccountService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	accountID, keyID, ok := apiKeyParams(c)
+	if !ok {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "Service account and API key IDs must be valid UUIDs",
+		})
+	}
+
+	var input apiKeyInput
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&input); err != nil {
+			return c.Status(400).JSON(fiber.Map{
+				"error":   "Invalid request body",
+				"message": "Please provide valid JSON data",
+			})
+		}
+	}
+
+	apiKey, key, err := accountService.RotateKey(accountID, keyID, input.ExpiresAt)
+	if err != nil {
+		return serviceAccountError(c, err)
+	}
+
+	return c.Status(201).JSON(fiber.Map{
+		"data":    apiKey,
+		"api_key": key,
+		"message": "API key rotated successfully. Store the new key now, it is not shown again",
+	})
+}
+
+// RevokeAPIKey revokes an API key of a service account
+func (h *ServiceAccountHandler) RevokeAPIKey(c *fiber.Ctx) error {
+	accountService, status, body := h.adminServiceAccountService(c)
+	if body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	accountID, keyID, ok := apiKeyParams(c)
+	if !ok {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid ID",
+			"message": "Service account and API key IDs must be valid UUIDs",
+		})
+	}
+
+	if err := accountService.RevokeKey(accountID, keyID); err != nil {
+		return serviceAccountError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "API key revoked successfully",
+	})
+}
+
+// adminServiceAccountService returns the service account service of the current tenant
+// if the user is one of its admins
+func (h *ServiceAccountHandler) adminServiceAccountService(c *fiber.Ctx) (*services.ServiceAccountService, int, fiber.Map) {
+	requestCtx := &types.RequestContext{
+		Tenant: middleware.GetTenantContext(c),
+		User:   middleware.GetUserContext(c),
+	}
+	if requestCtx.Tenant == nil {
+		return nil, 400, fiber.Map{"error": "Tenant context not available"}
+	}
+	if !requestCtx.IsAuthenticated() {
+		return nil, 401, fiber.Map{"error": "Authentication required"}
+	}
+	if requestCtx.ValidateTenantAccess() != nil || !requestCtx.IsTenantAdmin() {
+		return nil, 403, fiber.Map{"error": "Tenant admin access required"}
+	}
+
+	accountService := h.getServiceAccountService(string(requestCtx.Tenant.ID))
+	if accountService == nil {
+		return nil, 500, fiber.Map{"error": "Service account service not available"}
+	}
+	return accountService, 0, nil
+}
+
+// apiKeyParams parses the service account and API key IDs of the route
+func apiKeyParams(c *fiber.Ctx) (uuid.UUID, uuid.UUID, bool) {
+	accountID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	keyID, err := uuid.Parse(c.Params("keyId"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, false
+	}
+	return accountID, keyID, true
+}
+
+// serviceAccountError responds to an error managing service accounts or their keys
+func serviceAccountError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidServiceAccount), errors.Is(err, services.ErrInvalidKeyExpiry):
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid request",
+			"message": err.Error(),
+		})
+	case errors.Is(err, services.ErrAPIKeyInactive):
+		return c.Status(409).JSON(fiber.Map{
+			"error":   "API key inactive",
+			"message": err.Error(),
+		})
+	case err.Error() == "service account not found", err.Error() == "API key not found":
+		return c.Status(404).JSON(fiber.Map{
+			"error":   "Not found",
+			"message": err.Error(),
+		})
+	}
+	return c.Status(500).JSON(fiber.Map{
+		"error":   "Failed to manage service account",
+		"message": err.Error(),
+	})
+}
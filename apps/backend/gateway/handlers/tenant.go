@@ -1,6 +1,8 @@
 package handlers
 
 import (
+	"errors"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
@@ -110,12 +112,18 @@ func (h *TenantHandler) CreateTenant(c *fiber.Ctx) error {
 
 	tenant, err := h.tenantService.CreateTenant(input)
 	if err != nil {
-		if err.Error() == "tenant with slug '"+input.Slug+"' already exists" {
+		if err.Error() == "tenant with slug '"+input.Slug+"' already exists" || errors.Is(err, services.ErrTenantIdentifierTaken) {
 			return c.Status(409).JSON(fiber.Map{
 				"error":   "Tenant already exists",
 				"message": err.Error(),
 			})
 		}
+		if errors.Is(err, services.ErrIsolationUnavailable) {
+			return c.Status(400).JSON(fiber.Map{
+				"error":   "Invalid tenant isolation",
+				"message": err.Error(),
+			})
+		}
 		return c.Status(500).JSON(fiber.Map{
 			"error":   "Failed to create tenant",
 			"message": err.Error(),
@@ -155,6 +163,18 @@ func (h *TenantHandler) UpdateTenant(c *fiber.Ctx) error {
 				"message": "No tenant found with the specified ID",
 			})
 		}
+		if errors.Is(err, services.ErrTenantPendingDeletion) {
+			return c.Status(409).JSON(fiber.Map{
+				"error":   "Tenant pending deletion",
+				"message": "Restore the tenant before changing its status",
+			})
+		}
+		if errors.Is(err, services.ErrTenantIdentifierTaken) {
+			return c.Status(409).JSON(fiber.Map{
+				"error":   "Domain already in use",
+				"message": err.Error(),
+			})
+		}
 		return c.Status(500).JSON(fiber.Map{
 			"error":   "Failed to update tenant",
 			"message": err.Error(),
@@ -167,36 +187,6 @@ func (h *TenantHandler) UpdateTenant(c *fiber.Ctx) error {
 	})
 }
 
-// DeleteTenant deletes a tenant
-func (h *TenantHandler) DeleteTenant(c *fiber.Ctx) error {
-	id := c.Params("id")
-	tenantID, err := uuid.Parse(id)
-	if err != nil {
-		return c.Status(400).JSON(fiber.Map{
-			"error":   "Invalid tenant ID",
-			"message": "Tenant ID must be a valid UUID",
-		})
-	}
-
-	err = h.tenantService.DeleteTenant(tenantID)
-	if err != nil {
-		if err.Error() == "tenant not found" {
-			return c.Status(404).JSON(fiber.Map{
-				"error":   "Tenant not found",
-				"message": "No tenant found with the specified ID",
-			})
-		}
-		return c.Status(500).JSON(fiber.Map{
-			"error":   "Failed to delete tenant",
-			"message": err.Error(),
-		})
-	}
-
-	return c.JSON(fiber.Map{
-		"message": "Tenant deleted successfully",
-	})
-}
-
 // SuspendTenant suspends a tenant
 func (h *TenantHandler) SuspendTenant(c *fiber.Ctx) error {
 	id := c.Params("id")
@@ -216,6 +206,12 @@ func (h *TenantHandler) SuspendTenant(c *fiber.Ctx) error {
 				"message": "No tenant found with the specified ID",
 			})
 		}
+		if errors.Is(err, services.ErrTenantPendingDeletion) {
+			return c.Status(409).JSON(fiber.Map{
+				"error":   "Tenant pending deletion",
+				"message": "Restore the tenant before changing its status",
+			})
+		}
 		return c.Status(500).JSON(fiber.Map{
 			"error":   "Failed to suspend tenant",
 			"message": err.Error(),
@@ -246,6 +242,12 @@ func (h *TenantHandler) ActivateTenant(c *fiber.Ctx) error {
 				"message": "No tenant found with the specified ID",
 			})
 		}
+		if errors.Is(err, services.ErrTenantPendingDeletion) {
+			return c.Status(409).JSON(fiber.Map{
+				"error":   "Tenant pending deletion",
+				"message": "Restore the tenant before changing its status",
+			})
+		}
 		return c.Status(500).JSON(fiber.Map{
 			"error":   "Failed to activate tenant",
 			"message": err.Error(),
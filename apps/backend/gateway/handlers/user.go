@@ -1,6 +1,10 @@
 package handlers
 
 import (
+	"errors"
+	"strings"
+	"time"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
@@ -29,6 +33,10 @@ func (h *UserHandler) GetUsers(c *fiber.Ctx) error {
 		})
 	}
 
+	if status, body := checkResourceAccess(c, "users", "read"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
 	userService := h.getUserService(string(tenantCtx.ID))
 	if userService == nil {
 		return c.Status(500).JSON(fiber.Map{
@@ -81,6 +89,10 @@ func (h *UserHandler) GetUser(c *fiber.Ctx) error {
 		})
 	}
 
+	if status, body := checkResourceAccess(c, "users", "read"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
 	userService := h.getUserService(string(tenantCtx.ID))
 	if userService == nil {
 		return c.Status(500).JSON(fiber.Map{
@@ -126,6 +138,10 @@ func (h *UserHandler) CreateUser(c *fiber.Ctx) error {
 		})
 	}
 
+	if status, body := checkResourceAccess(c, "users", "create"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
 	userService := h.getUserService(string(tenantCtx.ID))
 	if userService == nil {
 		return c.Status(500).JSON(fiber.Map{
@@ -179,6 +195,10 @@ func (h *UserHandler) UpdateUser(c *fiber.Ctx) error {
 		})
 	}
 
+	if status, body := checkResourceAccess(c, "users", "update"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
 	userService := h.getUserService(string(tenantCtx.ID))
 	if userService == nil {
 		return c.Status(500).JSON(fiber.Map{
@@ -233,6 +253,10 @@ func (h *UserHandler) DeleteUser(c *fiber.Ctx) error {
 		})
 	}
 
+	if status, body := checkResourceAccess(c, "users", "delete"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
 	userService := h.getUserService(string(tenantCtx.ID))
 	if userService == nil {
 		return c.Status(500).JSON(fiber.Map{
@@ -337,7 +361,24 @@ func (h *UserHandler) ChangePassword(c *fiber.Ctx) error {
 	})
 }
 
-// AssignRoles assigns roles to a user
+// roleAssignmentInput is the body of role assignment requests. Roles assigned with a
+// window only grant permissions within it.
+type roleAssignmentInput struct {
+	Mode       services.RoleAssignmentMode `json:"mode"`
+	RoleIDs    []uuid.UUID                 `json:"role_ids"`
+	ValidFrom  *time.Time                  `json:"valid_from"`
+	ValidUntil *time.Time                  `json:"valid_until"`
+}
+
+// window returns the assignment window, or nil when the input has no bounds
+func (input *roleAssignmentInput) window() *services.RoleWindow {
+	if input.ValidFrom == nil && input.ValidUntil == nil {
+		return nil
+	}
+	return &services.RoleWindow{ValidFrom: input.ValidFrom, ValidUntil: input.ValidUntil}
+}
+
+// AssignRoles adds, removes or replaces the roles of a user. The mode defaults to replace.
 func (h *UserHandler) AssignRoles(c *fiber.Ctx) error {
 	// Get tenant context
 	tenantCtx := middleware.GetTenantContext(c)
@@ -347,6 +388,10 @@ func (h *UserHandler) AssignRoles(c *fiber.Ctx) error {
 		})
 	}
 
+	if status, body := checkResourceAccess(c, "roles", "manage"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
 	userService := h.getUserService(string(tenantCtx.ID))
 	if userService == nil {
 		return c.Status(500).JSON(fiber.Map{
@@ -363,31 +408,97 @@ func (h *UserHandler) AssignRoles(c *fiber.Ctx) error {
 		})
 	}
 
-	var input struct {
-		RoleIDs []uuid.UUID `json:"role_ids"`
-	}
+	var input roleAssignmentInput
 	if err := c.BodyParser(&input); err != nil {
 		return c.Status(400).JSON(fiber.Map{
 			"error":   "Invalid request body",
 			"message": "Please provide valid JSON data",
 		})
 	}
+	if input.Mode == "" {
+		input.Mode = services.RoleModeReplace
+	}
 
-	err = userService.AssignRoles(userID, input.RoleIDs)
-	if err != nil {
-		if err.Error() == "user not found" {
-			return c.Status(404).JSON(fiber.Map{
-				"error":   "User not found",
-				"message": "No user found with the specified ID",
-			})
-		}
+	if err := userService.AssignRoles(userID, input.Mode, input.RoleIDs, input.window()); err != nil {
+		return roleAssignmentError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"message": "Roles assigned successfully",
+	})
+}
+
+// BulkAssignRoles adds, removes or replaces the roles of several users at once. Either
+// every user is changed or none is.
+func (h *UserHandler) BulkAssignRoles(c *fiber.Ctx) error {
+	// Get tenant context
+	tenantCtx := middleware.GetTenantContext(c)
+	if tenantCtx == nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error": "Tenant context not available",
+		})
+	}
+
+	if status, body := checkResourceAccess(c, "roles", "manage"); body != nil {
+		return c.Status(status).JSON(body)
+	}
+
+	userService := h.getUserService(string(tenantCtx.ID))
+	if userService == nil {
 		return c.Status(500).JSON(fiber.Map{
-			"error":   "Failed to assign roles",
-			"message": err.Error(),
+			"error": "User service not available",
+		})
+	}
+
+	var input struct {
+		roleAssignmentInput
+		UserIDs []uuid.UUID `json:"user_ids"`
+	}
+	if err := c.BodyParser(&input); err != nil {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid request body",
+			"message": "Please provide valid JSON data",
 		})
 	}
+	if len(input.UserIDs) == 0 || input.Mode == "" {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Missing required fields",
+			"message": "user_ids and mode are required",
+		})
+	}
+
+	if err := userService.BulkAssignRoles(input.UserIDs, input.Mode, input.RoleIDs, input.window()); err != nil {
+		return roleAssignmentError(c, err)
+	}
 
 	return c.JSON(fiber.Map{
 		"message": "Roles assigned successfully",
+		"count":   len(input.UserIDs),
+	})
+}
+
+// roleAssignmentError converts a role assignment error into a response
+func roleAssignmentError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, services.ErrInvalidRoleWindow) || errors.Is(err, services.ErrInvalidRoleMode) {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid role assignment",
+			"message": err.Error(),
+		})
+	}
+	if err.Error() == "user not found" {
+		return c.Status(404).JSON(fiber.Map{
+			"error":   "User not found",
+			"message": "No user found with the specified ID",
+		})
+	}
+	if strings.HasPrefix(err.Error(), "some roles were not found") {
+		return c.Status(400).JSON(fiber.Map{
+			"error":   "Invalid roles",
+			"message": err.Error(),
+		})
+	}
+	return c.Status(500).JSON(fiber.Map{
+		"error":   "Failed to assign roles",
+		"message": err.Error(),
 	})
 }
\ No newline at end of file
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/generated"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/resolver"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// memoryAuditLog keeps audit log entries in memory
+type memoryAuditLog struct {
+	entries []*models.AuditLog
+}
+
+func (l *memoryAuditLog) Record(entry *models.AuditLog) error {
+	l.entries = append(l.entries, entry)
+	return nil
+}
+
+func setupImpersonationTestApp(tokenManager *auth.TokenManager, users *middleware.UserResolver, audit middleware.AuditRecorder) *fiber.App {
+	app := fiber.New()
+	app.Use(middleware.AuthMiddleware(tokenManager, users))
+	app.Use(middleware.ImpersonationAuditMiddleware(audit))
+	app.Get("/me", func(c *fiber.Ctx) error {
+		return c.JSON(middleware.GetUserContext(c))
+	})
+	app.Post("/users/:id/password", middleware.DenyImpersonation, func(c *fiber.Ctx) error {
+		return c.JSON(fiber.Map{"message": "Password changed successfully"})
+	})
+	return app
+}
+
+func TestImpersonatedRequests(t *testing.T) {
+	tenantID := uuid.New()
+	user := newAnalystUser(tenantID)
+	operator := &models.SystemUser{ID: uuid.New(), Email: "ops@zplus.io", Name: "Platform Ops", Role: "admin", IsActive: true}
+	lookup := &fakeUserLookup{
+		users:       map[uuid.UUID]*models.TenantUser{user.ID: user},
+		systemUsers: map[uuid.UUID]*models.SystemUser{operator.ID: operator},
+	}
+	audit := &memoryAuditLog{}
+
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := setupImpersonationTestApp(tokenManager, middleware.NewUserResolver(lookup, time.Minute), audit)
+
+	token, _, err := tokenManager.GenerateImpersonationToken(operator.ID.String(), user.ID.String(), tenantID.String(), "analyst", 0)
+	if err != nil {
+		t.Fatalf("Failed to generate impersonation token: %v", err)
+	}
+
+	// The request runs as the impersonated user, flagged with the impersonator
+	status, me := getMe(t, app, token)
+	if status != 200 {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if me.ID != user.ID.String() || me.ImpersonatorID != operator.ID.String() || me.IsAdmin {
+		t.Fatalf("Expected the analyst impersonated by the operator, got %+v", me)
+	}
+	if !(&types.RequestContext{User: me}).IsImpersonated() {
+		t.Fatal("Expected the request context to be flagged as impersonated")
+	}
+	if !me.HasPermission("reports:read") || me.HasPermission("system:manage") {
+		t.Fatalf("Expected only the analyst's permissions, got %v", me.Permissions)
+	}
+
+	// Sensitive actions are refused
+	req, _ := http.NewRequest("POST", "/users/"+user.ID.String()+"/password", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := app.Test(req, 5000)
+	if err != nil || resp.StatusCode != 403 {
+		t.Fatalf("Expected status 403 for a password change, got %v %v", resp.StatusCode, err)
+	}
+
+	// Every request is audited, regular users are not
+	regular, _ := tokenManager.GenerateToken(user.ID.String(), tenantID.String(), "analyst")
+	if status, me := getMe(t, app, regular); status != 200 || me.ImpersonatorID != "" {
+		t.Fatalf("Expected a regular request, got %d %+v", status, me)
+	}
+	if len(audit.entries) != 2 {
+		t.Fatalf("Expected 2 audit entries, got %d", len(audit.entries))
+	}
+	if entry := audit.entries[0]; entry.Action != models.AuditImpersonatedRequest || entry.Path != "/me" || entry.Status != 200 ||
+		*entry.ImpersonatorID != operator.ID || *entry.UserID != user.ID || *entry.TenantID != tenantID {
+		t.Fatalf("Expected the impersonated request to be audited, got %+v", entry)
+	}
+	if entry := audit.entries[1]; entry.Action != models.AuditImpersonationDenied || entry.Status != 403 {
+		t.Fatalf("Expected the denied password change to be audited, got %+v", entry)
+	}
+
+	// Support staff are no system administrators and cannot impersonate
+	support := &models.SystemUser{ID: uuid.New(), Email: "support@zplus.io", Name: "Support", Role: "support", IsActive: true}
+	lookup.systemUsers[support.ID] = support
+	supportToken, _, _ := tokenManager.GenerateImpersonationToken(support.ID.String(), user.ID.String(), tenantID.String(), "analyst", 0)
+	if status, _ := getMe(t, app, supportToken); status != 401 {
+		t.Fatalf("Expected status 401 for an impersonation by support staff, got %d", status)
+	}
+
+	// Tokens stop working once the impersonator is disabled
+	operator.IsActive = false
+	disabled := setupImpersonationTestApp(tokenManager, middleware.NewUserResolver(lookup, time.Minute), audit)
+	if status, _ := getMe(t, disabled, token); status != 401 {
+		t.Fatalf("Expected status 401 for a disabled impersonator, got %d", status)
+	}
+
+	t.Log("✓ Impersonated requests flagged, restricted and audited")
+}
+
+func TestImpersonationDeniedOnUserManagementRoutes(t *testing.T) {
+	tenantID := uuid.New()
+	user := newAnalystUser(tenantID)
+	user.Roles[0].Permissions = append(user.Roles[0].Permissions,
+		models.Permission{ID: uuid.New(), Name: "users:manage", Resource: "users", Action: "manage"},
+		models.Permission{ID: uuid.New(), Name: "roles:manage", Resource: "roles", Action: "manage"})
+	operator := &models.SystemUser{ID: uuid.New(), Email: "ops@zplus.io", Name: "Platform Ops", Role: "admin", IsActive: true}
+	lookup := &fakeUserLookup{
+		users:       map[uuid.UUID]*models.TenantUser{user.ID: user},
+		systemUsers: map[uuid.UUID]*models.SystemUser{operator.ID: operator},
+	}
+	audit := &memoryAuditLog{}
+
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("tenant", &types.TenantContext{ID: types.TenantID(tenantID.String()), Status: "ACTIVE"})
+		return c.Next()
+	})
+	app.Use(middleware.AuthMiddleware(tokenManager, middleware.NewUserResolver(lookup, time.Minute)))
+	app.Use(middleware.ImpersonationAuditMiddleware(audit))
+	setupRESTRoutes(app, nil, resolver.NewResolver(), nil, nil)
+
+	token, _, err := tokenManager.GenerateImpersonationToken(operator.ID.String(), user.ID.String(), tenantID.String(), "tenant_admin", 0)
+	if err != nil {
+		t.Fatalf("Failed to generate impersonation token: %v", err)
+	}
+
+	// The routes of the gateway refuse the request before any handler runs
+	id := uuid.NewString()
+	for _, route := range [][2]string{
+		{"POST", "/api/v1/users"},
+		{"PUT", "/api/v1/users/" + id},
+		{"DELETE", "/api/v1/users/" + id},
+		{"POST", "/api/v1/users/" + id + "/password"},
+		{"POST", "/api/v1/users/" + id + "/roles"},
+		{"POST", "/api/v1/users/roles/bulk"},
+		{"DELETE", "/api/v1/service-accounts/" + id},
+		{"DELETE", "/api/v1/service-accounts/" + id + "/keys/" + id},
+	} {
+		req, _ := http.NewRequest(route[0], route[1], nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req, 5000)
+		if err != nil || resp.StatusCode != 403 {
+			t.Errorf("Expected status 403 for %s %s, got %v %v", route[0], route[1], resp.StatusCode, err)
+		}
+	}
+	if len(audit.entries) != 8 {
+		t.Fatalf("Expected 8 audit entries, got %d", len(audit.entries))
+	}
+	for _, entry := range audit.entries {
+		if entry.Action != models.AuditImpersonationDenied {
+			t.Fatalf("Expected the refused requests to be audited as denied, got %s %s", entry.Action, entry.Path)
+		}
+	}
+
+	t.Log("✓ Impersonated requests refused on user, role and service account management")
+}
+
+func TestImpersonationDeniedOnGraphQLMutations(t *testing.T) {
+	tenants := newFakeTenantLookup()
+	acme := tenants.tenants[0]
+	admin := newAnalystUser(acme.ID)
+	admin.Roles[0].Name = "tenant_admin"
+	admin.Roles[0].Permissions = append(admin.Roles[0].Permissions,
+		models.Permission{ID: uuid.New(), Name: "roles:manage", Resource: "roles", Action: "manage"})
+	operator := &models.SystemUser{ID: uuid.New(), Email: "ops@zplus.io", Name: "Platform Ops", Role: "admin", IsActive: true}
+	lookup := &fakeUserLookup{
+		users:       map[uuid.UUID]*models.TenantUser{admin.ID: admin},
+		systemUsers: map[uuid.UUID]*models.SystemUser{operator.ID: operator},
+	}
+
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	gqlResolver := resolver.NewResolver()
+	gqlResolver.SetTokenManager(tokenManager)
+	gqlServer := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: gqlResolver}))
+
+	app := fiber.New()
+	app.Use(middleware.TenantMiddleware(middleware.NewTenantResolver(tenants, time.Minute)))
+	app.Use(middleware.AuthMiddleware(tokenManager, middleware.NewUserResolver(lookup, time.Minute)))
+	app.Use(middleware.GraphQLContextMiddleware())
+	app.All("/graphql", graphQLHandler(gqlServer))
+
+	token, _, err := tokenManager.GenerateImpersonationToken(operator.ID.String(), admin.ID.String(), acme.ID.String(), "tenant_admin", 0)
+	if err != nil {
+		t.Fatalf("Failed to generate impersonation token: %v", err)
+	}
+
+	id := uuid.NewString()
+	for _, mutation := range []string{
+		`assignRole(userId: "` + id + `", roleId: "` + id + `") { id }`,
+		`removeRole(userId: "` + id + `", roleId: "` + id + `") { id }`,
+		`assignRoles(input: {userIds: ["` + id + `"], roleIds: ["` + id + `"], mode: ADD}) { id }`,
+		`forceLogoutUser(userId: "` + id + `")`,
+		`revokeSession(id: "` + id + `")`,
+		`revokeOtherSessions`,
+		`deleteServiceAccount(id: "` + id + `")`,
+		`revokeAPIKey(serviceAccountId: "` + id + `", keyId: "` + id + `")`,
+	} {
+		body, _ := json.Marshal(map[string]string{"query": "mutation { " + mutation + " }"})
+		req, _ := http.NewRequest("POST", "/graphql", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Tenant-ID", "acme")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+
+		var result graphQLResponse
+		json.NewDecoder(resp.Body).Decode(&result)
+		if len(result.Errors) == 0 || result.Errors[0].Message != resolver.ErrImpersonationDenied.Error() {
+			t.Errorf("Expected %s to be refused while impersonating, got %v", mutation, result.Errors)
+		}
+	}
+
+	t.Log("✓ Impersonated requests refused on role, session and credential mutations")
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/playground"
@@ -15,11 +17,15 @@ import (
 	"github.com/valyala/fasthttp/fasthttpadaptor"
 	"gorm.io/gorm"
 
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/clients"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/generated"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/handlers"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/resolver"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
 	"github.com/ilmsadmin/Zplus-SaaS/pkg/database"
 )
 
@@ -48,10 +54,85 @@ func main() {
 		log.Fatalf("Failed to connect to database: %v", err)
 	}
 
-	app := fiber.New(fiber.Config{
-		ErrorHandler: errorHandler,
+	// Bring the system schema and every tenant schema up to the latest migration
+	if getEnv("DB_AUTO_MIGRATE", "true") == "true" {
+		runMigrations(db)
+	}
+
+	tokenStore, err := initializeTokenStore()
+	if err != nil {
+		log.Fatalf("Failed to initialize token store: %v", err)
+	}
+
+	// Shared event bus so tenant, user and role changes invalidate cached contexts. It is
+	// relayed over redis, so changes made by the auth service or another replica apply here.
+	events := services.NewEventBus()
+	if transport, ok := tokenStore.(services.EventTransport); ok {
+		if _, err := events.Relay(transport, services.EventsChannel); err != nil {
+			log.Printf("⚠️  Failed to relay events over redis: %v", err)
+		}
+	}
+	tenantService := services.NewTenantService(db)
+	tenantService.SetEventBus(events)
+
+	// Finish the tenant onboardings interrupted by a restart
+	onboarding := services.NewOnboardingService(db)
+	onboarding.SetEventBus(events)
+	resumed, err := onboarding.ResumePending()
+	if err != nil {
+		log.Printf("⚠️  Failed to resume tenant onboardings: %v", err)
+	}
+	for _, record := range resumed {
+		log.Printf("🏗️  Resumed onboarding of tenant %s: %s", record.Slug, record.Status)
+	}
+
+	tenantResolver := middleware.NewTenantResolver(tenantService, time.Duration(getEnvInt("TENANT_CACHE_TTL_SECONDS", 60))*time.Second)
+	events.Subscribe(services.EventTenantChanged, func(event services.Event) {
+		tenantResolver.Invalidate(event.TenantID.String())
 	})
 
+	// Users are loaded with their role permissions and cached until they or their roles change
+	userResolver := middleware.NewUserResolver(middleware.NewDatabaseUserLookup(db), time.Duration(getEnvInt("USER_CACHE_TTL_SECONDS", 60))*time.Second)
+	events.Subscribe(services.EventUserChanged, userResolver.HandleEvent)
+	events.Subscribe(services.EventRolesChanged, userResolver.HandleEvent)
+	events.Subscribe(services.EventRoleAssignmentExpired, userResolver.HandleEvent)
+	userResolver.SetAPIKeyLookup(middleware.NewDatabaseAPIKeyLookup(db))
+
+	// Time-bound role assignments are removed once they expire
+	roleSweeper := services.NewRoleAssignmentSweeper(db)
+	roleSweeper.SetEventBus(events)
+	roleSweeper.Start(time.Duration(getEnvInt("ROLE_SWEEP_INTERVAL_SECONDS", 60)) * time.Second)
+
+	// Template updates that did not reach every tenant are pushed again
+	roleTemplates := services.NewRoleTemplateService(db)
+	roleTemplates.SetEventBus(events)
+	roleTemplates.Start(time.Duration(getEnvInt("ROLE_TEMPLATE_PUSH_INTERVAL_SECONDS", 300)) * time.Second)
+
+	// Deleted tenants are exported, kept for a grace period and then purged
+	offboarding := services.NewOffboardingService(db)
+	offboarding.SetEventBus(events)
+	if exportDir := getEnv("TENANT_EXPORT_DIR", ""); exportDir != "" {
+		offboarding.SetExportDir(exportDir)
+	}
+	offboarding.SetGracePeriod(time.Duration(getEnvInt("TENANT_DELETION_GRACE_DAYS", 30)) * 24 * time.Hour)
+	offboarding.Start(time.Duration(getEnvInt("TENANT_PURGE_INTERVAL_SECONDS", 3600)) * time.Second)
+
+	// Tokens are verified with the public keys published by the auth service
+	keySet := auth.NewRemoteKeySet(getEnv("AUTH_JWKS_URL", "http://localhost:8001/.well-known/jwks.json"), 10*time.Minute)
+	tokenManager := auth.NewVerifyingTokenManager(keySet, "zplus-saas")
+	tokenManager.SetTokenLifetimes(
+		time.Duration(getEnvInt("JWT_EXPIRES_IN", 900))*time.Second,
+		time.Duration(getEnvInt("JWT_REFRESH_IN", 604800))*time.Second,
+	)
+
+	if tokenStore != nil {
+		tokenManager.SetStore(tokenStore)
+	}
+
+	app := fiber.New(withTrustedProxies(fiber.Config{
+		ErrorHandler: errorHandler,
+	}))
+
 	// Global middleware
 	app.Use(logger.New(logger.Config{
 		Format: "[${time}] ${status} - ${method} ${path} - ${latency}\n",
@@ -64,8 +145,9 @@ func main() {
 	}))
 
 	// Multi-tenant middleware
-	app.Use(middleware.TenantMiddleware())
-	app.Use(middleware.AuthMiddleware())
+	app.Use(middleware.TenantMiddleware(tenantResolver))
+	app.Use(middleware.AuthMiddleware(tokenManager, userResolver))
+	app.Use(middleware.ImpersonationAuditMiddleware(services.NewAuditService(db)))
 	app.Use(middleware.GraphQLContextMiddleware())
 
 	// Health check endpoint
@@ -95,6 +177,9 @@ func main() {
 	// Create GraphQL server with database integration
 	gqlResolver := resolver.NewResolver()
 	gqlResolver.SetDatabase(db)
+	gqlResolver.SetEventBus(events)
+	gqlResolver.SetTokenManager(tokenManager)
+	gqlResolver.SetAuthClient(clients.NewAuthClient(getEnv("AUTH_SERVICE_URL", "http://localhost:8001")))
 
 	gqlServer := handler.NewDefaultServer(
 		generated.NewExecutableSchema(generated.Config{
@@ -103,22 +188,7 @@ func main() {
 	)
 
 	// GraphQL endpoint with context injection
-	app.All("/graphql", func(c *fiber.Ctx) error {
-		// Get request context from middleware
-		requestCtx := middleware.GetRequestContext(c)
-
-		// Create GraphQL context with request context
-		ctx := context.WithValue(c.Context(), "request_context", requestCtx)
-
-		// Adapt Fiber to net/http for GraphQL handler
-		fasthttpadaptor.NewFastHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			// Set the context with request information
-			r = r.WithContext(ctx)
-			gqlServer.ServeHTTP(w, r)
-		})(c.Context())
-
-		return nil
-	})
+	app.All("/graphql", graphQLHandler(gqlServer))
 
 	// GraphQL Playground for development
 	app.Get("/playground", func(c *fiber.Ctx) error {
@@ -129,7 +199,7 @@ func main() {
 	})
 
 	// REST API endpoints for backward compatibility
-	setupRESTRoutes(app, db, gqlResolver)
+	setupRESTRoutes(app, db, gqlResolver, tenantService, offboarding)
 
 	// Get port from environment variable
 	port := getEnv("GATEWAY_PORT", "8000")
@@ -141,6 +211,41 @@ func main() {
 	log.Fatal(app.Listen(":" + port))
 }
 
+// withTrustedProxies makes c.IP() the client address forwarded in PROXY_HEADER by the
+// proxies listed in TRUSTED_PROXIES (IPs or CIDR ranges). Clients reaching the gateway
+// directly cannot set it, so API key allow-lists check the real client address.
+func withTrustedProxies(config fiber.Config) fiber.Config {
+	config.ProxyHeader = getEnv("PROXY_HEADER", "X-Real-Ip")
+	config.EnableTrustedProxyCheck = true
+	config.EnableIPValidation = true
+	for _, proxy := range strings.Split(getEnv("TRUSTED_PROXIES", ""), ",") {
+		if proxy = strings.TrimSpace(proxy); proxy != "" {
+			config.TrustedProxies = append(config.TrustedProxies, proxy)
+		}
+	}
+	return config
+}
+
+// graphQLHandler serves GraphQL requests with the tenant and user of the request
+func graphQLHandler(gqlServer http.Handler) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		// Get request context from middleware
+		requestCtx := middleware.GetRequestContext(c)
+
+		// Create GraphQL context with request context
+		ctx := context.WithValue(c.Context(), "request_context", requestCtx)
+
+		// Adapt Fiber to net/http for GraphQL handler
+		fasthttpadaptor.NewFastHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// Set the context with request information
+			r = r.WithContext(ctx)
+			gqlServer.ServeHTTP(w, r)
+		})(c.Context())
+
+		return nil
+	}
+}
+
 // initializeDatabase sets up the database connection
 func initializeDatabase() (*gorm.DB, error) {
 	// Get database configuration from environment variables
@@ -158,12 +263,45 @@ func initializeDatabase() (*gorm.DB, error) {
 		return nil, err
 	}
 
+	// Tenants are isolated in shared tables, a schema or a database of their own
+	router := tenancy.NewRouter(tenancy.NewDatabasePerTenant(database.Connect))
+	if err := db.Use(router); err != nil {
+		return nil, err
+	}
+
 	log.Printf("🗄️  Database connected successfully")
 	return db, nil
 }
 
+// runMigrations applies the pending system migrations and rolls tenant schemas forward.
+// A tenant that fails to migrate is logged and does not stop the gateway.
+func runMigrations(db *gorm.DB) {
+	migrationService := services.NewSchemaMigrationService(db)
+	applied, err := migrationService.MigrateSystem()
+	if err != nil {
+		log.Fatalf("Failed to migrate system schema: %v", err)
+	}
+	for _, migration := range applied {
+		log.Printf("🗄️  Applied system migration %03d_%s", migration.Version, migration.Name)
+	}
+
+	results, err := migrationService.MigrateAllTenants()
+	if err != nil {
+		log.Fatalf("Failed to migrate tenant schemas: %v", err)
+	}
+	for _, result := range results {
+		if result.Error != "" {
+			log.Printf("⚠️  Failed to migrate tenant %s: %s", result.Slug, result.Error)
+			continue
+		}
+		for _, migration := range result.Applied {
+			log.Printf("🗄️  Applied migration %03d_%s to tenant %s", migration.Version, migration.Name, result.Slug)
+		}
+	}
+}
+
 // setupRESTRoutes configures REST API endpoints for backward compatibility
-func setupRESTRoutes(app *fiber.App, db *gorm.DB, gqlResolver *resolver.Resolver) {
+func setupRESTRoutes(app *fiber.App, db *gorm.DB, gqlResolver *resolver.Resolver, tenantService *services.TenantService, offboarding *services.OffboardingService) {
 	api := app.Group("/api/v1")
 
 	// Health check
@@ -196,15 +334,41 @@ func setupRESTRoutes(app *fiber.App, db *gorm.DB, gqlResolver *resolver.Resolver
 	})
 	users.Get("/", userHandler.GetUsers)
 	users.Get("/:id", userHandler.GetUser)
-	users.Post("/", userHandler.CreateUser)
-	users.Put("/:id", userHandler.UpdateUser)
-	users.Delete("/:id", userHandler.DeleteUser)
-	users.Post("/:id/password", userHandler.ChangePassword)
-	users.Post("/:id/roles", userHandler.AssignRoles)
+	users.Post("/", middleware.DenyImpersonation, userHandler.CreateUser)
+	users.Put("/:id", middleware.DenyImpersonation, userHandler.UpdateUser)
+	users.Delete("/:id", middleware.DenyImpersonation, userHandler.DeleteUser)
+	users.Post("/:id/password", middleware.DenyImpersonation, userHandler.ChangePassword)
+	users.Post("/roles/bulk", middleware.DenyImpersonation, userHandler.BulkAssignRoles)
+	users.Post("/:id/roles", middleware.DenyImpersonation, userHandler.AssignRoles)
+
+	// Customer and employee endpoints, restricted by the tenant's access policies
+	customerHandler := handlers.NewCustomerHandler(gqlResolver.GetCustomerService)
+	api.Get("/customers", customerHandler.GetCustomers)
+	api.Get("/customers/:id", customerHandler.GetCustomer)
+	employeeHandler := handlers.NewEmployeeHandler(gqlResolver.GetEmployeeService)
+	api.Get("/employees", employeeHandler.GetEmployees)
+	api.Get("/employees/:id", employeeHandler.GetEmployee)
+
+	// Access policy endpoints (tenant admin only)
+	policies := api.Group("/policies")
+	policyHandler := handlers.NewPolicyHandler(gqlResolver.GetPolicyService)
+	policies.Get("/", policyHandler.GetPolicies)
+	policies.Post("/", policyHandler.CreatePolicy)
+	policies.Delete("/:id", policyHandler.DeletePolicy)
+
+	// Service account and API key endpoints (tenant admin only)
+	serviceAccounts := api.Group("/service-accounts")
+	serviceAccountHandler := handlers.NewServiceAccountHandler(gqlResolver.GetServiceAccountService)
+	serviceAccounts.Get("/", serviceAccountHandler.GetServiceAccounts)
+	serviceAccounts.Post("/", middleware.DenyImpersonation, serviceAccountHandler.CreateServiceAccount)
+	serviceAccounts.Delete("/:id", middleware.DenyImpersonation, serviceAccountHandler.DeleteServiceAccount)
+	serviceAccounts.Post("/:id/keys", middleware.DenyImpersonation, serviceAccountHandler.CreateAPIKey)
+	serviceAccounts.Post("/:id/keys/:keyId/rotate", middleware.DenyImpersonation, serviceAccountHandler.RotateAPIKey)
+	serviceAccounts.Delete("/:id/keys/:keyId", middleware.DenyImpersonation, serviceAccountHandler.RevokeAPIKey)
 
 	// Tenant endpoints (system admin only)
 	tenants := api.Group("/tenants")
-	tenantHandler := handlers.NewTenantHandler(services.NewTenantService(db))
+	tenantHandler := handlers.NewTenantHandler(tenantService)
 	tenants.Get("/current", func(c *fiber.Ctx) error {
 		tenantCtx := middleware.GetTenantContext(c)
 		if tenantCtx == nil {
@@ -226,10 +390,23 @@ func setupRESTRoutes(app *fiber.App, db *gorm.DB, gqlResolver *resolver.Resolver
 	tenants.Get("/:id", tenantHandler.GetTenant)
 	tenants.Post("/", tenantHandler.CreateTenant)
 	tenants.Put("/:id", tenantHandler.UpdateTenant)
-	tenants.Delete("/:id", tenantHandler.DeleteTenant)
 	tenants.Post("/:id/suspend", tenantHandler.SuspendTenant)
 	tenants.Post("/:id/activate", tenantHandler.ActivateTenant)
 
+	// Tenant deletion endpoints (system admin only)
+	offboardingHandler := handlers.NewOffboardingHandler(offboarding)
+	tenants.Delete("/:id", offboardingHandler.DeleteTenant)
+	tenants.Get("/:id/deletion", offboardingHandler.GetDeletion)
+	tenants.Post("/:id/restore", offboardingHandler.RestoreTenant)
+	tenants.Get("/:id/export", offboardingHandler.DownloadExport)
+	tenants.Post("/:id/export", offboardingHandler.ExportTenant)
+	tenants.Post("/:id/purge", offboardingHandler.PurgeTenant)
+
+	// Schema migration endpoints (system admin only)
+	migrationHandler := handlers.NewMigrationHandler(services.NewSchemaMigrationService(db))
+	api.Get("/migrations", migrationHandler.GetMigrationStatus)
+	api.Post("/migrations", migrationHandler.RunMigrations)
+
 	// Plan endpoints (system admin only)
 	plans := api.Group("/plans")
 	planHandler := handlers.NewPlanHandler(services.NewPlanService(db))
@@ -265,3 +442,31 @@ func errorHandler(c *fiber.Ctx, err error) error {
 		"code":  code,
 	})
 }
+
+// initializeTokenStore connects to the Redis server shared by all services so token
+// revocation and sessions are visible everywhere. Without REDIS_HOST tokens are
+// tracked in process memory only.
+func initializeTokenStore() (auth.Store, error) {
+	redisConfig := shared.RedisConfig{
+		Host:     getEnv("REDIS_HOST", ""),
+		Port:     getEnvInt("REDIS_PORT", 6379),
+		Password: getEnv("REDIS_PASSWORD", ""),
+		DB:       getEnvInt("REDIS_DB", 0),
+	}
+	if redisConfig.Host == "" {
+		return nil, nil
+	}
+
+	store, err := auth.NewRedisStore(auth.RedisOptions{
+		Addr:      redisConfig.Addr(),
+		Password:  redisConfig.Password,
+		DB:        redisConfig.DB,
+		KeyPrefix: "zplus:auth:",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Printf("Token store connected to redis at %s", redisConfig.Addr())
+	return store, nil
+}
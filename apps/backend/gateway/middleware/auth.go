@@ -21,25 +21,29 @@ const (
 	RequestContextKey ContextKey = "request"
 )
 
-// Global token manager instance (in production, this should be configured properly)
-var tokenManager = auth.NewTokenManager("your-secret-key", "zplus-saas")
-
 // TenantMiddleware extracts tenant information from request and validates it
-func TenantMiddleware() fiber.Handler {
+func TenantMiddleware(resolver *TenantResolver) fiber.Handler {
 	return func(c *fiber.Ctx) error {
-		// Extract tenant slug from subdomain or X-Tenant-ID header
-		tenantSlug := extractTenantSlug(c)
+		// Extract tenant identifiers from X-Tenant-ID header, custom domain or subdomain
+		identifiers := extractTenantIdentifiers(c)
+		
+		// Platform administration is not done within a tenant, the tenant is optional
+		if isPlatformPath(c.Path()) {
+			if tenantCtx, err := validateAndGetTenant(resolver, identifiers); err == nil && tenantCtx != nil {
+				c.Locals("tenant", tenantCtx)
+			}
+			return c.Next()
+		}
 		
-		if tenantSlug == "" {
+		if len(identifiers) == 0 {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"error": "Tenant identification required",
 				"code":  "TENANT_REQUIRED",
 			})
 		}
 		
-		// Validate and get tenant context
-		// In a real application, this would query the database
-		tenantCtx, err := validateAndGetTenant(tenantSlug)
+		// Validate and get tenant context from the database (cached)
+		tenantCtx, err := validateAndGetTenant(resolver, identifiers)
 		if err != nil {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"error": err.Error(),
@@ -54,17 +58,39 @@ func TenantMiddleware() fiber.Handler {
 	}
 }
 
-// AuthMiddleware validates JWT tokens and extracts user information
-func AuthMiddleware() fiber.Handler {
+// AuthMiddleware validates JWT tokens with the given token manager and resolves the
+// token's user, with the permissions of their roles, through the user resolver.
+// Service accounts may send an API key instead of a JWT.
+func AuthMiddleware(tokenManager *auth.TokenManager, users *UserResolver) fiber.Handler {
 	return func(c *fiber.Ctx) error {
 		// Skip auth for certain endpoints
 		if shouldSkipAuth(c.Path()) {
 			return c.Next()
 		}
 		
+		// Service accounts authenticate with an API key instead of a JWT
+		if apiKey := extractAPIKey(c); apiKey != "" {
+			userCtx, err := users.ResolveAPIKey(GetTenantContext(c), apiKey, c.IP())
+			if err != nil {
+				return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+					"error": err.Error(),
+					"code":  "INVALID_API_KEY",
+				})
+			}
+			
+			c.Locals("user", userCtx)
+			
+			return c.Next()
+		}
+		
 		// Extract token from Authorization header
 		authHeader := c.Get("Authorization")
 		if authHeader == "" {
+			// Login and password resets are GraphQL operations too, so anonymous GraphQL
+			// requests go through without a user and the resolvers require one where needed
+			if c.Path() == graphQLPath {
+				return c.Next()
+			}
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"error": "Authorization header required",
 				"code":  "AUTH_REQUIRED",
@@ -82,9 +108,8 @@ func AuthMiddleware() fiber.Handler {
 		
 		token := parts[1]
 		
-		// Validate JWT token and extract user context
-		// In a real application, this would validate JWT and query user data
-		userCtx, err := validateJWTAndGetUser(token)
+		// Validate JWT token and load user context
+		userCtx, err := validateJWTAndGetUser(tokenManager, users, token)
 		if err != nil {
 			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 				"error": err.Error(),
@@ -92,9 +117,11 @@ func AuthMiddleware() fiber.Handler {
 			})
 		}
 		
-		// Ensure user belongs to the current tenant
+		// Ensure user belongs to the current tenant. System users administer the platform
+		// from any host, they only leave their tenant on platform administration routes.
 		tenantCtx, ok := c.Locals("tenant").(*types.TenantContext)
-		if ok && tenantCtx != nil {
+		platformAdmin := userCtx.TenantID == systemTenantID && isPlatformPath(c.Path())
+		if ok && tenantCtx != nil && !platformAdmin {
 			if userCtx.TenantID != tenantCtx.ID {
 				return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
 					"error": "User does not belong to the current tenant",
@@ -138,35 +165,43 @@ func GraphQLContextMiddleware() fiber.Handler {
 	}
 }
 
-// extractTenantSlug extracts tenant identifier from request
-func extractTenantSlug(c *fiber.Ctx) string {
+// extractTenantIdentifiers extracts candidate tenant identifiers from request, most specific first
+func extractTenantIdentifiers(c *fiber.Ctx) []string {
 	// First try X-Tenant-ID header (set by Traefik middleware)
 	if tenantID := c.Get("X-Tenant-ID"); tenantID != "" {
-		return tenantID
+		return []string{tenantID}
 	}
 	
 	// Fallback to extracting from Host header
-	host := c.Get("Host")
+	host := strings.ToLower(c.Get("Host"))
+	if idx := strings.LastIndex(host, ":"); idx != -1 {
+		host = host[:idx]
+	}
 	if host == "" {
-		return ""
+		return nil
 	}
 	
+	// Full host may be a tenant's custom domain (e.g., "crm.acme.com")
+	identifiers := []string{host}
+	
 	// Extract subdomain from host (e.g., "tenant.zplus.com" -> "tenant")
 	parts := strings.Split(host, ".")
 	if len(parts) >= 3 {
-		return parts[0]
+		identifiers = append(identifiers, parts[0])
 	}
 	
-	return ""
+	return identifiers
 }
 
+// graphQLPath is the GraphQL endpoint, which serves anonymous and authenticated operations
+const graphQLPath = "/graphql"
+
 // shouldSkipAuth determines if authentication should be skipped for certain endpoints
 func shouldSkipAuth(path string) bool {
 	skipPaths := []string{
 		"/",
 		"/health",
 		"/metrics",
-		"/graphql", // We'll handle auth in GraphQL resolvers
 	}
 	
 	for _, skipPath := range skipPaths {
@@ -178,62 +213,58 @@ func shouldSkipAuth(path string) bool {
 	return false
 }
 
-// validateAndGetTenant validates tenant and returns tenant context
-// In a real application, this would query the database
-func validateAndGetTenant(slug string) (*types.TenantContext, error) {
-	tenantID := types.TenantID(slug)
-	if err := tenantID.Validate(); err != nil {
-		return nil, fmt.Errorf("invalid tenant slug: %v", err)
-	}
-	
-	// Mock tenant validation - replace with actual database query
-	mockTenantData := map[string]*types.TenantContext{
-		"demo": {
-			ID:     "demo",
-			Slug:   "demo",
-			Name:   "Demo Tenant",
-			Schema: "tenant_demo",
-			Status: "ACTIVE",
-			PlanID: "startup",
-			Features: []string{
-				"CRM",
-				"HRM",
-				"POS",
-				"BASIC_ANALYTICS",
-			},
-		},
-		"acme": {
-			ID:     "acme",
-			Slug:   "acme",
-			Name:   "ACME Corporation",
-			Schema: "tenant_acme",
-			Status: "ACTIVE",
-			PlanID: "enterprise",
-			Features: []string{
-				"CRM",
-				"HRM",
-				"POS",
-				"LMS",
-				"ADVANCED_ANALYTICS",
-				"API_ACCESS",
-			},
-		},
+// platformPaths are the routes administering the platform rather than a tenant, for
+// system admins
+var platformPaths = []string{
+	"/api/v1/tenants",
+	"/api/v1/migrations",
+	"/api/v1/plans",
+}
+
+// isPlatformPath determines if a path administers the platform. The current tenant of
+// /api/v1/tenants/current belongs to the tenant.
+func isPlatformPath(path string) bool {
+	if path == "/api/v1/tenants/current" {
+		return false
 	}
-	
-	tenant, exists := mockTenantData[slug]
-	if !exists {
-		return nil, fmt.Errorf("tenant not found: %s", slug)
+	for _, prefix := range platformPaths {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
 	}
-	
-	if !tenant.IsActive() {
-		return nil, fmt.Errorf("tenant is not active: %s", slug)
+	return false
+}
+
+// validateAndGetTenant resolves the first matching identifier and ensures the tenant is active
+func validateAndGetTenant(resolver *TenantResolver, identifiers []string) (*types.TenantContext, error) {
+	var lastErr error
+	for _, identifier := range identifiers {
+		// Identifiers without a dot are slugs or subdomains and must be well-formed
+		if !strings.Contains(identifier, ".") {
+			if err := types.TenantID(identifier).Validate(); err != nil {
+				lastErr = fmt.Errorf("invalid tenant slug: %v", err)
+				continue
+			}
+		}
+		
+		tenant, err := resolver.Resolve(identifier)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		
+		if !tenant.IsActive() {
+			return nil, fmt.Errorf("tenant is not active: %s", tenant.Slug)
+		}
+		
+		return tenant, nil
 	}
 	
-	return tenant, nil
+	return nil, lastErr
 }
 
-// validateJWTAndGetUser validates JWT token and returns user context
-func validateJWTAndGetUser(token string) (*types.UserContext, error) {
+// validateJWTAndGetUser validates JWT token and returns the context of the user it was issued to
+func validateJWTAndGetUser(tokenManager *auth.TokenManager, users *UserResolver, token string) (*types.UserContext, error) {
 	if token == "" {
 		return nil, fmt.Errorf("empty token")
 	}
@@ -244,62 +275,8 @@ func validateJWTAndGetUser(token string) (*types.UserContext, error) {
 		return nil, fmt.Errorf("invalid token: %v", err)
 	}
 	
-	// Create user context from JWT claims
-	// In a full implementation, you might query a user service for additional details
-	userCtx := &types.UserContext{
-		ID:       claims.UserID,
-		TenantID: types.TenantID(claims.TenantID),
-		Roles:    []string{claims.Role},
-	}
-	
-	// Add mock permissions based on role for demonstration
-	// In production, this would come from a user service or be cached in JWT
-	switch claims.Role {
-	case "system_admin":
-		userCtx.Email = "system@zplus.com"
-		userCtx.FirstName = "System"
-		userCtx.LastName = "Admin"
-		userCtx.IsAdmin = true
-		userCtx.Permissions = []string{
-			"system:manage",
-			"tenants:read",
-			"tenants:write",
-			"users:read",
-			"users:write",
-		}
-	case "tenant_admin":
-		userCtx.Email = "admin@" + claims.TenantID + ".zplus.com"
-		userCtx.FirstName = "Tenant"
-		userCtx.LastName = "Admin"
-		userCtx.IsAdmin = false
-		userCtx.Permissions = []string{
-			"users:read",
-			"users:write",
-			"customers:read",
-			"customers:write",
-			"employees:read",
-			"employees:write",
-			"products:read",
-			"products:write",
-		}
-	case "user":
-		userCtx.Email = "user@" + claims.TenantID + ".zplus.com"
-		userCtx.FirstName = "User"
-		userCtx.LastName = "User"
-		userCtx.IsAdmin = false
-		userCtx.Permissions = []string{
-			"customers:read",
-			"products:read",
-		}
-	default:
-		userCtx.Email = "unknown@" + claims.TenantID + ".zplus.com"
-		userCtx.FirstName = "Unknown"
-		userCtx.LastName = "User"
-		userCtx.IsAdmin = false
-		userCtx.Permissions = []string{}
-	}
-	
-	return userCtx, nil
+	// Load the user record with its role permissions (cached)
+	return users.Resolve(claims)
 }
 
 // GetRequestContext extracts request context from fiber context
@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// TenantLookup loads tenants and their enabled modules from storage.
+// It is satisfied by *services.TenantService.
+type TenantLookup interface {
+	ResolveTenant(identifier string) (*models.Tenant, error)
+	GetEnabledModules(tenantID uuid.UUID) ([]string, error)
+}
+
+// tenantCacheEntry is a cached tenant context with its expiry time
+type tenantCacheEntry struct {
+	tenant    *types.TenantContext
+	expiresAt time.Time
+}
+
+// TenantResolver resolves tenant contexts from the database and caches them for a limited time
+type TenantResolver struct {
+	lookup  TenantLookup
+	ttl     time.Duration
+	entries map[string]*tenantCacheEntry // key: normalized identifier
+	mutex   sync.RWMutex
+}
+
+// NewTenantResolver creates a new tenant resolver with the given cache TTL
+func NewTenantResolver(lookup TenantLookup, ttl time.Duration) *TenantResolver {
+	return &TenantResolver{
+		lookup:  lookup,
+		ttl:     ttl,
+		entries: make(map[string]*tenantCacheEntry),
+	}
+}
+
+// Resolve returns the tenant context for a slug, custom domain or subdomain
+func (r *TenantResolver) Resolve(identifier string) (*types.TenantContext, error) {
+	key := strings.ToLower(strings.TrimSpace(identifier))
+	if key == "" {
+		return nil, fmt.Errorf("tenant identifier cannot be empty")
+	}
+
+	r.mutex.RLock()
+	entry, exists := r.entries[key]
+	r.mutex.RUnlock()
+	if exists && time.Now().Before(entry.expiresAt) {
+		return entry.tenant, nil
+	}
+
+	tenant, err := r.lookup.ResolveTenant(key)
+	if err != nil {
+		return nil, fmt.Errorf("tenant not found: %s", identifier)
+	}
+
+	modules, err := r.lookup.GetEnabledModules(tenant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantCtx := buildTenantContext(tenant, modules)
+
+	r.mutex.Lock()
+	r.entries[key] = &tenantCacheEntry{
+		tenant:    tenantCtx,
+		expiresAt: time.Now().Add(r.ttl),
+	}
+	r.mutex.Unlock()
+
+	return tenantCtx, nil
+}
+
+// Invalidate removes every cached entry that belongs to the given tenant
+func (r *TenantResolver) Invalidate(tenantID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for key, entry := range r.entries {
+		if string(entry.tenant.ID) == tenantID {
+			delete(r.entries, key)
+		}
+	}
+}
+
+// InvalidateAll clears the whole cache
+func (r *TenantResolver) InvalidateAll() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.entries = make(map[string]*tenantCacheEntry)
+}
+
+// buildTenantContext converts a tenant row into a request tenant context
+func buildTenantContext(tenant *models.Tenant, modules []string) *types.TenantContext {
+	isolation, err := tenancy.ParseIsolation(tenant.Isolation)
+	if err != nil {
+		isolation = tenancy.IsolationSchema
+	}
+	tenantCtx := &types.TenantContext{
+		ID:        types.TenantID(tenant.ID.String()),
+		Slug:      tenant.Slug,
+		Name:      tenant.Name,
+		Schema:    tenancy.SchemaOf(isolation, tenant.ID),
+		Isolation: string(isolation),
+		Status:    strings.ToUpper(tenant.Status),
+	}
+	if tenant.PlanID != nil {
+		tenantCtx.PlanID = tenant.PlanID.String()
+	}
+
+	features := make(map[string]bool)
+	for _, module := range modules {
+		features[strings.ToUpper(module)] = true
+	}
+	if tenant.Plan != nil {
+		for _, feature := range planFeatures(tenant.Plan.Features) {
+			features[feature] = true
+		}
+	}
+
+	tenantCtx.Features = make([]string, 0, len(features))
+	for feature := range features {
+		tenantCtx.Features = append(tenantCtx.Features, feature)
+	}
+	sort.Strings(tenantCtx.Features)
+
+	return tenantCtx
+}
+
+// planFeatures extracts enabled feature names from a plan's Features JSON.
+// Keys with a truthy value are features; string lists (e.g. "modules") contribute each entry.
+func planFeatures(planFeatures map[string]interface{}) []string {
+	var features []string
+	for name, value := range planFeatures {
+		switch v := value.(type) {
+		case bool:
+			if v {
+				features = append(features, strings.ToUpper(name))
+			}
+		case float64:
+			if v != 0 {
+				features = append(features, strings.ToUpper(name))
+			}
+		case string:
+			if v != "" && v != "false" {
+				features = append(features, strings.ToUpper(name))
+			}
+		case []interface{}:
+			for _, item := range v {
+				if s, ok := item.(string); ok && s != "" {
+					features = append(features, strings.ToUpper(s))
+				}
+			}
+		}
+	}
+	return features
+}
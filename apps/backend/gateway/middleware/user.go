@@ -0,0 +1,284 @@
+package middleware
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// systemTenantID is the tenant ID in tokens of system users
+const systemTenantID = "system"
+
+// systemPermissions are granted to active system administrators
+var systemPermissions = []string{
+	"system:manage",
+	"tenants:read",
+	"tenants:write",
+	"users:read",
+	"users:write",
+}
+
+// supportPermissions are granted to the other system users, such as support staff
+var supportPermissions = []string{
+	"tenants:read",
+	"users:read",
+}
+
+// UserLookup loads users with their roles and role permissions from storage
+type UserLookup interface {
+	GetTenantUser(tenantID, userID uuid.UUID) (*models.TenantUser, error)
+	GetSystemUser(userID uuid.UUID) (*models.SystemUser, error)
+	GetUserAttributes(tenantID uuid.UUID, email string) (map[string]string, error)
+}
+
+// databaseUserLookup loads users through the shared services
+type databaseUserLookup struct {
+	db *gorm.DB
+}
+
+// NewDatabaseUserLookup creates a user lookup reading the users tables
+func NewDatabaseUserLookup(db *gorm.DB) UserLookup {
+	return &databaseUserLookup{db: db}
+}
+
+// GetTenantUser returns a tenant user with roles and permissions preloaded
+func (l *databaseUserLookup) GetTenantUser(tenantID, userID uuid.UUID) (*models.TenantUser, error) {
+	return services.NewUserService(l.db, tenantID).GetUser(userID)
+}
+
+// GetSystemUser returns a system user
+func (l *databaseUserLookup) GetSystemUser(userID uuid.UUID) (*models.SystemUser, error) {
+	var systemUser models.SystemUser
+	if err := l.db.First(&systemUser, userID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("user not found")
+		}
+		return nil, fmt.Errorf("failed to get system user: %v", err)
+	}
+	return &systemUser, nil
+}
+
+// GetUserAttributes returns the attributes access policies compare records against
+func (l *databaseUserLookup) GetUserAttributes(tenantID uuid.UUID, email string) (map[string]string, error) {
+	return services.NewPolicyService(l.db, tenantID).SubjectAttributes(email)
+}
+
+// userCacheEntry is a cached user context with its expiry time
+type userCacheEntry struct {
+	user      *types.UserContext
+	expiresAt time.Time
+}
+
+// UserResolver builds user contexts from the user records and their role permissions,
+// caching them per user until they expire or are invalidated
+type UserResolver struct {
+	lookup  UserLookup
+	apiKeys APIKeyLookup
+	ttl     time.Duration
+	entries map[string]*userCacheEntry // key: tenant ID and user ID
+	mutex   sync.RWMutex
+}
+
+// NewUserResolver creates a new user resolver with the given cache TTL
+func NewUserResolver(lookup UserLookup, ttl time.Duration) *UserResolver {
+	return &UserResolver{
+		lookup:  lookup,
+		ttl:     ttl,
+		entries: make(map[string]*userCacheEntry),
+	}
+}
+
+// Resolve returns the context of the user a token was issued to. Users that no
+// longer exist or are not active are rejected, as are impersonation tokens of system
+// users that were disabled or are no longer administrators.
+func (r *UserResolver) Resolve(claims *auth.Claims) (*types.UserContext, error) {
+	userCtx, err := r.cached(claims.TenantID, claims.UserID)
+	if err != nil {
+		return nil, err
+	}
+	userCtx = withToken(userCtx, claims.TokenID)
+
+	if claims.IsImpersonation() {
+		if claims.TenantID == systemTenantID {
+			return nil, fmt.Errorf("system users cannot be impersonated")
+		}
+		impersonator, err := r.cached(systemTenantID, claims.ImpersonatorID)
+		if err != nil {
+			return nil, fmt.Errorf("impersonator: %v", err)
+		}
+		if !impersonator.IsAdmin {
+			return nil, fmt.Errorf("impersonator is not a system administrator")
+		}
+		userCtx.ImpersonatorID = impersonator.ID
+	}
+
+	return userCtx, nil
+}
+
+// cached returns a user context from the cache, loading it when missing or expired
+func (r *UserResolver) cached(tenantID, userID string) (*types.UserContext, error) {
+	key := userCacheKey(tenantID, userID)
+
+	r.mutex.RLock()
+	entry, exists := r.entries[key]
+	r.mutex.RUnlock()
+	if exists && time.Now().Before(entry.expiresAt) {
+		return entry.user, nil
+	}
+
+	userCtx, rolesChangeAt, err := r.load(tenantID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// A time-bound role that starts or ends before the TTL elapses reloads the user then
+	expiresAt := time.Now().Add(r.ttl)
+	if rolesChangeAt != nil && rolesChangeAt.Before(expiresAt) {
+		expiresAt = *rolesChangeAt
+	}
+
+	r.mutex.Lock()
+	r.entries[key] = &userCacheEntry{
+		user:      userCtx,
+		expiresAt: expiresAt,
+	}
+	r.mutex.Unlock()
+
+	return userCtx, nil
+}
+
+// Invalidate removes a cached user, or every cached user of the tenant when userID is empty
+func (r *UserResolver) Invalidate(tenantID, userID string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if userID != "" {
+		delete(r.entries, userCacheKey(tenantID, userID))
+		return
+	}
+
+	prefix := userCacheKey(tenantID, "")
+	for key := range r.entries {
+		if strings.HasPrefix(key, prefix) {
+			delete(r.entries, key)
+		}
+	}
+}
+
+// HandleEvent invalidates the users affected by a user or role change
+func (r *UserResolver) HandleEvent(event services.Event) {
+	userID := ""
+	if event.UserID != uuid.Nil {
+		userID = event.UserID.String()
+	}
+	r.Invalidate(event.TenantID.String(), userID)
+}
+
+// load reads a user from storage and converts it into a user context. It also returns
+// when the user's roles change next, if a time-bound role assignment is pending.
+func (r *UserResolver) load(tenantID, userID string) (*types.UserContext, *time.Time, error) {
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("user not found")
+	}
+
+	if tenantID == systemTenantID {
+		systemUser, err := r.lookup.GetSystemUser(id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !systemUser.IsActive {
+			return nil, nil, fmt.Errorf("user is not active")
+		}
+		return buildSystemUserContext(systemUser), nil, nil
+	}
+
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("user not found")
+	}
+	tenantUser, err := r.lookup.GetTenantUser(tenantUUID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if tenantUser.Status != "active" {
+		return nil, nil, fmt.Errorf("user is not active")
+	}
+	attributes, err := r.lookup.GetUserAttributes(tenantUUID, tenantUser.Email)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userCtx := buildUserContext(tenantUser)
+	userCtx.Attributes = attributes
+	return userCtx, tenantUser.RolesChangeAt, nil
+}
+
+// buildUserContext converts a tenant user with its roles into a user context
+func buildUserContext(tenantUser *models.TenantUser) *types.UserContext {
+	roles := make([]string, 0, len(tenantUser.Roles))
+	for _, role := range tenantUser.Roles {
+		roles = append(roles, role.Name)
+	}
+
+	permissions := tenantUser.GetPermissions()
+	if permissions == nil {
+		permissions = []string{}
+	}
+
+	return &types.UserContext{
+		ID:          tenantUser.ID.String(),
+		TenantID:    types.TenantID(tenantUser.TenantID.String()),
+		Email:       tenantUser.Email,
+		FirstName:   tenantUser.FirstName,
+		LastName:    tenantUser.LastName,
+		Roles:       roles,
+		Permissions: permissions,
+		IsAdmin:     false,
+	}
+}
+
+// buildSystemUserContext converts a system user into a user context
+func buildSystemUserContext(systemUser *models.SystemUser) *types.UserContext {
+	firstName, lastName := systemUser.Name, ""
+	if idx := strings.Index(systemUser.Name, " "); idx != -1 {
+		firstName, lastName = systemUser.Name[:idx], systemUser.Name[idx+1:]
+	}
+
+	permissions := supportPermissions
+	if systemUser.IsAdministrator() {
+		permissions = systemPermissions
+	}
+
+	return &types.UserContext{
+		ID:          systemUser.ID.String(),
+		TenantID:    types.TenantID(systemTenantID),
+		Email:       systemUser.Email,
+		FirstName:   firstName,
+		LastName:    lastName,
+		Roles:       []string{systemUser.Role},
+		Permissions: permissions,
+		IsAdmin:     systemUser.IsAdministrator(),
+	}
+}
+
+// withToken returns a copy of a cached user context for the request's token
+func withToken(user *types.UserContext, tokenID string) *types.UserContext {
+	userCtx := *user
+	userCtx.TokenID = tokenID
+	return &userCtx
+}
+
+// userCacheKey returns the cache key of a user
+func userCacheKey(tenantID, userID string) string {
+	return tenantID + "/" + userID
+}
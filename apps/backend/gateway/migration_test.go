@@ -0,0 +1,273 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/migrations"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// fakeSchemaMigrator reports two tenants, one of which is behind and fails to migrate
+type fakeSchemaMigrator struct {
+	upToDate   uuid.UUID
+	behind     uuid.UUID
+	migrations []migrations.Migration
+	ran        bool
+}
+
+func (f *fakeSchemaMigrator) MigrateSystem() ([]migrations.Migration, error) {
+	f.ran = true
+	return nil, nil
+}
+
+func (f *fakeSchemaMigrator) SystemStatus() (*migrations.Status, error) {
+	return &migrations.Status{Schema: migrations.SystemSchema, Version: 1, Pending: []migrations.Migration{}}, nil
+}
+
+func (f *fakeSchemaMigrator) MigrateAllTenants() ([]services.TenantMigrationResult, error) {
+	return []services.TenantMigrationResult{
+		{TenantID: f.upToDate, Slug: "acme", Schema: migrations.TenantSchema(f.upToDate), Applied: []migrations.Migration{}},
+		{TenantID: f.behind, Slug: "globex", Schema: migrations.TenantSchema(f.behind), Error: "relation already exists"},
+	}, nil
+}
+
+func (f *fakeSchemaMigrator) TenantStatuses() ([]services.TenantMigrationStatus, error) {
+	return []services.TenantMigrationStatus{
+		{TenantID: f.upToDate, Slug: "acme", Status: &migrations.Status{Schema: migrations.TenantSchema(f.upToDate), Version: 2, Pending: []migrations.Migration{}}},
+		{TenantID: f.behind, Slug: "globex", Status: &migrations.Status{Schema: migrations.TenantSchema(f.behind), Version: 1, Pending: f.migrations[1:]}},
+	}, nil
+}
+
+func setupMigrationTestApp(migrator handlers.SchemaMigrator, user *types.UserContext) *fiber.App {
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		if user != nil {
+			c.Locals("user", user)
+		}
+		return c.Next()
+	})
+	migrationHandler := handlers.NewMigrationHandler(migrator)
+	app.Get("/migrations", migrationHandler.GetMigrationStatus)
+	app.Post("/migrations", migrationHandler.RunMigrations)
+	return app
+}
+
+func TestLoadMigrations(t *testing.T) {
+	// The embedded migrations load in version order
+	for name, load := range map[string]func() ([]migrations.Migration, error){
+		"system": migrations.SystemMigrations,
+		"tenant": migrations.TenantMigrations,
+	} {
+		loaded, err := load()
+		if err != nil {
+			t.Fatalf("Failed to load %s migrations: %v", name, err)
+		}
+		if len(loaded) == 0 || loaded[0].Version != 1 || loaded[0].SQL == "" {
+			t.Fatalf("Expected %s migrations to start at version 1, got %+v", name, loaded)
+		}
+
+		// Version 1 is the schema that shipped before versioning, which existing schemas
+		// are recorded at. Everything added since is a later migration.
+		for _, later := range []string{"role_templates", "audit_logs", "access_policies", "service_accounts", "api_keys", "valid_until", "mfa_secret", "template_version"} {
+			if strings.Contains(loaded[0].SQL, later) {
+				t.Errorf("Expected %s to be added after %s migration 1", later, name)
+			}
+		}
+	}
+
+	fsys := fstest.MapFS{
+		"tenant/010_add_notes.sql": {Data: []byte("ALTER TABLE customers ADD COLUMN notes TEXT;")},
+		"tenant/002_add_tags.sql":  {Data: []byte("ALTER TABLE customers ADD COLUMN tags JSONB;")},
+		"tenant/001_init.sql":      {Data: []byte("CREATE TABLE customers (id UUID);")},
+		"duplicate/001_init.sql":   {Data: []byte("SELECT 1;")},
+		"duplicate/0001_again.sql": {Data: []byte("SELECT 1;")},
+		"misnamed/add-columns.sql": {Data: []byte("SELECT 1;")},
+	}
+	loaded, err := migrations.Load(fsys, "tenant")
+	if err != nil {
+		t.Fatalf("Failed to load migrations: %v", err)
+	}
+	if len(loaded) != 3 || loaded[0].Version != 1 || loaded[1].Version != 2 || loaded[2].Version != 10 || loaded[2].Name != "add_notes" {
+		t.Fatalf("Expected migrations sorted by version, got %+v", loaded)
+	}
+
+	// Versions must be unique and file names must carry one
+	if _, err := migrations.Load(fsys, "duplicate"); err == nil {
+		t.Fatal("Expected duplicate versions to be rejected")
+	}
+	if _, err := migrations.Load(fsys, "misnamed"); err == nil {
+		t.Fatal("Expected a file without version to be rejected")
+	}
+
+	t.Log("✓ Migrations load in version order and malformed sets are rejected")
+}
+
+func TestTenantSchemaNames(t *testing.T) {
+	tenantID := uuid.MustParse("0b6f3c2e-8d4a-4f1e-9c7b-2a5d6e8f1a3b")
+	schema := migrations.TenantSchema(tenantID)
+	if schema != "tenant_0b6f3c2e_8d4a_4f1e_9c7b_2a5d6e8f1a3b" {
+		t.Fatalf("Unexpected tenant schema name: %s", schema)
+	}
+	if quoted, err := migrations.QuoteIdentifier(schema); err != nil || quoted != `"`+schema+`"` {
+		t.Fatalf("Expected tenant schema to be quoted, got %s, %v", quoted, err)
+	}
+
+	for _, name := range []string{"", "Tenant", "tenant; DROP SCHEMA system", `tenant"x`, "pg_catalog", "1tenant"} {
+		if _, err := migrations.QuoteIdentifier(name); !errors.Is(err, migrations.ErrInvalidSchemaName) {
+			t.Fatalf("Expected %q to be rejected, got %v", name, err)
+		}
+	}
+
+	t.Log("✓ Tenant schema names are derived from tenant IDs and unsafe names are rejected")
+}
+
+func TestMigrationEndpoints(t *testing.T) {
+	tenantMigrations, err := migrations.TenantMigrations()
+	if err != nil {
+		t.Fatalf("Failed to load tenant migrations: %v", err)
+	}
+	migrator := &fakeSchemaMigrator{
+		upToDate:   uuid.New(),
+		behind:     uuid.New(),
+		migrations: append(tenantMigrations[:1:1], migrations.Migration{Version: 2, Name: "add_tags"}),
+	}
+
+	request := func(app *fiber.App, method string) *http.Response {
+		req, _ := http.NewRequest(method, "/migrations", nil)
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		return resp
+	}
+
+	// Only system admins can see or run migrations
+	if resp := request(setupMigrationTestApp(migrator, nil), "GET"); resp.StatusCode != 401 {
+		t.Fatalf("Expected 401 without a user, got %d", resp.StatusCode)
+	}
+	tenantAdmin := &types.UserContext{ID: uuid.New().String(), Roles: []string{"tenant_admin"}}
+	for _, method := range []string{"GET", "POST"} {
+		if resp := request(setupMigrationTestApp(migrator, tenantAdmin), method); resp.StatusCode != 403 {
+			t.Fatalf("Expected 403 for a tenant admin on %s, got %d", method, resp.StatusCode)
+		}
+	}
+	if migrator.ran {
+		t.Fatal("Expected migrations not to run for a tenant admin")
+	}
+
+	systemAdmin := &types.UserContext{ID: uuid.New().String(), IsAdmin: true}
+	app := setupMigrationTestApp(migrator, systemAdmin)
+
+	// Status lists each tenant with its pending migrations
+	resp := request(app, "GET")
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected 200, got %d", resp.StatusCode)
+	}
+	var status struct {
+		System struct {
+			Version int `json:"version"`
+		} `json:"system"`
+		Tenants []struct {
+			Slug    string `json:"slug"`
+			Schema  string `json:"schema"`
+			Version int    `json:"version"`
+			Pending []struct {
+				Version int    `json:"version"`
+				Name    string `json:"name"`
+			} `json:"pending"`
+		} `json:"tenants"`
+		PendingTenants int `json:"pending_tenants"`
+	}
+	json.NewDecoder(resp.Body).Decode(&status)
+	if status.System.Version != 1 || len(status.Tenants) != 2 || status.PendingTenants != 1 {
+		t.Fatalf("Unexpected migration status: %+v", status)
+	}
+	behind := status.Tenants[1]
+	if behind.Slug != "globex" || behind.Schema != migrations.TenantSchema(migrator.behind) || len(behind.Pending) != 1 || behind.Pending[0].Name != "add_tags" {
+		t.Fatalf("Expected globex to have add_tags pending, got %+v", behind)
+	}
+
+	// Rolling forward reports tenants that failed without failing the request
+	resp = request(app, "POST")
+	if resp.StatusCode != 200 {
+		t.Fatalf("Expected 200, got %d", resp.StatusCode)
+	}
+	var result struct {
+		SystemApplied []migrations.Migration `json:"system_applied"`
+		Tenants       []struct {
+			Slug  string `json:"slug"`
+			Error string `json:"error"`
+		} `json:"tenants"`
+		FailedTenants int `json:"failed_tenants"`
+	}
+	json.NewDecoder(resp.Body).Decode(&result)
+	if !migrator.ran || result.SystemApplied == nil || result.FailedTenants != 1 || result.Tenants[1].Error == "" {
+		t.Fatalf("Unexpected migration result: %+v", result)
+	}
+
+	t.Log("✓ System admins can inspect and roll forward tenant schema migrations")
+}
+
+func TestMigrationEndpointsForSystemAdmins(t *testing.T) {
+	migrator := &fakeSchemaMigrator{upToDate: uuid.New(), behind: uuid.New(), migrations: []migrations.Migration{{Version: 1}, {Version: 2, Name: "add_tags"}}}
+	systemAdmin := &models.SystemUser{ID: uuid.New(), Email: "admin@zplus.io", Name: "Platform Admin", Role: "super_admin", IsActive: true}
+	support := &models.SystemUser{ID: uuid.New(), Email: "support@zplus.io", Name: "Support", Role: "support", IsActive: true}
+	lookup := &fakeUserLookup{systemUsers: map[uuid.UUID]*models.SystemUser{systemAdmin.ID: systemAdmin, support.ID: support}}
+
+	// The middleware chain of the gateway
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := fiber.New()
+	app.Use(middleware.TenantMiddleware(middleware.NewTenantResolver(newFakeTenantLookup(), time.Minute)))
+	app.Use(middleware.AuthMiddleware(tokenManager, middleware.NewUserResolver(lookup, time.Minute)))
+	migrationHandler := handlers.NewMigrationHandler(migrator)
+	app.Get("/api/v1/migrations", migrationHandler.GetMigrationStatus)
+	app.Post("/api/v1/migrations", migrationHandler.RunMigrations)
+
+	token, _ := tokenManager.GenerateToken(systemAdmin.ID.String(), "system", "system_admin")
+	for _, tenant := range []string{"", "acme"} {
+		for _, method := range []string{"GET", "POST"} {
+			req, _ := http.NewRequest(method, "/api/v1/migrations", nil)
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tenant != "" {
+				req.Header.Set("X-Tenant-ID", tenant)
+			}
+			resp, err := app.Test(req, 5000)
+			if err != nil || resp.StatusCode != 200 {
+				t.Fatalf("Expected status 200 for %s with tenant %q, got %v %v", method, tenant, resp.StatusCode, err)
+			}
+		}
+	}
+	if !migrator.ran {
+		t.Fatal("Expected the migrations to run")
+	}
+
+	// Support staff sign in to the system tenant but may not run migrations
+	migrator.ran = false
+	supportToken, _ := tokenManager.GenerateToken(support.ID.String(), "system", "support")
+	for _, method := range []string{"GET", "POST"} {
+		req, _ := http.NewRequest(method, "/api/v1/migrations", nil)
+		req.Header.Set("Authorization", "Bearer "+supportToken)
+		resp, err := app.Test(req, 5000)
+		if err != nil || resp.StatusCode != 403 {
+			t.Fatalf("Expected status 403 for %s by support staff, got %v %v", method, resp.StatusCode, err)
+		}
+	}
+	if migrator.ran {
+		t.Fatal("Expected support staff not to run migrations")
+	}
+
+	t.Log("✓ System admins reach the migration endpoints through the gateway middleware, support staff do not")
+}
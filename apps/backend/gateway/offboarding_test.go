@@ -0,0 +1,333 @@
+package main
+
+import (
+	"archive/zip"
+	"database/sql/driver"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/resolver"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+	"gorm.io/gorm"
+)
+
+// offboardingDriver serves a tenant of the shared schema pending deletion: its deletion,
+// its tenant row, and tables of the shared schema with their foreign keys
+type offboardingDriver struct {
+	fakeDriver
+	tenantID   uuid.UUID
+	purgeAfter time.Time
+	exportPath string
+}
+
+// audited returns the details of the audit entries recorded for an action, and whether
+// the entries name the tenant in their tenant_id
+func (d *offboardingDriver) audited(action string) (details []string, withTenant bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, statement := range d.statements {
+		if !strings.HasPrefix(statement, `INSERT INTO "system"."audit_logs"`) {
+			continue
+		}
+		found := false
+		for _, arg := range d.args[i] {
+			value, _ := arg.Value.(string)
+			switch {
+			case value == action:
+				found = true
+			case value == d.tenantID.String():
+				withTenant = true
+			case strings.HasPrefix(value, "{"):
+				details = append(details, value)
+			}
+		}
+		if !found {
+			details = details[:0]
+			withTenant = false
+			continue
+		}
+		return details, withTenant
+	}
+	return nil, false
+}
+
+func (d *offboardingDriver) query(_ *fakeConn, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	switch {
+	case strings.Contains(query, "count("):
+		return fakeColumn("count", int64(1)), nil
+	case strings.Contains(query, `FROM "system"."tenant_deletions"`):
+		var exportedAt driver.Value
+		if d.exportPath != "" {
+			exportedAt = time.Now()
+		}
+		return &fakeRows{
+			columns: []string{"id", "tenant_id", "slug", "isolation", "status", "previous_status", "purge_after", "export_path", "exported_at"},
+			values: [][]driver.Value{{
+				uuid.NewString(), d.tenantID.String(), "acme", "shared", models.TenantDeletionPending, "active", d.purgeAfter, d.exportPath, exportedAt,
+			}},
+		}, nil
+	case strings.Contains(query, `SELECT "isolation" FROM "system"."tenants"`):
+		return fakeColumn("isolation", "shared"), nil
+	case strings.Contains(query, `FROM "system"."tenants"`):
+		return &fakeRows{
+			columns: []string{"id", "slug", "isolation", "status"},
+			values:  [][]driver.Value{{d.tenantID.String(), "acme", "shared", models.TenantStatusPendingDeletion}},
+		}, nil
+	case strings.Contains(query, "information_schema.tables"):
+		return fakeColumn("table_name", "customers", "permissions", "role_permissions", "roles", "user_roles", "users"), nil
+	case strings.Contains(query, "information_schema.columns"):
+		return fakeColumn("table_name", "customers", "roles", "users"), nil
+	case strings.Contains(query, "information_schema.table_constraints"):
+		rows := &fakeRows{columns: []string{"referencing", "referenced"}}
+		for _, reference := range [][2]string{
+			{"customers", "users"},
+			{"role_permissions", "permissions"},
+			{"role_permissions", "roles"},
+			{"user_roles", "roles"},
+			{"user_roles", "users"},
+			{"users", "users"},
+		} {
+			rows.values = append(rows.values, []driver.Value{reference[0], reference[1]})
+		}
+		return rows, nil
+	case strings.Contains(query, `FROM "users"`):
+		return &fakeRows{
+			columns: []string{"id", "email", "password_hash", "mfa_secret", "created_at"},
+			values:  [][]driver.Value{{d.tenantID.String(), "admin@acme.test", "$2a$10$hash", "JBSWY3DPEHPK3PXP", time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)}},
+		}, nil
+	}
+	return nil, nil
+}
+
+func openOffboardingDB(t *testing.T, fake *offboardingDriver) *gorm.DB {
+	db := openFakeDB(t, fake)
+	if err := db.Use(tenancy.NewRouter(tenancy.SharedTables{}, tenancy.SchemaPerTenant{})); err != nil {
+		t.Fatalf("Failed to register tenancy router: %v", err)
+	}
+	return db
+}
+
+func TestTenantExportAndRestore(t *testing.T) {
+	fake := &offboardingDriver{tenantID: uuid.New(), purgeAfter: time.Now().Add(time.Hour)}
+	offboarding := services.NewOffboardingService(openOffboardingDB(t, fake))
+	offboarding.SetExportDir(t.TempDir())
+	actorID := uuid.New()
+
+	deletion, err := offboarding.Export(fake.tenantID, &actorID)
+	if err != nil {
+		t.Fatalf("Failed to export tenant: %v", err)
+	}
+	if deletion.ExportedAt == nil || deletion.ExportSize == 0 {
+		t.Fatalf("Expected the export to be recorded, got %+v", deletion)
+	}
+
+	// Every table holding rows of the tenant is exported as JSON and CSV, shared
+	// catalogues are not
+	archive, err := zip.OpenReader(deletion.ExportPath)
+	if err != nil {
+		t.Fatalf("Failed to open export: %v", err)
+	}
+	entries := map[string]*zip.File{}
+	for _, file := range archive.File {
+		entries[file.Name] = file
+	}
+	for _, name := range []string{"system/tenants", "system/subscriptions", "system/tenant_modules", "tenant/users", "tenant/user_roles", "tenant/role_permissions"} {
+		if entries[name+".json"] == nil || entries[name+".csv"] == nil {
+			t.Fatalf("Expected %s to be exported, got %v", name, entries)
+		}
+	}
+	if entries["tenant/permissions.json"] != nil {
+		t.Fatal("Expected the shared permission catalogue not to be exported")
+	}
+
+	reader, err := entries["tenant/users.json"].Open()
+	if err != nil {
+		t.Fatalf("Failed to read export: %v", err)
+	}
+	var users []map[string]interface{}
+	err = json.NewDecoder(reader).Decode(&users)
+	reader.Close()
+	if err != nil || len(users) != 1 || users[0]["email"] != "admin@acme.test" {
+		t.Fatalf("Expected the users to be exported as JSON, got %v (%v)", users, err)
+	}
+	if _, exists := users[0]["password_hash"]; exists || users[0]["mfa_secret"] != nil {
+		t.Fatalf("Expected credentials to be left out of the export, got %v", users[0])
+	}
+	reader, err = entries["tenant/users.csv"].Open()
+	if err != nil {
+		t.Fatalf("Failed to read export: %v", err)
+	}
+	records, err := csv.NewReader(reader).ReadAll()
+	reader.Close()
+	if err != nil || len(records) != 2 || strings.Join(records[0], ",") != "id,email,created_at" || records[1][1] != "admin@acme.test" {
+		t.Fatalf("Expected the users to be exported as CSV without credentials, got %v (%v)", records, err)
+	}
+	archive.Close()
+
+	if details, withTenant := fake.audited(models.AuditTenantExported); details == nil || !withTenant || !strings.Contains(details[0], actorID.String()) {
+		t.Fatalf("Expected the export to be audited with its actor, got %v", details)
+	}
+
+	// The grace period has not ended, the tenant can be restored but not purged
+	if _, err := offboarding.Purge(fake.tenantID, &actorID); !errors.Is(err, services.ErrGracePeriod) {
+		t.Fatalf("Expected the purge to wait for the grace period, got %v", err)
+	}
+
+	fake.exportPath = deletion.ExportPath
+	restored, err := offboarding.Restore(fake.tenantID, &actorID)
+	if err != nil {
+		t.Fatalf("Failed to restore tenant: %v", err)
+	}
+	if restored.Status != models.TenantDeletionRestored || restored.RestoredAt == nil {
+		t.Fatalf("Expected the deletion to be restored, got %+v", restored)
+	}
+	if err := fake.ordered(`UPDATE "system"."tenants" SET "status"`, `UPDATE "system"."tenant_deletions"`, `INSERT INTO "system"."audit_logs"`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(deletion.ExportPath); !os.IsNotExist(err) {
+		t.Fatal("Expected the export of a restored tenant to be removed")
+	}
+	if details, _ := fake.audited(models.AuditTenantRestored); details == nil {
+		t.Fatal("Expected the restore to be audited")
+	}
+
+	t.Log("✓ Deleted tenants are exported per table and can be restored during the grace period")
+}
+
+func TestTenantPurgeDeletesInDependencyOrder(t *testing.T) {
+	fake := &offboardingDriver{tenantID: uuid.New(), purgeAfter: time.Now().Add(-time.Hour)}
+	db := openOffboardingDB(t, fake)
+
+	events := services.NewEventBus()
+	published := 0
+	events.Subscribe(services.EventTenantChanged, func(services.Event) { published++ })
+
+	offboarding := services.NewOffboardingService(db)
+	offboarding.SetEventBus(events)
+	offboarding.SetExportDir(t.TempDir())
+
+	purged, err := offboarding.PurgeExpired(time.Now())
+	if err != nil || purged != 1 {
+		t.Fatalf("Expected the expired tenant to be purged, got %d (%v)", purged, err)
+	}
+
+	// The data is exported before anything is deleted, tables referencing others go first
+	// and the tenant row goes last
+	if err := fake.ordered(
+		`INSERT INTO "system"."audit_logs"`,
+		`DELETE FROM "customers"`,
+		`DELETE FROM "users"`,
+		`DELETE FROM "system"."tenant_modules"`,
+		`DELETE FROM "system"."tenants"`,
+		`UPDATE "system"."tenant_deletions"`,
+	); err != nil {
+		t.Fatal(err)
+	}
+	for _, order := range [][2]string{
+		{"user_roles", "users"},
+		{"user_roles", "roles"},
+		{"role_permissions", "roles"},
+		{"users", "system\".\"tenants"},
+	} {
+		first, second := fake.index(`DELETE FROM "`+order[0]+`"`, 0), fake.index(`DELETE FROM "`+order[1]+`"`, 0)
+		if first < 0 || second < 0 || first > second {
+			t.Fatalf("Expected %s to be deleted before %s:\n%s", order[0], order[1], strings.Join(fake.statements, "\n"))
+		}
+	}
+	if fake.index(`DELETE FROM "permissions"`, 0) >= 0 {
+		t.Fatal("Expected the shared permission catalogue to be kept")
+	}
+	if index := fake.index(`DELETE FROM "customers"`, 0); !strings.Contains(fake.statements[index], "tenant_id = $1") {
+		t.Fatalf("Expected only the tenant's rows to be deleted, got %s", fake.statements[index])
+	}
+
+	// The tenant row is gone, so the purge is recorded without it but names it
+	if _, withTenant := fake.audited(models.AuditTenantExported); !withTenant {
+		t.Fatal("Expected the export to be audited")
+	}
+	details, withTenant := fake.audited(models.AuditTenantPurged)
+	if details == nil || withTenant || !strings.Contains(details[0], fake.tenantID.String()) {
+		t.Fatalf("Expected the purge to be audited by tenant ID in its details, got %v", details)
+	}
+	if published != 1 {
+		t.Fatalf("Expected the purge to be published, got %d events", published)
+	}
+
+	t.Log("✓ Expired tenants are exported, then purged in foreign key order with an audit entry")
+}
+
+func TestTenantDeletionRoutesForSystemAdmins(t *testing.T) {
+	fake := &offboardingDriver{tenantID: uuid.New(), purgeAfter: time.Now().Add(time.Hour)}
+	db := openOffboardingDB(t, fake)
+	offboarding := services.NewOffboardingService(db)
+	offboarding.SetExportDir(t.TempDir())
+
+	tenants := newFakeTenantLookup()
+	acme := tenants.tenants[0]
+	tenantAdmin := newAnalystUser(acme.ID)
+	systemAdmin := &models.SystemUser{ID: uuid.New(), Email: "admin@zplus.io", Name: "Platform Admin", Role: "super_admin", IsActive: true}
+	lookup := &fakeUserLookup{
+		users:       map[uuid.UUID]*models.TenantUser{tenantAdmin.ID: tenantAdmin},
+		systemUsers: map[uuid.UUID]*models.SystemUser{systemAdmin.ID: systemAdmin},
+	}
+
+	// The middleware chain of the gateway
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := fiber.New()
+	app.Use(middleware.TenantMiddleware(middleware.NewTenantResolver(tenants, time.Minute)))
+	app.Use(middleware.AuthMiddleware(tokenManager, middleware.NewUserResolver(lookup, time.Minute)))
+	setupRESTRoutes(app, db, resolver.NewResolver(), nil, offboarding)
+
+	request := func(method, path, tenant, userID, tenantID string) (int, string) {
+		token, _ := tokenManager.GenerateToken(userID, tenantID, "admin")
+		req, _ := http.NewRequest(method, path, nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		if tenant != "" {
+			req.Header.Set("X-Tenant-ID", tenant)
+		}
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		var body map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&body)
+		code, _ := body["code"].(string)
+		return resp.StatusCode, code
+	}
+	deletion := "/api/v1/tenants/" + fake.tenantID.String() + "/deletion"
+
+	// System admins reach the deletion endpoints with or without a tenant
+	if status, code := request("GET", deletion, "", systemAdmin.ID.String(), "system"); status != 200 {
+		t.Fatalf("Expected status 200 for a system admin without a tenant, got %d %s", status, code)
+	}
+	if status, code := request("GET", deletion, "acme", systemAdmin.ID.String(), "system"); status != 200 {
+		t.Fatalf("Expected status 200 for a system admin on a tenant host, got %d %s", status, code)
+	}
+
+	// Tenant users are refused by the handler
+	if status, _ := request("GET", deletion, "acme", tenantAdmin.ID.String(), acme.ID.String()); status != 403 {
+		t.Fatalf("Expected status 403 for a tenant user, got %d", status)
+	}
+
+	// Tenant routes still require the tenant of the token
+	if status, code := request("GET", "/api/v1/users", "", systemAdmin.ID.String(), "system"); status != 400 || code != "TENANT_REQUIRED" {
+		t.Fatalf("Expected TENANT_REQUIRED on a tenant route, got %d %s", status, code)
+	}
+	if status, code := request("GET", "/api/v1/users", "acme", systemAdmin.ID.String(), "system"); status != 403 || code != "TENANT_MISMATCH" {
+		t.Fatalf("Expected TENANT_MISMATCH on a tenant route, got %d %s", status, code)
+	}
+
+	t.Log("✓ System admins reach tenant deletion routes through the gateway middleware")
+}
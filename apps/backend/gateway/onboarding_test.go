@@ -0,0 +1,199 @@
+package main
+
+import (
+	"database/sql/driver"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// sagaDriver is a fake database whose queries find nothing, except the module catalogue
+// and the stored onboarding, so every onboarding step has work to do
+type sagaDriver struct {
+	fakeDriver
+	onboarding []driver.Value   // Row of system.tenant_onboardings
+	leaseLost  bool             // Progress saves match no onboarding, as if another process took it over
+	tenant     []driver.Value   // Row of system.tenants
+	plan       []driver.Value   // Row of system.plans: id, name and isolation
+	isolation  string           // Isolation of every tenant, looked up when a router is registered
+	counts     map[string]int64 // Results of count queries containing the key, 0 otherwise
+}
+
+// lastStatus returns the status last saved for the onboarding
+func (d *sagaDriver) lastStatus() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i := len(d.statements) - 1; i >= 0; i-- {
+		if !strings.HasPrefix(d.statements[i], `UPDATE "system"."tenant_onboardings" SET "status"`) {
+			continue
+		}
+		return d.args[i][0].Value.(string)
+	}
+	return ""
+}
+
+func (d *sagaDriver) exec(_ *fakeConn, query string, _ []driver.NamedValue) (driver.Result, error) {
+	if d.leaseLost && strings.HasPrefix(query, `UPDATE "system"."tenant_onboardings" SET "status"`) {
+		return driver.RowsAffected(0), nil
+	}
+	return nil, nil
+}
+
+func (d *sagaDriver) query(_ *fakeConn, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	switch {
+	case strings.Contains(query, "count("):
+		count := int64(0)
+		for part, value := range d.counts {
+			if strings.Contains(query, part) {
+				count = value
+			}
+		}
+		return fakeColumn("count", count), nil
+	case strings.HasPrefix(query, `SELECT "isolation" FROM "system"."tenants"`) && d.isolation != "":
+		return fakeColumn("isolation", d.isolation), nil
+	case strings.Contains(query, `FROM "system"."tenants"`) && d.tenant != nil:
+		return &fakeRows{columns: []string{"id", "name", "slug", "status"}, values: [][]driver.Value{d.tenant}}, nil
+	case strings.Contains(query, `FROM "system"."plans"`) && d.plan != nil:
+		return &fakeRows{columns: []string{"id", "name", "isolation"}, values: [][]driver.Value{d.plan}}, nil
+	case strings.Contains(query, `FROM "system"."modules"`):
+		return &fakeRows{columns: []string{"id", "name"}, values: [][]driver.Value{{uuid.NewString(), "crm"}}}, nil
+	case strings.Contains(query, `FROM "system"."tenant_onboardings"`) && d.onboarding != nil:
+		return &fakeRows{
+			columns: []string{"id", "tenant_id", "slug", "status", "step", "steps", "input", "error"},
+			values:  [][]driver.Value{d.onboarding},
+		}, nil
+	}
+	return nil, nil
+}
+
+func TestOnboardingRollsBackAppliedSteps(t *testing.T) {
+	fake := &sagaDriver{fakeDriver: fakeDriver{failOn: `INSERT INTO "system"."tenant_modules"`}}
+	db := openFakeDB(t, fake)
+
+	events := services.NewEventBus()
+	published := 0
+	events.Subscribe(services.EventTenantChanged, func(services.Event) { published++ })
+
+	onboarding := services.NewOnboardingService(db)
+	onboarding.SetEventBus(events)
+	_, err := onboarding.Onboard(services.OnboardingInput{
+		Tenant: services.CreateTenantInput{
+			Name:      "ACME Corporation",
+			Slug:      "acme",
+			Isolation: "schema",
+			Modules:   []string{"crm"},
+		},
+	})
+	if err == nil || !strings.Contains(err.Error(), "failed to enable modules") {
+		t.Fatalf("Expected the modules step to fail, got %v", err)
+	}
+
+	// Steps are applied in order, then undone in reverse order starting with the failed one
+	if err := fake.ordered(
+		`INSERT INTO "system"."tenant_onboardings"`,
+		`INSERT INTO "system"."tenants"`,
+		"CREATE SCHEMA IF NOT EXISTS",
+		`INSERT INTO "system"."tenant_modules"`,
+		`DELETE FROM "system"."tenant_modules"`,
+		`DELETE FROM "system"."subscriptions"`,
+		`DELETE FROM "roles"`,
+		"DROP SCHEMA IF EXISTS",
+		`DELETE FROM "system"."tenants"`,
+	); err != nil {
+		t.Fatal(err)
+	}
+	if status := fake.lastStatus(); status != models.OnboardingFailed {
+		t.Fatalf("Expected the onboarding to end failed, got %q", status)
+	}
+	if published != 0 {
+		t.Fatal("Expected no tenant to be published")
+	}
+
+	// Invalid input is rejected before anything is stored
+	before := len(fake.statements)
+	if _, err := onboarding.Onboard(services.OnboardingInput{Tenant: services.CreateTenantInput{Name: "Bad", Slug: "Bad Slug"}}); err == nil {
+		t.Fatal("Expected an invalid slug to be rejected")
+	}
+	if len(fake.statements) != before {
+		t.Fatal("Expected no statements for an invalid slug")
+	}
+
+	t.Log("✓ A failed onboarding undoes its applied steps in reverse order")
+}
+
+func TestOnboardingResumesAfterCrash(t *testing.T) {
+	id, tenantID := uuid.New(), uuid.New()
+	input := `{"tenant":{"name":"ACME Corporation","slug":"acme","isolation":"schema"}}`
+
+	// A process stopped after the roles were seeded: the remaining steps are applied
+	running := &sagaDriver{onboarding: []driver.Value{
+		id.String(), tenantID.String(), "acme", models.OnboardingRunning, services.OnboardingStepAdmin,
+		`["tenant","schema","roles"]`, input, "",
+	}}
+	record, err := services.NewOnboardingService(openFakeDB(t, running)).Resume(id)
+	if err != nil {
+		t.Fatalf("Failed to resume onboarding: %v", err)
+	}
+	if record.Status != models.OnboardingCompleted || running.lastStatus() != models.OnboardingCompleted {
+		t.Fatalf("Expected the onboarding to complete, got %q", record.Status)
+	}
+	for _, applied := range []string{`INSERT INTO "system"."tenants"`, "CREATE SCHEMA", `INSERT INTO "system"."role_templates"`} {
+		if running.index(applied, 0) >= 0 {
+			t.Fatalf("Expected applied steps not to run again, got %q", applied)
+		}
+	}
+	if len(record.Steps) != 7 {
+		t.Fatalf("Expected every step to be applied, got %v", record.Steps)
+	}
+
+	// A process stopped while rolling back: only the remaining steps are undone
+	compensating := &sagaDriver{onboarding: []driver.Value{
+		id.String(), tenantID.String(), "acme", models.OnboardingCompensating, services.OnboardingStepRoles,
+		`["tenant","schema"]`, input, "roles: connection reset by peer",
+	}}
+	record, err = services.NewOnboardingService(openFakeDB(t, compensating)).Resume(id)
+	if err == nil || err.Error() != "roles: connection reset by peer" {
+		t.Fatalf("Expected the error of the failed step, got %v", err)
+	}
+	if record.Status != models.OnboardingFailed {
+		t.Fatalf("Expected the onboarding to be rolled back, got %q", record.Status)
+	}
+	if err := compensating.ordered(`DELETE FROM "roles"`, "DROP SCHEMA IF EXISTS", `DELETE FROM "system"."tenants"`); err != nil {
+		t.Fatal(err)
+	}
+	if compensating.index("INSERT INTO", 0) >= 0 || compensating.index(`DELETE FROM "users"`, 0) >= 0 {
+		t.Fatalf("Expected only applied steps to be undone:\n%s", strings.Join(compensating.statements, "\n"))
+	}
+
+	t.Log("✓ Interrupted onboardings resume where they stopped")
+}
+
+func TestOnboardingStopsWhenLeaseLost(t *testing.T) {
+	id, tenantID := uuid.New(), uuid.New()
+	fake := &sagaDriver{
+		onboarding: []driver.Value{
+			id.String(), tenantID.String(), "acme", models.OnboardingRunning, services.OnboardingStepAdmin,
+			`["tenant","schema","roles"]`, `{"tenant":{"name":"ACME Corporation","slug":"acme","isolation":"schema"}}`, "",
+		},
+		leaseLost: true,
+	}
+
+	// The lease expired while this process was paused and another process resumed the
+	// onboarding, so this one must not run the remaining steps as well
+	_, err := services.NewOnboardingService(openFakeDB(t, fake)).Resume(id)
+	if err != services.ErrOnboardingLeaseLost {
+		t.Fatalf("Expected ErrOnboardingLeaseLost, got %v", err)
+	}
+	save := fake.index(`UPDATE "system"."tenant_onboardings" SET "status"`, 0)
+	if save < 0 || !strings.Contains(fake.statements[save], "locked_until =") {
+		t.Fatalf("Expected progress to be saved only under this process's lease:\n%s", strings.Join(fake.statements, "\n"))
+	}
+	if fake.index(`INSERT INTO "users"`, 0) >= 0 || fake.index("INSERT INTO", save) >= 0 {
+		t.Fatalf("Expected no step to run after the lease was lost:\n%s", strings.Join(fake.statements, "\n"))
+	}
+
+	t.Log("✓ An onboarding taken over by another process stops running")
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/clients"
+)
+
+func TestAuthClientPasswordReset(t *testing.T) {
+	// Stand-in for the auth service password endpoints
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		json.NewDecoder(r.Body).Decode(&body)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/forgot-password":
+			json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		case r.URL.Path == "/reset-password" && body["token"] == "valid-token":
+			json.NewEncoder(w).Encode(map[string]interface{}{"success": true})
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{
+				"code":    "INVALID_RESET_TOKEN",
+				"message": "The link is invalid or has expired",
+			})
+		}
+	}))
+	defer server.Close()
+
+	client := clients.NewAuthClient(server.URL)
+
+	if err := client.ForgotPassword("john@demo-corp.zplus.com", "demo-corp"); err != nil {
+		t.Fatalf("Forgot password failed: %v", err)
+	}
+	if err := client.ResetPassword("valid-token", "new-password"); err != nil {
+		t.Fatalf("Reset password failed: %v", err)
+	}
+
+	err := client.ResetPassword("used-token", "new-password")
+	apiErr, ok := err.(*clients.APIError)
+	if !ok || apiErr.Code != "INVALID_RESET_TOKEN" || apiErr.Status != http.StatusBadRequest {
+		t.Fatalf("Expected INVALID_RESET_TOKEN error, got %v", err)
+	}
+
+	t.Log("✓ Password reset requests forwarded to the auth service")
+}
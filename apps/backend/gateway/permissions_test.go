@@ -0,0 +1,551 @@
+package main
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/generated"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/resolver"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// fakeUserLookup serves users from memory and counts database lookups
+type fakeUserLookup struct {
+	users       map[uuid.UUID]*models.TenantUser
+	systemUsers map[uuid.UUID]*models.SystemUser
+	attributes  map[string]map[string]string // by email
+	lookups     int
+}
+
+func (f *fakeUserLookup) GetTenantUser(tenantID, userID uuid.UUID) (*models.TenantUser, error) {
+	f.lookups++
+	user, exists := f.users[userID]
+	if !exists || user.TenantID != tenantID {
+		return nil, fmt.Errorf("user not found")
+	}
+	return user, nil
+}
+
+func (f *fakeUserLookup) GetSystemUser(userID uuid.UUID) (*models.SystemUser, error) {
+	f.lookups++
+	user, exists := f.systemUsers[userID]
+	if !exists {
+		return nil, fmt.Errorf("user not found")
+	}
+	return user, nil
+}
+
+func (f *fakeUserLookup) GetUserAttributes(tenantID uuid.UUID, email string) (map[string]string, error) {
+	return f.attributes[email], nil
+}
+
+// newAnalystUser creates a tenant user with a custom role that is not known to the gateway
+func newAnalystUser(tenantID uuid.UUID) *models.TenantUser {
+	return &models.TenantUser{
+		ID:        uuid.New(),
+		TenantID:  tenantID,
+		Email:     "jane.doe@acme.com",
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Status:    "active",
+		Roles: []models.Role{{
+			ID:       uuid.New(),
+			TenantID: tenantID,
+			Name:     "analyst",
+			Permissions: []models.Permission{
+				{ID: uuid.New(), Name: "reports:read", Resource: "reports", Action: "read"},
+				{ID: uuid.New(), Name: "customers:read", Resource: "customers", Action: "read"},
+			},
+		}},
+	}
+}
+
+func setupPermissionsTestApp(tokenManager *auth.TokenManager, users *middleware.UserResolver) *fiber.App {
+	app := fiber.New()
+	app.Use(middleware.AuthMiddleware(tokenManager, users))
+	app.Get("/me", func(c *fiber.Ctx) error {
+		return c.JSON(middleware.GetUserContext(c))
+	})
+	return app
+}
+
+func getMe(t *testing.T, app *fiber.App, token string) (int, *types.UserContext) {
+	req, _ := http.NewRequest("GET", "/me", nil)
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+
+	var userCtx types.UserContext
+	json.NewDecoder(resp.Body).Decode(&userCtx)
+	return resp.StatusCode, &userCtx
+}
+
+func TestPermissionsResolvedFromRoleAssignments(t *testing.T) {
+	tenantID := uuid.New()
+	user := newAnalystUser(tenantID)
+	lookup := &fakeUserLookup{users: map[uuid.UUID]*models.TenantUser{user.ID: user}}
+
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := setupPermissionsTestApp(tokenManager, middleware.NewUserResolver(lookup, time.Minute))
+
+	// The token role is ignored in favour of the user's role assignments
+	token, _ := tokenManager.GenerateToken(user.ID.String(), tenantID.String(), "user")
+	status, me := getMe(t, app, token)
+	if status != 200 {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if me.Email != "jane.doe@acme.com" || me.FirstName != "Jane" || me.LastName != "Doe" {
+		t.Fatalf("Expected the user's own email and name, got %s %s <%s>", me.FirstName, me.LastName, me.Email)
+	}
+	if len(me.Roles) != 1 || me.Roles[0] != "analyst" {
+		t.Fatalf("Expected role analyst, got %v", me.Roles)
+	}
+	if !me.HasPermission("reports:read") || !me.HasPermission("customers:read") {
+		t.Fatalf("Expected permissions of the analyst role, got %v", me.Permissions)
+	}
+	if me.HasPermission("products:read") {
+		t.Fatalf("Expected no permissions beyond the analyst role, got %v", me.Permissions)
+	}
+
+	// Disabled users are rejected even with a valid token
+	user.Status = "suspended"
+	lookup.users[user.ID] = user
+	disabled := middleware.NewUserResolver(lookup, time.Minute)
+	if status, _ := getMe(t, setupPermissionsTestApp(tokenManager, disabled), token); status != 401 {
+		t.Fatalf("Expected status 401 for a suspended user, got %d", status)
+	}
+
+	// Tokens of unknown users are rejected
+	token, _ = tokenManager.GenerateToken(uuid.New().String(), tenantID.String(), "tenant_admin")
+	if status, _ := getMe(t, app, token); status != 401 {
+		t.Fatalf("Expected status 401 for an unknown user, got %d", status)
+	}
+
+	t.Log("✓ Permissions resolved from role assignments")
+}
+
+func TestSystemUserPermissions(t *testing.T) {
+	admin := &models.SystemUser{ID: uuid.New(), Email: "ops@zplus.io", Name: "Ops Team", Role: "super_admin", IsActive: true}
+	support := &models.SystemUser{ID: uuid.New(), Email: "support@zplus.io", Name: "Support", Role: "support", IsActive: true}
+	lookup := &fakeUserLookup{systemUsers: map[uuid.UUID]*models.SystemUser{admin.ID: admin, support.ID: support}}
+
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := setupPermissionsTestApp(tokenManager, middleware.NewUserResolver(lookup, time.Minute))
+
+	token, _ := tokenManager.GenerateToken(admin.ID.String(), "system", "system_admin")
+	status, me := getMe(t, app, token)
+	if status != 200 {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if !me.IsAdmin || me.Email != "ops@zplus.io" || me.FirstName != "Ops" || me.LastName != "Team" {
+		t.Fatalf("Expected system admin Ops Team, got %+v", me)
+	}
+	if !me.HasPermission("system:manage") {
+		t.Fatalf("Expected system permissions, got %v", me.Permissions)
+	}
+
+	// Support staff are system users without admin access
+	token, _ = tokenManager.GenerateToken(support.ID.String(), "system", "support")
+	status, me = getMe(t, app, token)
+	if status != 200 {
+		t.Fatalf("Expected status 200, got %d", status)
+	}
+	if me.IsAdmin || me.HasPermission("system:manage") || me.HasPermission("tenants:write") || !me.HasPermission("tenants:read") {
+		t.Fatalf("Expected support staff with read access only, got %+v", me)
+	}
+
+	t.Log("✓ System users resolved with system permissions")
+}
+
+func TestUserCacheInvalidatedOnRoleChange(t *testing.T) {
+	tenantID := uuid.New()
+	user := newAnalystUser(tenantID)
+	other := newAnalystUser(tenantID)
+	lookup := &fakeUserLookup{users: map[uuid.UUID]*models.TenantUser{user.ID: user, other.ID: other}}
+	resolver := middleware.NewUserResolver(lookup, time.Minute)
+
+	events := services.NewEventBus()
+	events.Subscribe(services.EventUserChanged, resolver.HandleEvent)
+	events.Subscribe(services.EventRolesChanged, resolver.HandleEvent)
+
+	claims := &auth.Claims{UserID: user.ID.String(), TenantID: tenantID.String()}
+	otherClaims := &auth.Claims{UserID: other.ID.String(), TenantID: tenantID.String()}
+	resolver.Resolve(claims)
+	resolver.Resolve(claims)
+	resolver.Resolve(otherClaims)
+	if lookup.lookups != 2 {
+		t.Fatalf("Expected 2 database lookups with cache, got %d", lookup.lookups)
+	}
+
+	// Simulate AssignRoles publishing a change for one user
+	user.Roles[0].Permissions = append(user.Roles[0].Permissions, models.Permission{Name: "reports:write"})
+	events.Publish(services.Event{Type: services.EventRolesChanged, TenantID: tenantID, UserID: user.ID})
+
+	refreshed, _ := resolver.Resolve(claims)
+	resolver.Resolve(otherClaims)
+	if lookup.lookups != 3 {
+		t.Fatalf("Expected only the changed user to be reloaded, got %d lookups", lookup.lookups)
+	}
+	if !refreshed.HasPermission("reports:write") {
+		t.Fatalf("Expected refreshed permissions, got %v", refreshed.Permissions)
+	}
+
+	// Simulate a role permission change affecting every user of the tenant
+	events.Publish(services.Event{Type: services.EventRolesChanged, TenantID: tenantID})
+	resolver.Resolve(claims)
+	resolver.Resolve(otherClaims)
+	if lookup.lookups != 5 {
+		t.Fatalf("Expected every user of the tenant to be reloaded, got %d lookups", lookup.lookups)
+	}
+
+	t.Log("✓ User cache invalidated on role change")
+}
+
+func TestTimeBoundRoleAssignments(t *testing.T) {
+	now := time.Now()
+	hourAgo, inHour := now.Add(-time.Hour), now.Add(time.Hour)
+
+	for _, tc := range []struct {
+		name       string
+		assignment models.UserRole
+		active     bool
+	}{
+		{"permanent", models.UserRole{}, true},
+		{"within window", models.UserRole{ValidFrom: &hourAgo, ValidUntil: &inHour}, true},
+		{"not started", models.UserRole{ValidFrom: &inHour}, false},
+		{"expired", models.UserRole{ValidUntil: &hourAgo}, false},
+	} {
+		if active := tc.assignment.ActiveAt(now); active != tc.active {
+			t.Errorf("%s: ActiveAt = %v, expected %v", tc.name, active, tc.active)
+		}
+	}
+
+	if err := (&services.RoleWindow{ValidUntil: &hourAgo}).Validate(); err != services.ErrInvalidRoleWindow {
+		t.Errorf("Expected a window ending in the past to be rejected, got %v", err)
+	}
+	if err := (&services.RoleWindow{ValidFrom: &inHour, ValidUntil: &inHour}).Validate(); err != services.ErrInvalidRoleWindow {
+		t.Errorf("Expected an empty window to be rejected, got %v", err)
+	}
+	if err := (&services.RoleWindow{ValidFrom: &hourAgo, ValidUntil: &inHour}).Validate(); err != nil {
+		t.Errorf("Expected a valid window, got %v", err)
+	}
+
+	// The sweeper announces removed assignments so cached users are reloaded
+	tenantID := uuid.New()
+	user := newAnalystUser(tenantID)
+	lookup := &fakeUserLookup{users: map[uuid.UUID]*models.TenantUser{user.ID: user}}
+	resolver := middleware.NewUserResolver(lookup, time.Minute)
+	events := services.NewEventBus()
+	events.Subscribe(services.EventRoleAssignmentExpired, resolver.HandleEvent)
+
+	claims := &auth.Claims{UserID: user.ID.String(), TenantID: tenantID.String()}
+	resolver.Resolve(claims)
+	user.Roles = nil
+	events.Publish(services.Event{Type: services.EventRoleAssignmentExpired, TenantID: tenantID, UserID: user.ID})
+
+	refreshed, _ := resolver.Resolve(claims)
+	if lookup.lookups != 2 || refreshed.HasPermission("reports:read") {
+		t.Fatalf("Expected the expired role to be dropped, got %d lookups and %v", lookup.lookups, refreshed.Permissions)
+	}
+
+	// A cached user is reloaded when a time-bound role ends, without waiting for the sweeper
+	granted := newAnalystUser(tenantID)
+	validUntil := time.Now().Add(50 * time.Millisecond)
+	granted.RolesChangeAt = &validUntil
+	lookup.users[granted.ID] = granted
+	grantedClaims := &auth.Claims{UserID: granted.ID.String(), TenantID: tenantID.String()}
+	if userCtx, _ := resolver.Resolve(grantedClaims); !userCtx.HasPermission("reports:read") {
+		t.Fatalf("Expected the role to grant until it ends, got %v", userCtx.Permissions)
+	}
+	granted.Roles, granted.RolesChangeAt = nil, nil
+	resolver.Resolve(grantedClaims)
+	if lookup.lookups != 3 {
+		t.Fatalf("Expected the user to be cached until the role ends, got %d lookups", lookup.lookups)
+	}
+	time.Sleep(100 * time.Millisecond)
+	if userCtx, _ := resolver.Resolve(grantedClaims); lookup.lookups != 4 || userCtx.HasPermission("reports:read") {
+		t.Fatalf("Expected the user to be reloaded when the role ends, got %d lookups and %v", lookup.lookups, userCtx.Permissions)
+	}
+
+	t.Log("✓ Time-bound role assignments only grant within their window")
+}
+
+func TestRoleAssignmentModes(t *testing.T) {
+	for _, mode := range []services.RoleAssignmentMode{services.RoleModeAdd, services.RoleModeRemove, services.RoleModeReplace} {
+		if !mode.Valid() {
+			t.Errorf("Expected mode %q to be valid", mode)
+		}
+	}
+
+	// Invalid changes are rejected before anything is written
+	userService := services.NewUserService(nil, uuid.New())
+	userIDs, roleIDs := []uuid.UUID{uuid.New(), uuid.New()}, []uuid.UUID{uuid.New()}
+	if err := userService.BulkAssignRoles(userIDs, "upsert", roleIDs, nil); err != services.ErrInvalidRoleMode {
+		t.Errorf("Expected unknown mode to be rejected, got %v", err)
+	}
+	expired := time.Now().Add(-time.Hour)
+	if err := userService.AssignRoles(userIDs[0], services.RoleModeAdd, roleIDs, &services.RoleWindow{ValidUntil: &expired}); err != services.ErrInvalidRoleWindow {
+		t.Errorf("Expected expired window to be rejected, got %v", err)
+	}
+
+	t.Log("✓ Role assignment modes validated")
+}
+
+// permissionCase is a case of the permission matching suite shared by the services
+type permissionCase struct {
+	Name     string   `json:"name"`
+	Granted  []string `json:"granted"`
+	Required string   `json:"required"`
+	Expected bool     `json:"expected"`
+}
+
+func loadPermissionCases(t *testing.T) []permissionCase {
+	data, err := os.ReadFile("../shared/models/testdata/permission_cases.json")
+	if err != nil {
+		t.Fatalf("Failed to read permission cases: %v", err)
+	}
+
+	var cases []permissionCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		t.Fatalf("Failed to parse permission cases: %v", err)
+	}
+	return cases
+}
+
+func TestWildcardAndImpliedPermissions(t *testing.T) {
+	for _, tc := range loadPermissionCases(t) {
+		userCtx := &types.UserContext{Permissions: tc.Granted}
+		if got := userCtx.HasPermission(tc.Required); got != tc.Expected {
+			t.Errorf("%s: UserContext.HasPermission(%q) with %v = %v, expected %v", tc.Name, tc.Required, tc.Granted, got, tc.Expected)
+		}
+
+		role := models.Role{Name: "custom"}
+		for _, name := range tc.Granted {
+			role.Permissions = append(role.Permissions, models.Permission{Name: name})
+		}
+		tenantUser := &models.TenantUser{Roles: []models.Role{role}}
+		if got := tenantUser.HasPermission(tc.Required); got != tc.Expected {
+			t.Errorf("%s: TenantUser.HasPermission(%q) with %v = %v, expected %v", tc.Name, tc.Required, tc.Granted, got, tc.Expected)
+		}
+	}
+
+	t.Log("✓ Wildcard and implied permissions matched by user contexts and tenant users")
+}
+
+// roleAssignmentDriver serves tenants with one user and one role, which the user
+// already holds permanently
+type roleAssignmentDriver struct {
+	fakeDriver
+	userID, roleID uuid.UUID
+	tenantIDs      []uuid.UUID
+	template       []driver.Value // Row of system.role_templates
+}
+
+func (d *roleAssignmentDriver) query(_ *fakeConn, query string, _ []driver.NamedValue) (driver.Rows, error) {
+	switch {
+	case strings.Contains(query, "count("):
+		return fakeColumn("count", int64(1)), nil
+	case strings.Contains(query, `"tenants"`):
+		rows := fakeColumn("id")
+		for _, tenantID := range d.tenantIDs {
+			rows.values = append(rows.values, []driver.Value{tenantID.String()})
+		}
+		return rows, nil
+	case strings.Contains(query, "role_templates") && d.template != nil:
+		return &fakeRows{columns: []string{"id", "name", "version", "permissions"}, values: [][]driver.Value{d.template}}, nil
+	case strings.Contains(query, `FROM "user_roles"`):
+		return &fakeRows{
+			columns: []string{"user_id", "role_id", "assigned_at"},
+			values:  [][]driver.Value{{d.userID.String(), d.roleID.String(), time.Now()}},
+		}, nil
+	}
+	return nil, nil
+}
+
+func TestRoleAssignmentRequiresRolesManage(t *testing.T) {
+	tenantID := uuid.New()
+	analyst := newAnalystUser(tenantID)
+	admin := newAnalystUser(tenantID)
+	admin.ID = uuid.New()
+	admin.Roles[0].Permissions = append(admin.Roles[0].Permissions, models.Permission{ID: uuid.New(), Name: "roles:manage", Resource: "roles", Action: "manage"})
+	lookup := &fakeUserLookup{users: map[uuid.UUID]*models.TenantUser{analyst.ID: analyst, admin.ID: admin}}
+
+	fake := &roleAssignmentDriver{userID: analyst.ID, roleID: uuid.New()}
+	db := openFakeDB(t, fake)
+	userHandler := handlers.NewUserHandler(func(string) *services.UserService {
+		return services.NewUserService(db, tenantID)
+	})
+
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("tenant", &types.TenantContext{ID: types.TenantID(tenantID.String()), Status: "ACTIVE"})
+		return c.Next()
+	})
+	app.Use(middleware.AuthMiddleware(tokenManager, middleware.NewUserResolver(lookup, time.Minute)))
+	app.Post("/users/roles/bulk", userHandler.BulkAssignRoles)
+	app.Post("/users/:id/roles", userHandler.AssignRoles)
+
+	assign := func(userID uuid.UUID, path string, payload fiber.Map) int {
+		token, _ := tokenManager.GenerateToken(userID.String(), tenantID.String(), "tenant_admin")
+		body, _ := json.Marshal(payload)
+		req, _ := http.NewRequest("POST", path, bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		return resp.StatusCode
+	}
+
+	// Users without roles:manage cannot change anybody's roles, their own included
+	grant := fiber.Map{"mode": "replace", "role_ids": []uuid.UUID{fake.roleID}}
+	if status := assign(analyst.ID, "/users/"+analyst.ID.String()+"/roles", grant); status != 403 {
+		t.Fatalf("Expected status 403 assigning roles, got %d", status)
+	}
+	if status := assign(analyst.ID, "/users/roles/bulk", fiber.Map{"mode": "add", "role_ids": []uuid.UUID{fake.roleID}, "user_ids": []uuid.UUID{analyst.ID}}); status != 403 {
+		t.Fatalf("Expected status 403 assigning roles in bulk, got %d", status)
+	}
+	if len(fake.statements) != 0 {
+		t.Fatalf("Expected nothing to be written, got %v", fake.statements)
+	}
+
+	// Adding a role for a period keeps the permanent assignment of the same role
+	window := fiber.Map{"mode": "add", "role_ids": []uuid.UUID{fake.roleID}, "valid_until": time.Now().Add(time.Hour)}
+	if status := assign(admin.ID, "/users/"+analyst.ID.String()+"/roles", window); status != 200 {
+		t.Fatalf("Expected status 200 assigning roles, got %d", status)
+	}
+	if fake.index(`INSERT INTO "user_roles"`, 0) >= 0 {
+		t.Fatalf("Expected the permanent assignment to be kept, got:\n%s", strings.Join(fake.statements, "\n"))
+	}
+	if index := fake.index(`DELETE FROM "user_roles"`, 0); index < 0 || !strings.Contains(fake.statements[index], "valid_from IS NOT NULL OR valid_until IS NOT NULL") {
+		t.Fatalf("Expected only time-bound assignments to be replaced, got:\n%s", strings.Join(fake.statements, "\n"))
+	}
+
+	t.Log("✓ Role assignments require roles:manage and keep permanent grants")
+}
+
+func TestGraphQLRoleAssignmentRequiresRolesManage(t *testing.T) {
+	tenantID := uuid.New()
+	analyst := newAnalystUser(tenantID)
+	manager := newAnalystUser(tenantID)
+	manager.ID = uuid.New()
+	manager.Roles[0].Name = "people_ops"
+	manager.Roles[0].Permissions = append(manager.Roles[0].Permissions, models.Permission{ID: uuid.New(), Name: "roles:manage", Resource: "roles", Action: "manage"})
+	lookup := &fakeUserLookup{users: map[uuid.UUID]*models.TenantUser{analyst.ID: analyst, manager.ID: manager}}
+
+	fake := &roleAssignmentDriver{userID: analyst.ID, roleID: uuid.New()}
+	db := openFakeDB(t, fake)
+	gqlResolver := resolver.NewResolver()
+	gqlResolver.SetDatabase(db)
+	gqlServer := handler.NewDefaultServer(generated.NewExecutableSchema(generated.Config{Resolvers: gqlResolver}))
+
+	tokenManager := auth.NewTokenManager("test-secret", "zplus-saas")
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("tenant", &types.TenantContext{ID: types.TenantID(tenantID.String()), Status: "ACTIVE"})
+		return c.Next()
+	})
+	app.Use(middleware.AuthMiddleware(tokenManager, middleware.NewUserResolver(lookup, time.Minute)))
+	app.Use(middleware.GraphQLContextMiddleware())
+	app.All("/graphql", graphQLHandler(gqlServer))
+
+	assign := func(userID uuid.UUID, mutation string) *graphQLResponse {
+		token, _ := tokenManager.GenerateToken(userID.String(), tenantID.String(), "user")
+		body, _ := json.Marshal(map[string]string{"query": "mutation { " + mutation + " }"})
+		req, _ := http.NewRequest("POST", "/graphql", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		var result graphQLResponse
+		json.NewDecoder(resp.Body).Decode(&result)
+		return &result
+	}
+
+	mutations := []string{
+		fmt.Sprintf(`assignRole(userId: "%s", roleId: "%s") { id }`, analyst.ID, fake.roleID),
+		fmt.Sprintf(`removeRole(userId: "%s", roleId: "%s") { id }`, analyst.ID, fake.roleID),
+		fmt.Sprintf(`assignRoles(input: {userIds: ["%s"], roleIds: ["%s"], mode: ADD}) { id }`, analyst.ID, fake.roleID),
+	}
+
+	// Users without roles:manage are refused, as on the REST endpoints
+	for _, mutation := range mutations {
+		if result := assign(analyst.ID, mutation); len(result.Errors) == 0 || result.Errors[0].Message != resolver.ErrForbidden.Error() {
+			t.Errorf("Expected %s to be forbidden, got %v", mutation, result.Errors)
+		}
+	}
+	if len(fake.statements) != 0 {
+		t.Fatalf("Expected nothing to be written, got %v", fake.statements)
+	}
+
+	// A custom role holding roles:manage is enough, no admin role name is required
+	for _, mutation := range mutations {
+		if result := assign(manager.ID, mutation); len(result.Errors) != 0 && result.Errors[0].Message == resolver.ErrForbidden.Error() {
+			t.Errorf("Expected %s to be allowed with roles:manage, got %v", mutation, result.Errors)
+		}
+	}
+	if fake.index(`DELETE FROM "user_roles"`, 0) < 0 {
+		t.Fatalf("Expected the role assignments to be written, got:\n%s", strings.Join(fake.statements, "\n"))
+	}
+
+	t.Log("✓ GraphQL role assignment requires roles:manage like the REST endpoints")
+}
+
+func TestRoleAssignmentSweepScopedToTenant(t *testing.T) {
+	// Both tenants see the assignment, as tenants with shared isolation share the table
+	fake := &roleAssignmentDriver{userID: uuid.New(), roleID: uuid.New(), tenantIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+	db := openFakeDB(t, fake)
+	sweeper := services.NewRoleAssignmentSweeper(db)
+	events := services.NewEventBus()
+	sweeper.SetEventBus(events)
+	var expired []services.Event
+	events.Subscribe(services.EventRoleAssignmentExpired, func(event services.Event) {
+		expired = append(expired, event)
+	})
+
+	if _, err := sweeper.Sweep(time.Now()); err != nil {
+		t.Fatalf("Sweep failed: %v", err)
+	}
+
+	// Every statement on the assignments is limited to the users of the tenant swept
+	swept := 0
+	for i, statement := range fake.statements {
+		if !strings.Contains(statement, `"user_roles"`) {
+			continue
+		}
+		swept++
+		if !strings.Contains(statement, "user_id IN (SELECT id FROM users WHERE tenant_id = $1)") {
+			t.Fatalf("Expected the assignments to be filtered by tenant, got %s", statement)
+		}
+		if tenantID := fmt.Sprint(fake.args[i][0].Value); tenantID != fake.tenantIDs[0].String() && tenantID != fake.tenantIDs[1].String() {
+			t.Fatalf("Expected a tenant ID as filter, got %s", tenantID)
+		}
+	}
+	if swept != 4 || len(expired) != 2 || expired[0].TenantID != fake.tenantIDs[0] || expired[1].TenantID != fake.tenantIDs[1] {
+		t.Fatalf("Expected each tenant to be swept on its own, got %d statements and events %+v", swept, expired)
+	}
+
+	t.Log("✓ Expired role assignments are swept per tenant")
+}
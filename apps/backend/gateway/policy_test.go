@@ -0,0 +1,208 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// salesPolicies limits sales reps to their own customers and managers to their department's employees
+func salesPolicies() []models.AccessPolicy {
+	return []models.AccessPolicy{
+		{
+			Name:       "Own customers",
+			Resource:   "customers",
+			Action:     "*",
+			Roles:      []string{"sales_rep"},
+			Conditions: []models.PolicyCondition{{Type: models.PolicyConditionOwner, Field: "created_by"}},
+		},
+		{
+			Name:       "Department employees",
+			Resource:   "employees",
+			Action:     "read",
+			Roles:      []string{"manager"},
+			Conditions: []models.PolicyCondition{{Type: models.PolicyConditionAttribute, Field: "department_id", Attribute: "department_id"}},
+		},
+		{
+			Name:       "Partner customers",
+			Resource:   "customers",
+			Action:     "read",
+			Roles:      []string{"partner_manager"},
+			Conditions: []models.PolicyCondition{{Type: models.PolicyConditionTag, Field: "tags", Value: "partner"}},
+		},
+	}
+}
+
+func TestAccessPoliciesAuthorizeRecords(t *testing.T) {
+	policies := salesPolicies()
+	for _, policy := range policies {
+		if err := policy.Validate(); err != nil {
+			t.Fatalf("Policy %s should be valid: %v", policy.Name, err)
+		}
+	}
+
+	rep := &types.UserContext{ID: uuid.New().String(), Roles: []string{"sales_rep"}}
+	otherRep := uuid.New()
+	repID := uuid.MustParse(rep.ID)
+	own := &models.Customer{Name: "Own", CreatedBy: &repID}
+	foreign := &models.Customer{Name: "Foreign", CreatedBy: &otherRep, Tags: []string{"partner"}}
+
+	if !models.PoliciesAllow(policies, rep.PolicySubject(), "customers", "read", own) {
+		t.Error("Sales rep should read customers they created")
+	}
+	if models.PoliciesAllow(policies, rep.PolicySubject(), "customers", "write", foreign) {
+		t.Error("Sales rep should not update customers created by others")
+	}
+
+	partnerManager := &types.UserContext{ID: uuid.New().String(), Roles: []string{"partner_manager"}}
+	if !models.PoliciesAllow(policies, partnerManager.PolicySubject(), "customers", "read", foreign) ||
+		models.PoliciesAllow(policies, partnerManager.PolicySubject(), "customers", "read", own) {
+		t.Error("Partner manager should only read customers tagged partner")
+	}
+
+	// Roles without policies are only limited by their permissions
+	tenantAdmin := &types.UserContext{ID: uuid.New().String(), Roles: []string{"tenant_admin"}}
+	if !models.PoliciesAllow(policies, tenantAdmin.PolicySubject(), "customers", "read", foreign) {
+		t.Error("Roles without policies should not be restricted")
+	}
+	systemAdmin := &types.UserContext{ID: uuid.New().String(), Roles: []string{"sales_rep"}, IsAdmin: true}
+	if !models.PoliciesAllow(policies, systemAdmin.PolicySubject(), "customers", "read", foreign) {
+		t.Error("Administrators should bypass policies")
+	}
+
+	sales, engineering := uuid.New(), uuid.New()
+	manager := &types.UserContext{ID: uuid.New().String(), Roles: []string{"manager"}, Attributes: map[string]string{"department_id": sales.String()}}
+	if !models.PoliciesAllow(policies, manager.PolicySubject(), "employees", "read", &models.Employee{DepartmentID: &sales}) {
+		t.Error("Manager should read employees of their department")
+	}
+	if models.PoliciesAllow(policies, manager.PolicySubject(), "employees", "read", &models.Employee{DepartmentID: &engineering}) {
+		t.Error("Manager should not read employees of other departments")
+	}
+	if models.PoliciesAllow(policies, manager.PolicySubject(), "employees", "read", &models.Employee{}) {
+		t.Error("Manager should not read employees without a department")
+	}
+
+	// Without a department of their own managers see no employees
+	unassigned := &types.UserContext{ID: uuid.New().String(), Roles: []string{"manager"}}
+	if models.PoliciesAllow(policies, unassigned.PolicySubject(), "employees", "read", &models.Employee{DepartmentID: &sales}) {
+		t.Error("Manager without a department should not read employees")
+	}
+
+	t.Log("✓ Access policies authorize single records")
+}
+
+func TestAccessPoliciesScopeLists(t *testing.T) {
+	db, err := gorm.Open(postgres.New(postgres.Config{DSN: "host=localhost"}), &gorm.Config{
+		DryRun:                 true,
+		DisableAutomaticPing:   true,
+		SkipDefaultTransaction: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed to open dry-run database: %v", err)
+	}
+
+	listSQL := func(user *types.UserContext, resource string, model interface{}) (string, []interface{}) {
+		query := services.ScopeQuery(db.Model(model), salesPolicies(), user.PolicySubject(), resource, "read")
+		statement := query.Find(model).Statement
+		return statement.SQL.String(), statement.Vars
+	}
+
+	rep := &types.UserContext{ID: uuid.New().String(), Roles: []string{"sales_rep", "partner_manager"}}
+	sql, vars := listSQL(rep, "customers", &[]models.Customer{})
+	if !strings.Contains(sql, "(created_by = $1) OR (tags @> $2)") {
+		t.Fatalf("Expected customers scoped to owner or partner tag, got %s", sql)
+	}
+	if vars[0] != rep.ID || vars[1] != `["partner"]` {
+		t.Fatalf("Unexpected scope arguments: %v", vars)
+	}
+
+	department := uuid.New().String()
+	manager := &types.UserContext{ID: uuid.New().String(), Roles: []string{"manager"}, Attributes: map[string]string{"department_id": department}}
+	sql, vars = listSQL(manager, "employees", &[]models.Employee{})
+	if !strings.Contains(sql, "(department_id = $1)") || vars[0] != department {
+		t.Fatalf("Expected employees scoped to the manager's department, got %s %v", sql, vars)
+	}
+
+	unassigned := &types.UserContext{ID: uuid.New().String(), Roles: []string{"manager"}}
+	if sql, _ = listSQL(unassigned, "employees", &[]models.Employee{}); !strings.Contains(sql, "1 = 0") {
+		t.Fatalf("Expected no employees for a manager without a department, got %s", sql)
+	}
+
+	tenantAdmin := &types.UserContext{ID: uuid.New().String(), Roles: []string{"tenant_admin"}}
+	if sql, _ = listSQL(tenantAdmin, "customers", &[]models.Customer{}); strings.Contains(sql, "created_by") || strings.Contains(sql, "tags") {
+		t.Fatalf("Expected unrestricted customers for roles without policies, got %s", sql)
+	}
+
+	t.Log("✓ Access policies scope list queries")
+}
+
+func TestAccessPolicyValidation(t *testing.T) {
+	invalid := []models.AccessPolicy{
+		{Name: "No conditions", Resource: "customers", Action: "read"},
+		{Name: "Bad field", Resource: "customers", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionOwner, Field: "created_by; DROP TABLE customers"}}},
+		{Name: "Unknown type", Resource: "customers", Action: "read", Conditions: []models.PolicyCondition{{Type: "region", Field: "region"}}},
+		{Name: "Attribute missing", Resource: "employees", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionAttribute, Field: "department_id"}}},
+		{Name: "Partial wildcard", Resource: "cust*", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionOwner, Field: "created_by"}}},
+		{Name: "Unknown column", Resource: "customers", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionOwner, Field: "owner_id"}}},
+		{Name: "Column of another resource", Resource: "customers", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionAttribute, Field: "department_id", Attribute: "department_id"}}},
+		{Name: "Column missing on some resources", Resource: "*", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionOwner, Field: "created_by"}}},
+		{Name: "Unsupported resource", Resource: "orders", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionOwner, Field: "created_by"}}},
+		{Name: "Tag on a single value", Resource: "customers", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionTag, Field: "status", Value: "vip"}}},
+		{Name: "Owner of a tag list", Resource: "customers", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionOwner, Field: "tags"}}},
+	}
+	for _, policy := range invalid {
+		if err := policy.Validate(); err == nil {
+			t.Errorf("Policy %q should be invalid", policy.Name)
+		}
+	}
+
+	valid := models.AccessPolicy{Name: "Active only", Resource: "*", Action: "read", Conditions: []models.PolicyCondition{{Type: models.PolicyConditionAttribute, Field: "status", Attribute: "status"}}}
+	if err := valid.Validate(); err != nil {
+		t.Errorf("Expected a column of every resource to be valid on all of them, got %v", err)
+	}
+
+	t.Log("✓ Invalid access policies rejected")
+}
+
+func TestPolicyErrorsHideDatabaseDetails(t *testing.T) {
+	tenantID := uuid.New()
+	db := openFakeDB(t, &sagaDriver{fakeDriver: fakeDriver{failOn: "access_policies"}})
+	policyHandler := handlers.NewPolicyHandler(func(string) *services.PolicyService {
+		return services.NewPolicyService(db, tenantID)
+	})
+
+	app := fiber.New()
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("tenant", &types.TenantContext{ID: types.TenantID(tenantID.String()), Status: "ACTIVE"})
+		c.Locals("user", &types.UserContext{ID: uuid.New().String(), TenantID: types.TenantID(tenantID.String()), Roles: []string{"tenant_admin"}})
+		return c.Next()
+	})
+	app.Get("/policies", policyHandler.GetPolicies)
+
+	req, _ := http.NewRequest("GET", "/policies", nil)
+	resp, err := app.Test(req, 5000)
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	var body map[string]interface{}
+	json.NewDecoder(resp.Body).Decode(&body)
+	if resp.StatusCode != 500 || body["error"] != "Failed to retrieve policies" {
+		t.Fatalf("Expected a 500 naming the failed operation, got %d %v", resp.StatusCode, body)
+	}
+	encoded, _ := json.Marshal(body)
+	if strings.Contains(string(encoded), "access_policies") || strings.Contains(string(encoded), "connection reset") {
+		t.Fatalf("Expected the database error to stay out of the response, got %s", encoded)
+	}
+
+	t.Log("✓ Database errors are not returned to clients")
+}
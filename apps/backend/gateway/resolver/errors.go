@@ -4,11 +4,15 @@ import "errors"
 
 // Common GraphQL errors for multi-tenant operations
 var (
-	ErrUnauthenticated = errors.New("authentication required")
-	ErrForbidden       = errors.New("access forbidden")
-	ErrNotFound        = errors.New("resource not found")
-	ErrInvalidInput    = errors.New("invalid input")
-	ErrTenantMismatch  = errors.New("tenant mismatch")
-	ErrInactiveTenant  = errors.New("tenant is not active")
-	ErrFeatureDisabled = errors.New("feature not enabled for this tenant")
-)
\ No newline at end of file
+	ErrUnauthenticated        = errors.New("authentication required")
+	ErrForbidden              = errors.New("access forbidden")
+	ErrNotFound               = errors.New("resource not found")
+	ErrInvalidInput           = errors.New("invalid input")
+	ErrTenantMismatch         = errors.New("tenant mismatch")
+	ErrInactiveTenant         = errors.New("tenant is not active")
+	ErrFeatureDisabled        = errors.New("feature not enabled for this tenant")
+	ErrSessionsUnavailable    = errors.New("session management is not available")
+	ErrAuthServiceUnavailable = errors.New("auth service is not available")
+	ErrImpersonationDenied    = errors.New("not available while impersonating a user")
+	ErrInternal               = errors.New("internal error")
+)
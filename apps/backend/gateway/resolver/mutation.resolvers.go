@@ -7,8 +7,12 @@ package resolver
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/generated"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
 )
 
 // Login is the resolver for the login field.
@@ -26,6 +30,129 @@ func (r *mutationResolver) RefreshToken(ctx context.Context, token string) (*gen
 	panic(fmt.Errorf("not implemented: RefreshToken - refreshToken"))
 }
 
+// ForgotPassword is the resolver for the forgotPassword field.
+func (r *mutationResolver) ForgotPassword(ctx context.Context, input generated.ForgotPasswordInput) (bool, error) {
+	if r.authClient == nil {
+		return false, ErrAuthServiceUnavailable
+	}
+	
+	// The auth service answers the same way whether or not the account exists
+	if err := r.authClient.ForgotPassword(input.Email, input.TenantSlug); err != nil {
+		return false, err
+	}
+	
+	return true, nil
+}
+
+// ResetPassword is the resolver for the resetPassword field.
+func (r *mutationResolver) ResetPassword(ctx context.Context, input generated.ResetPasswordInput) (bool, error) {
+	if r.authClient == nil {
+		return false, ErrAuthServiceUnavailable
+	}
+	
+	if err := r.authClient.ResetPassword(input.Token, input.Password); err != nil {
+		return false, err
+	}
+	
+	return true, nil
+}
+
+// RevokeSession is the resolver for the revokeSession field.
+func (r *mutationResolver) RevokeSession(ctx context.Context, id string) (bool, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requireSessions(reqCtx); err != nil {
+		return false, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return false, err
+	}
+	
+	// Only sessions of the current user can be found
+	session, err := r.tokenManager.GetUserSession(reqCtx.User.ID, id)
+	if err == auth.ErrSessionNotFound {
+		return false, ErrNotFound
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get session: %v", err)
+	}
+	
+	if err := r.tokenManager.RevokeSession(session); err != nil {
+		return false, fmt.Errorf("failed to revoke session: %v", err)
+	}
+	
+	return true, nil
+}
+
+// RevokeOtherSessions is the resolver for the revokeOtherSessions field.
+func (r *mutationResolver) RevokeOtherSessions(ctx context.Context) (int, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requireSessions(reqCtx); err != nil {
+		return 0, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return 0, err
+	}
+	
+	sessions, err := r.tokenManager.GetUserSessions(reqCtx.User.ID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sessions: %v", err)
+	}
+	
+	others := make([]*auth.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session.TokenID != reqCtx.User.TokenID {
+			others = append(others, session)
+		}
+	}
+	
+	revoked, err := r.tokenManager.RevokeSessions(others)
+	if err != nil {
+		return revoked, fmt.Errorf("failed to revoke sessions: %v", err)
+	}
+	
+	return revoked, nil
+}
+
+// ForceLogoutUser is the resolver for the forceLogoutUser field.
+func (r *mutationResolver) ForceLogoutUser(ctx context.Context, userID string) (int, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requireSessions(reqCtx); err != nil {
+		return 0, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return 0, err
+	}
+	
+	// System admins may log out anyone, tenant admins only users of their tenant
+	if !reqCtx.IsSystemAdmin() {
+		if err := r.requireTenantAdmin(reqCtx); err != nil {
+			return 0, err
+		}
+	}
+	
+	sessions, err := r.tokenManager.GetUserSessions(userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list sessions: %v", err)
+	}
+	
+	targets := make([]*auth.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if reqCtx.IsSystemAdmin() || session.TenantID == reqCtx.User.TenantID.String() {
+			targets = append(targets, session)
+		}
+	}
+	
+	revoked, err := r.tokenManager.RevokeSessions(targets)
+	if err != nil {
+		return revoked, fmt.Errorf("failed to revoke sessions: %v", err)
+	}
+	
+	return revoked, nil
+}
+
 // CreateUser is the resolver for the createUser field.
 func (r *mutationResolver) CreateUser(ctx context.Context, input generated.CreateUserInput) (*generated.User, error) {
 	panic(fmt.Errorf("not implemented: CreateUser - createUser"))
@@ -42,68 +169,127 @@ func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (bool, err
 }
 
 // AssignRole is the resolver for the assignRole field.
-func (r *mutationResolver) AssignRole(ctx context.Context, userID string, roleID string) (*generated.User, error) {
+func (r *mutationResolver) AssignRole(ctx context.Context, userID string, roleID string, validFrom *string, validUntil *string) (*generated.User, error) {
 	reqCtx := getRequestContext(ctx)
 	
-	// Require tenant admin permission to assign roles
-	if err := r.requireTenantAdmin(reqCtx); err != nil {
+	// Role assignment requires roles:manage, like the REST endpoints
+	if err := r.requirePermission(reqCtx, "roles", "manage"); err != nil {
+		return nil, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
 		return nil, err
 	}
 	
-	// Mock implementation - return user with assigned role
-	user := &generated.User{
-		ID:        userID,
-		TenantID:  reqCtx.Tenant.ID,
-		Email:     "mock@example.com",
-		FirstName: "Mock",
-		LastName:  "User",
-		Avatar:    nil,
-		Roles: []*generated.Role{
-			{
-				ID:          roleID,
-				TenantID:    reqCtx.Tenant.ID,
-				Name:        "assigned_role",
-				Description: stringPtr("Assigned role"),
-				Permissions: []*generated.Permission{},
-				Users:       []*generated.User{},
-				CreatedAt:   "2024-01-01T00:00:00Z",
-				UpdatedAt:   "2024-01-01T00:00:00Z",
-			},
-		},
-		Status:      "ACTIVE",
-		LastLoginAt: nil,
-		CreatedAt:   "2024-01-01T00:00:00Z",
-		UpdatedAt:   "2024-01-01T00:00:00Z",
+	userService := r.GetUserService(string(reqCtx.Tenant.ID))
+	if userService == nil {
+		return nil, fmt.Errorf("user service not available")
 	}
 	
-	return user, nil
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	roleUUID, err := uuid.Parse(roleID)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	window, err := roleWindow(validFrom, validUntil)
+	if err != nil {
+		return nil, err
+	}
+	
+	if err := userService.AssignRole(userUUID, roleUUID, window); err != nil {
+		return nil, roleAssignmentError(err)
+	}
+	
+	user, err := userService.GetUser(userUUID)
+	if err != nil {
+		return nil, recordError(err)
+	}
+	return convertTenantUser(user), nil
 }
 
 // RemoveRole is the resolver for the removeRole field.
 func (r *mutationResolver) RemoveRole(ctx context.Context, userID string, roleID string) (*generated.User, error) {
 	reqCtx := getRequestContext(ctx)
 	
-	// Require tenant admin permission to remove roles
-	if err := r.requireTenantAdmin(reqCtx); err != nil {
+	// Role assignment requires roles:manage, like the REST endpoints
+	if err := r.requirePermission(reqCtx, "roles", "manage"); err != nil {
+		return nil, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
 		return nil, err
 	}
 	
-	// Mock implementation - return user without the removed role
-	user := &generated.User{
-		ID:          userID,
-		TenantID:    reqCtx.Tenant.ID,
-		Email:       "mock@example.com",
-		FirstName:   "Mock",
-		LastName:    "User",
-		Avatar:      nil,
-		Roles:       []*generated.Role{}, // Empty after removal
-		Status:      "ACTIVE",
-		LastLoginAt: nil,
-		CreatedAt:   "2024-01-01T00:00:00Z",
-		UpdatedAt:   "2024-01-01T00:00:00Z",
+	userService := r.GetUserService(string(reqCtx.Tenant.ID))
+	if userService == nil {
+		return nil, fmt.Errorf("user service not available")
+	}
+	
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	roleUUID, err := uuid.Parse(roleID)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	
+	if err := userService.RemoveRoles(userUUID, []uuid.UUID{roleUUID}); err != nil {
+		return nil, roleAssignmentError(err)
+	}
+	
+	user, err := userService.GetUser(userUUID)
+	if err != nil {
+		return nil, recordError(err)
+	}
+	return convertTenantUser(user), nil
+}
+
+// AssignRoles is the resolver for the assignRoles field.
+func (r *mutationResolver) AssignRoles(ctx context.Context, input generated.AssignRolesInput) ([]*generated.User, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	// Role assignment requires roles:manage, like the REST endpoints
+	if err := r.requirePermission(reqCtx, "roles", "manage"); err != nil {
+		return nil, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return nil, err
+	}
+	
+	userService := r.GetUserService(string(reqCtx.Tenant.ID))
+	if userService == nil {
+		return nil, fmt.Errorf("user service not available")
+	}
+	
+	userIDs, err := parseIDs(input.UserIds)
+	if err != nil {
+		return nil, err
+	}
+	roleIDs, err := parseIDs(input.RoleIds)
+	if err != nil {
+		return nil, err
+	}
+	window, err := roleWindow(input.ValidFrom, input.ValidUntil)
+	if err != nil {
+		return nil, err
 	}
 	
-	return user, nil
+	mode := services.RoleAssignmentMode(strings.ToLower(string(input.Mode)))
+	if err := userService.BulkAssignRoles(userIDs, mode, roleIDs, window); err != nil {
+		return nil, roleAssignmentError(err)
+	}
+	
+	users := make([]*generated.User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		user, err := userService.GetUser(userID)
+		if err != nil {
+			return nil, recordError(err)
+		}
+		users = append(users, convertTenantUser(user))
+	}
+	return users, nil
 }
 
 // CreateRole is the resolver for the createRole field.
@@ -261,6 +447,183 @@ func (r *mutationResolver) RemovePermission(ctx context.Context, roleID string,
 	return role, nil
 }
 
+// CreateServiceAccount is the resolver for the createServiceAccount field.
+func (r *mutationResolver) CreateServiceAccount(ctx context.Context, input generated.CreateServiceAccountInput) (*generated.APIKeyPayload, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	// Require tenant admin permission to manage service accounts
+	if err := r.requireTenantAdmin(reqCtx); err != nil {
+		return nil, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return nil, err
+	}
+	
+	// Admins can only delegate permissions they hold themselves
+	for _, permission := range input.Permissions {
+		if !reqCtx.User.HasPermission(permission) {
+			return nil, ErrForbidden
+		}
+	}
+	
+	accountService := r.GetServiceAccountService(string(reqCtx.Tenant.ID))
+	if accountService == nil {
+		return nil, fmt.Errorf("service account service not available")
+	}
+	
+	expiresAt, err := parseDateTime(input.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	createInput := services.CreateServiceAccountInput{
+		Name:        input.Name,
+		Description: input.Description,
+		Permissions: input.Permissions,
+		AllowedIPs:  input.AllowedIps,
+		ExpiresAt:   expiresAt,
+	}
+	if creatorID, err := uuid.Parse(reqCtx.User.ID); err == nil {
+		createInput.CreatedBy = &creatorID
+	}
+	
+	account, key, err := accountService.CreateServiceAccount(createInput)
+	if err != nil {
+		return nil, serviceAccountError(err)
+	}
+	
+	return &generated.APIKeyPayload{
+		ServiceAccount: convertServiceAccount(account),
+		APIKey:         convertAPIKey(&account.Keys[0]),
+		Key:            key,
+	}, nil
+}
+
+// DeleteServiceAccount is the resolver for the deleteServiceAccount field.
+func (r *mutationResolver) DeleteServiceAccount(ctx context.Context, id string) (bool, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	// Require tenant admin permission to manage service accounts
+	if err := r.requireTenantAdmin(reqCtx); err != nil {
+		return false, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return false, err
+	}
+	
+	accountService := r.GetServiceAccountService(string(reqCtx.Tenant.ID))
+	if accountService == nil {
+		return false, fmt.Errorf("service account service not available")
+	}
+	
+	accountID, err := uuid.Parse(id)
+	if err != nil {
+		return false, ErrInvalidInput
+	}
+	
+	if err := accountService.DeleteServiceAccount(accountID); err != nil {
+		return false, serviceAccountError(err)
+	}
+	
+	return true, nil
+}
+
+// CreateAPIKey is the resolver for the createAPIKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, serviceAccountID string, expiresAt *string) (*generated.APIKeyPayload, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	// Require tenant admin permission to manage service accounts
+	if err := r.requireTenantAdmin(reqCtx); err != nil {
+		return nil, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return nil, err
+	}
+	
+	accountService := r.GetServiceAccountService(string(reqCtx.Tenant.ID))
+	if accountService == nil {
+		return nil, fmt.Errorf("service account service not available")
+	}
+	
+	accountID, err := uuid.Parse(serviceAccountID)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	expiry, err := parseDateTime(expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	
+	apiKey, key, err := accountService.CreateKey(accountID, expiry)
+	if err != nil {
+		return nil, serviceAccountError(err)
+	}
+	
+	return apiKeyPayload(accountService, accountID, apiKey, key)
+}
+
+// RotateAPIKey is the resolver for the rotateAPIKey field.
+func (r *mutationResolver) RotateAPIKey(ctx context.Context, serviceAccountID string, keyID string, expiresAt *string) (*generated.APIKeyPayload, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	// Require tenant admin permission to manage service accounts
+	if err := r.requireTenantAdmin(reqCtx); err != nil {
+		return nil, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return nil, err
+	}
+	
+	accountService := r.GetServiceAccountService(string(reqCtx.Tenant.ID))
+	if accountService == nil {
+		return nil, fmt.Errorf("service account service not available")
+	}
+	
+	ids, err := parseIDs([]string{serviceAccountID, keyID})
+	if err != nil {
+		return nil, err
+	}
+	expiry, err := parseDateTime(expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	
+	apiKey, key, err := accountService.RotateKey(ids[0], ids[1], expiry)
+	if err != nil {
+		return nil, serviceAccountError(err)
+	}
+	
+	return apiKeyPayload(accountService, ids[0], apiKey, key)
+}
+
+// RevokeAPIKey is the resolver for the revokeAPIKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, serviceAccountID string, keyID string) (bool, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	// Require tenant admin permission to manage service accounts
+	if err := r.requireTenantAdmin(reqCtx); err != nil {
+		return false, err
+	}
+	if err := r.requireNotImpersonated(reqCtx); err != nil {
+		return false, err
+	}
+	
+	accountService := r.GetServiceAccountService(string(reqCtx.Tenant.ID))
+	if accountService == nil {
+		return false, fmt.Errorf("service account service not available")
+	}
+	
+	ids, err := parseIDs([]string{serviceAccountID, keyID})
+	if err != nil {
+		return false, err
+	}
+	
+	if err := accountService.RevokeKey(ids[0], ids[1]); err != nil {
+		return false, serviceAccountError(err)
+	}
+	
+	return true, nil
+}
+
 // CreateCustomer is the resolver for the createCustomer field.
 func (r *mutationResolver) CreateCustomer(ctx context.Context, input generated.CreateCustomerInput) (*generated.Customer, error) {
 	panic(fmt.Errorf("not implemented: CreateCustomer - createCustomer"))
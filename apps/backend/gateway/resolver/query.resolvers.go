@@ -7,9 +7,12 @@ package resolver
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/google/uuid"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/generated"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
 )
 
 // SystemInfo is the resolver for the systemInfo field.
@@ -67,6 +70,27 @@ func (r *queryResolver) Me(ctx context.Context) (*generated.User, error) {
 	}, nil
 }
 
+// MySessions is the resolver for the mySessions field.
+func (r *queryResolver) MySessions(ctx context.Context) ([]*generated.Session, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requireSessions(reqCtx); err != nil {
+		return nil, err
+	}
+	
+	sessions, err := r.tokenManager.GetUserSessions(reqCtx.User.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %v", err)
+	}
+	
+	result := make([]*generated.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, convertSession(session, reqCtx.User.TokenID))
+	}
+	
+	return result, nil
+}
+
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, filter *generated.UserFilter, pagination *generated.Pagination) (*generated.UserConnection, error) {
 	panic(fmt.Errorf("not implemented: Users - users"))
@@ -237,24 +261,189 @@ func (r *queryResolver) Permissions(ctx context.Context) ([]*generated.Permissio
 	return permissions, nil
 }
 
+// ServiceAccounts is the resolver for the serviceAccounts field.
+func (r *queryResolver) ServiceAccounts(ctx context.Context) ([]*generated.ServiceAccount, error) {
+	reqCtx := getRequestContext(ctx)
+	
+	// Require tenant admin permission to view service accounts
+	if err := r.requireTenantAdmin(reqCtx); err != nil {
+		return nil, err
+	}
+	
+	accountService := r.GetServiceAccountService(string(reqCtx.Tenant.ID))
+	if accountService == nil {
+		return nil, fmt.Errorf("service account service not available")
+	}
+	
+	accounts, err := accountService.ListServiceAccounts()
+	if err != nil {
+		return nil, err
+	}
+	
+	result := make([]*generated.ServiceAccount, 0, len(accounts))
+	for i := range accounts {
+		result = append(result, convertServiceAccount(&accounts[i]))
+	}
+	return result, nil
+}
+
 // Customers is the resolver for the customers field.
 func (r *queryResolver) Customers(ctx context.Context, filter *generated.CustomerFilter, pagination *generated.Pagination) (*generated.CustomerConnection, error) {
-	panic(fmt.Errorf("not implemented: Customers - customers"))
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requirePermission(reqCtx, "customers", "read"); err != nil {
+		return nil, err
+	}
+	
+	customerService := r.GetCustomerService(string(reqCtx.Tenant.ID))
+	if customerService == nil {
+		return nil, fmt.Errorf("customer service not available")
+	}
+	
+	offset, limit, err := pageWindow(pagination)
+	if err != nil {
+		return nil, err
+	}
+	
+	var customerFilter services.CustomerFilter
+	if filter != nil {
+		if filter.Status != nil {
+			customerFilter.Status = strings.ToLower(string(*filter.Status))
+		}
+		if filter.Search != nil {
+			customerFilter.Search = *filter.Search
+		}
+		customerFilter.Tags = filter.Tags
+	}
+	
+	// Access policies restrict the customers listed
+	customers, total, err := customerService.ListCustomers(reqCtx.User.PolicySubject(), customerFilter, offset, limit)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	
+	edges := make([]*generated.CustomerEdge, 0, len(customers))
+	for i, customer := range customers {
+		edges = append(edges, &generated.CustomerEdge{
+			Node:   convertCustomer(customer),
+			Cursor: strconv.Itoa(offset + i + 1),
+		})
+	}
+	
+	return &generated.CustomerConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo(offset, len(customers), total),
+		TotalCount: int(total),
+	}, nil
 }
 
 // Customer is the resolver for the customer field.
 func (r *queryResolver) Customer(ctx context.Context, id string) (*generated.Customer, error) {
-	panic(fmt.Errorf("not implemented: Customer - customer"))
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requirePermission(reqCtx, "customers", "read"); err != nil {
+		return nil, err
+	}
+	
+	customerService := r.GetCustomerService(string(reqCtx.Tenant.ID))
+	if customerService == nil {
+		return nil, fmt.Errorf("customer service not available")
+	}
+	
+	customerID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	
+	// Access policies decide whether this customer may be read
+	customer, err := customerService.GetCustomer(reqCtx.User.PolicySubject(), customerID)
+	if err != nil {
+		return nil, recordError(err)
+	}
+	
+	return convertCustomer(customer), nil
 }
 
 // Employees is the resolver for the employees field.
 func (r *queryResolver) Employees(ctx context.Context, filter *generated.EmployeeFilter, pagination *generated.Pagination) (*generated.EmployeeConnection, error) {
-	panic(fmt.Errorf("not implemented: Employees - employees"))
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requirePermission(reqCtx, "employees", "read"); err != nil {
+		return nil, err
+	}
+	
+	employeeService := r.GetEmployeeService(string(reqCtx.Tenant.ID))
+	if employeeService == nil {
+		return nil, fmt.Errorf("employee service not available")
+	}
+	
+	offset, limit, err := pageWindow(pagination)
+	if err != nil {
+		return nil, err
+	}
+	
+	var employeeFilter services.EmployeeFilter
+	if filter != nil {
+		if filter.Status != nil {
+			employeeFilter.Status = strings.ToLower(string(*filter.Status))
+		}
+		if filter.Department != nil {
+			employeeFilter.DepartmentID = *filter.Department
+		}
+		if filter.Position != nil {
+			employeeFilter.Position = *filter.Position
+		}
+		if filter.Search != nil {
+			employeeFilter.Search = *filter.Search
+		}
+	}
+	
+	// Access policies restrict the employees listed
+	employees, total, err := employeeService.ListEmployees(reqCtx.User.PolicySubject(), employeeFilter, offset, limit)
+	if err != nil {
+		return nil, internalError(err)
+	}
+	
+	edges := make([]*generated.EmployeeEdge, 0, len(employees))
+	for i, employee := range employees {
+		edges = append(edges, &generated.EmployeeEdge{
+			Node:   convertEmployee(employee),
+			Cursor: strconv.Itoa(offset + i + 1),
+		})
+	}
+	
+	return &generated.EmployeeConnection{
+		Edges:      edges,
+		PageInfo:   pageInfo(offset, len(employees), total),
+		TotalCount: int(total),
+	}, nil
 }
 
 // Employee is the resolver for the employee field.
 func (r *queryResolver) Employee(ctx context.Context, id string) (*generated.Employee, error) {
-	panic(fmt.Errorf("not implemented: Employee - employee"))
+	reqCtx := getRequestContext(ctx)
+	
+	if err := r.requirePermission(reqCtx, "employees", "read"); err != nil {
+		return nil, err
+	}
+	
+	employeeService := r.GetEmployeeService(string(reqCtx.Tenant.ID))
+	if employeeService == nil {
+		return nil, fmt.Errorf("employee service not available")
+	}
+	
+	employeeID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	
+	// Access policies decide whether this employee may be read
+	employee, err := employeeService.GetEmployee(reqCtx.User.PolicySubject(), employeeID)
+	if err != nil {
+		return nil, recordError(err)
+	}
+	
+	return convertEmployee(employee), nil
 }
 
 // Departments is the resolver for the departments field.
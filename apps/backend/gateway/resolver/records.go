@@ -0,0 +1,294 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/generated"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+)
+
+// defaultPageSize is the number of records returned without pagination.first
+const defaultPageSize = 20
+
+// pageWindow returns the offset and limit of a page. Cursors are record positions.
+func pageWindow(pagination *generated.Pagination) (int, int, error) {
+	offset, limit := 0, defaultPageSize
+	if pagination == nil {
+		return offset, limit, nil
+	}
+	if pagination.First != nil {
+		if *pagination.First < 1 || *pagination.First > 100 {
+			return 0, 0, ErrInvalidInput
+		}
+		limit = *pagination.First
+	}
+	if pagination.After != nil {
+		position, err := strconv.Atoi(*pagination.After)
+		if err != nil || position < 0 {
+			return 0, 0, ErrInvalidInput
+		}
+		offset = position
+	}
+	return offset, limit, nil
+}
+
+// pageInfo describes the page of count records starting at offset
+func pageInfo(offset, count int, total int64) *generated.PageInfo {
+	info := &generated.PageInfo{
+		HasNextPage:     int64(offset+count) < total,
+		HasPreviousPage: offset > 0,
+	}
+	if count > 0 {
+		info.StartCursor = stringPtr(strconv.Itoa(offset + 1))
+		info.EndCursor = stringPtr(strconv.Itoa(offset + count))
+	}
+	return info
+}
+
+// recordError converts a service error loading a record into a GraphQL error
+func recordError(err error) error {
+	if errors.Is(err, services.ErrAccessDenied) {
+		return ErrForbidden
+	}
+	if strings.HasSuffix(err.Error(), "not found") {
+		return ErrNotFound
+	}
+	return internalError(err)
+}
+
+// internalError logs an unexpected service error and hides it from the client, as
+// database errors carry SQL and schema details
+func internalError(err error) error {
+	log.Printf("GraphQL resolver error: %v", err)
+	return ErrInternal
+}
+
+// roleAssignmentError converts a role assignment error into a GraphQL error
+func roleAssignmentError(err error) error {
+	if errors.Is(err, services.ErrInvalidRoleWindow) || errors.Is(err, services.ErrInvalidRoleMode) ||
+		strings.HasPrefix(err.Error(), "some roles were not found") {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return recordError(err)
+}
+
+// parseIDs parses a list of UUID arguments
+func parseIDs(values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			return nil, ErrInvalidInput
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// convertCustomer converts a customer into its GraphQL type
+func convertCustomer(customer *models.Customer) *generated.Customer {
+	result := &generated.Customer{
+		ID:        customer.ID.String(),
+		TenantID:  types.TenantID(customer.TenantID.String()),
+		Name:      customer.Name,
+		Email:     customer.Email,
+		Phone:     customer.Phone,
+		Address:   customer.Address,
+		Company:   customer.Company,
+		Status:    generated.CustomerStatus(strings.ToUpper(customer.Status)),
+		Tags:      customer.Tags,
+		Notes:     customer.Notes,
+		CreatedBy: &generated.User{},
+		CreatedAt: customer.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: customer.UpdatedAt.Format(time.RFC3339),
+	}
+	if result.Tags == nil {
+		result.Tags = []string{}
+	}
+	if customer.Creator != nil {
+		result.CreatedBy = convertTenantUser(customer.Creator)
+	}
+	return result
+}
+
+// convertEmployee converts an employee into its GraphQL type
+func convertEmployee(employee *models.Employee) *generated.Employee {
+	result := &generated.Employee{
+		ID:         employee.ID.String(),
+		TenantID:   types.TenantID(employee.TenantID.String()),
+		EmployeeID: employee.EmployeeID,
+		FirstName:  employee.FirstName,
+		LastName:   employee.LastName,
+		Email:      employee.Email,
+		Phone:      employee.Phone,
+		Position:   employee.Position,
+		Salary:     employee.Salary,
+		HireDate:   employee.HireDate.Format(time.RFC3339),
+		Status:     generated.EmployeeStatus(strings.ToUpper(employee.Status)),
+		CreatedAt:  employee.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:  employee.UpdatedAt.Format(time.RFC3339),
+	}
+	if department := employee.Department; department != nil {
+		result.Department = &generated.Department{
+			ID:          department.ID.String(),
+			TenantID:    types.TenantID(department.TenantID.String()),
+			Name:        department.Name,
+			Description: department.Description,
+			Employees:   []*generated.Employee{},
+			CreatedAt:   department.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:   department.UpdatedAt.Format(time.RFC3339),
+		}
+	}
+	return result
+}
+
+// convertTenantUser converts a tenant user with the roles it was loaded with into its GraphQL type
+func convertTenantUser(user *models.TenantUser) *generated.User {
+	roles := make([]*generated.Role, 0, len(user.Roles))
+	for i := range user.Roles {
+		roles = append(roles, convertRole(&user.Roles[i]))
+	}
+
+	return &generated.User{
+		ID:        user.ID.String(),
+		TenantID:  types.TenantID(user.TenantID.String()),
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Avatar:    user.Avatar,
+		Roles:     roles,
+		Status:    generated.UserStatus(strings.ToUpper(user.Status)),
+		CreatedAt: user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// convertRole converts a role and the permissions it was loaded with into its GraphQL type
+func convertRole(role *models.Role) *generated.Role {
+	permissions := make([]*generated.Permission, 0, len(role.Permissions))
+	for _, permission := range role.Permissions {
+		permissions = append(permissions, &generated.Permission{
+			ID:          permission.ID.String(),
+			Name:        permission.Name,
+			Resource:    permission.Resource,
+			Action:      permission.Action,
+			Description: permission.Description,
+		})
+	}
+
+	return &generated.Role{
+		ID:          role.ID.String(),
+		TenantID:    types.TenantID(role.TenantID.String()),
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+		Users:       []*generated.User{},
+		CreatedAt:   role.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   role.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// roleWindow parses the optional bounds of a role assignment. It returns nil when
+// neither bound is given.
+func roleWindow(validFrom, validUntil *string) (*services.RoleWindow, error) {
+	if validFrom == nil && validUntil == nil {
+		return nil, nil
+	}
+
+	from, err := parseDateTime(validFrom)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseDateTime(validUntil)
+	if err != nil {
+		return nil, err
+	}
+	return &services.RoleWindow{ValidFrom: from, ValidUntil: until}, nil
+}
+
+// parseDateTime parses an optional RFC 3339 DateTime argument
+func parseDateTime(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, ErrInvalidInput
+	}
+	return &parsed, nil
+}
+
+// convertServiceAccount converts a service account with its keys into its GraphQL type
+func convertServiceAccount(account *models.ServiceAccount) *generated.ServiceAccount {
+	keys := make([]*generated.APIKey, 0, len(account.Keys))
+	for i := range account.Keys {
+		keys = append(keys, convertAPIKey(&account.Keys[i]))
+	}
+	allowedIPs := account.AllowedIPs
+	if allowedIPs == nil {
+		allowedIPs = []string{}
+	}
+
+	return &generated.ServiceAccount{
+		ID:          account.ID.String(),
+		TenantID:    types.TenantID(account.TenantID.String()),
+		Name:        account.Name,
+		Description: account.Description,
+		Permissions: account.Permissions,
+		AllowedIps:  allowedIPs,
+		Keys:        keys,
+		CreatedAt:   account.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   account.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// convertAPIKey converts an API key into its GraphQL type
+func convertAPIKey(apiKey *models.APIKey) *generated.APIKey {
+	return &generated.APIKey{
+		ID:         apiKey.ID.String(),
+		Prefix:     models.APIKeyPrefix + apiKey.Prefix,
+		ExpiresAt:  formatDateTime(apiKey.ExpiresAt),
+		LastUsedAt: formatDateTime(apiKey.LastUsedAt),
+		RevokedAt:  formatDateTime(apiKey.RevokedAt),
+		Active:     apiKey.Active(time.Now()),
+		CreatedAt:  apiKey.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// formatDateTime formats an optional time as a DateTime
+func formatDateTime(value *time.Time) *string {
+	if value == nil {
+		return nil
+	}
+	return stringPtr(value.Format(time.RFC3339))
+}
+
+// serviceAccountError converts a service account error into a GraphQL error
+func serviceAccountError(err error) error {
+	if errors.Is(err, services.ErrInvalidServiceAccount) || errors.Is(err, services.ErrInvalidKeyExpiry) ||
+		errors.Is(err, services.ErrAPIKeyInactive) {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	return recordError(err)
+}
+
+// apiKeyPayload returns a newly issued API key with its service account
+func apiKeyPayload(accountService *services.ServiceAccountService, accountID uuid.UUID, apiKey *models.APIKey, key string) (*generated.APIKeyPayload, error) {
+	account, err := accountService.GetServiceAccount(accountID)
+	if err != nil {
+		return nil, recordError(err)
+	}
+	return &generated.APIKeyPayload{
+		ServiceAccount: convertServiceAccount(account),
+		APIKey:         convertAPIKey(apiKey),
+		Key:            key,
+	}, nil
+}
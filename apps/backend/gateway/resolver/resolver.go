@@ -3,8 +3,10 @@ package resolver
 import (
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/clients"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
 )
 
 // This file will not be regenerated automatically.
@@ -19,6 +21,15 @@ type Resolver struct {
 	tenantService      *services.TenantService
 	planService        *services.PlanService
 	subscriptionService *services.SubscriptionService
+	
+	// Event bus used by services to publish changes
+	events *services.EventBus
+	
+	// Token manager for session management
+	tokenManager *auth.TokenManager
+	
+	// Auth service client for flows handled by the auth service
+	authClient *clients.AuthClient
 }
 
 // NewResolver creates a new resolver instance
@@ -37,6 +48,24 @@ func (r *Resolver) SetDatabase(db *gorm.DB) {
 	r.subscriptionService = services.NewSubscriptionService(db)
 }
 
+// SetEventBus sets the event bus used by services to publish changes
+func (r *Resolver) SetEventBus(events *services.EventBus) {
+	r.events = events
+	if r.tenantService != nil {
+		r.tenantService.SetEventBus(events)
+	}
+}
+
+// SetTokenManager sets the token manager used to list and revoke sessions
+func (r *Resolver) SetTokenManager(tokenManager *auth.TokenManager) {
+	r.tokenManager = tokenManager
+}
+
+// SetAuthClient sets the client used to call the auth service
+func (r *Resolver) SetAuthClient(authClient *clients.AuthClient) {
+	r.authClient = authClient
+}
+
 // GetUserService returns a user service for the given tenant
 func (r *Resolver) GetUserService(tenantID string) *services.UserService {
 	if r.db == nil {
@@ -47,7 +76,57 @@ func (r *Resolver) GetUserService(tenantID string) *services.UserService {
 	if err != nil {
 		return nil
 	}
-	return services.NewUserService(r.db, tenantUUID)
+	userService := services.NewUserService(r.db, tenantUUID)
+	userService.SetEventBus(r.events)
+	return userService
+}
+
+// GetCustomerService returns a customer service for the given tenant
+func (r *Resolver) GetCustomerService(tenantID string) *services.CustomerService {
+	if r.db == nil {
+		return nil
+	}
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil
+	}
+	return services.NewCustomerService(r.db, tenantUUID)
+}
+
+// GetEmployeeService returns an employee service for the given tenant
+func (r *Resolver) GetEmployeeService(tenantID string) *services.EmployeeService {
+	if r.db == nil {
+		return nil
+	}
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil
+	}
+	return services.NewEmployeeService(r.db, tenantUUID)
+}
+
+// GetPolicyService returns an access policy service for the given tenant
+func (r *Resolver) GetPolicyService(tenantID string) *services.PolicyService {
+	if r.db == nil {
+		return nil
+	}
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil
+	}
+	return services.NewPolicyService(r.db, tenantUUID)
+}
+
+// GetServiceAccountService returns a service account service for the given tenant
+func (r *Resolver) GetServiceAccountService(tenantID string) *services.ServiceAccountService {
+	if r.db == nil {
+		return nil
+	}
+	tenantUUID, err := uuid.Parse(tenantID)
+	if err != nil {
+		return nil
+	}
+	return services.NewServiceAccountService(r.db, tenantUUID)
 }
 
 // Helper methods for multi-tenant operations
@@ -95,6 +174,14 @@ func (r *Resolver) requireTenantAdmin(ctx *types.RequestContext) error {
 	return nil
 }
 
+// requireNotImpersonated keeps impersonated requests away from sensitive actions
+func (r *Resolver) requireNotImpersonated(ctx *types.RequestContext) error {
+	if ctx.IsImpersonated() {
+		return ErrImpersonationDenied
+	}
+	return nil
+}
+
 // requirePermission checks if the user has a specific permission
 func (r *Resolver) requirePermission(ctx *types.RequestContext, resource, action string) error {
 	if err := r.requireTenantAuth(ctx); err != nil {
@@ -0,0 +1,32 @@
+package resolver
+
+import (
+	"time"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/generated"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// requireSessions ensures the user is authenticated and sessions can be managed
+func (r *Resolver) requireSessions(ctx *types.RequestContext) error {
+	if err := r.requireAuth(ctx); err != nil {
+		return err
+	}
+	if r.tokenManager == nil {
+		return ErrSessionsUnavailable
+	}
+	return nil
+}
+
+// convertSession converts a session into its GraphQL type
+func convertSession(session *auth.Session, currentTokenID string) *generated.Session {
+	return &generated.Session{
+		ID:        session.ID,
+		IPAddress: session.IPAddress,
+		UserAgent: session.UserAgent,
+		CreatedAt: session.CreatedAt.Format(time.RFC3339),
+		LastSeen:  session.LastSeen.Format(time.RFC3339),
+		Current:   session.TokenID == currentTokenID,
+	}
+}
@@ -0,0 +1,287 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/types"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/auth"
+)
+
+// fakeAPIKeyLookup serves API keys from memory and records their use
+type fakeAPIKeyLookup struct {
+	accounts map[string]*models.ServiceAccount // by key
+	keys     map[string]*models.APIKey
+}
+
+func (f *fakeAPIKeyLookup) AuthenticateAPIKey(tenantID uuid.UUID, key, ip string) (*models.ServiceAccount, error) {
+	account, exists := f.accounts[key]
+	if !exists || account.TenantID != tenantID {
+		return nil, services.ErrInvalidAPIKey
+	}
+	apiKey := f.keys[key]
+	now := time.Now()
+	if !apiKey.Active(now) {
+		return nil, services.ErrAPIKeyInactive
+	}
+	if !account.AllowsIP(ip) {
+		return nil, services.ErrIPNotAllowed
+	}
+	apiKey.LastUsedAt = &now
+	return account, nil
+}
+
+func setupServiceAccountTestApp(tenantID uuid.UUID, lookup middleware.APIKeyLookup) *fiber.App {
+	users := middleware.NewUserResolver(&fakeUserLookup{}, time.Minute)
+	users.SetAPIKeyLookup(lookup)
+
+	app := fiber.New(withTrustedProxies(fiber.Config{}))
+	app.Use(func(c *fiber.Ctx) error {
+		c.Locals("tenant", &types.TenantContext{ID: types.TenantID(tenantID.String()), Status: "ACTIVE"})
+		return c.Next()
+	})
+	app.Use(middleware.AuthMiddleware(auth.NewTokenManager("test-secret", "zplus-saas"), users))
+	app.Get("/me", func(c *fiber.Ctx) error {
+		return c.JSON(middleware.GetUserContext(c))
+	})
+	serviceAccountHandler := handlers.NewServiceAccountHandler(func(string) *services.ServiceAccountService { return nil })
+	app.Get("/service-accounts", serviceAccountHandler.GetServiceAccounts)
+	return app
+}
+
+func TestServiceAccountAPIKeyAuth(t *testing.T) {
+	tenantID := uuid.New()
+	account := &models.ServiceAccount{
+		ID:          uuid.New(),
+		TenantID:    tenantID,
+		Name:        "billing-sync",
+		Permissions: []string{"customers:read"},
+		AllowedIPs:  []string{"10.0.0.0/8"},
+	}
+	expired := time.Now().Add(-time.Hour)
+	lookup := &fakeAPIKeyLookup{
+		accounts: map[string]*models.ServiceAccount{"zpk_aaa_valid": account, "zpk_bbb_expired": account},
+		keys: map[string]*models.APIKey{
+			"zpk_aaa_valid":   {ID: uuid.New(), ServiceAccountID: account.ID},
+			"zpk_bbb_expired": {ID: uuid.New(), ServiceAccountID: account.ID, ExpiresAt: &expired},
+		},
+	}
+	app := setupServiceAccountTestApp(tenantID, lookup)
+
+	request := func(path, header, value string) *http.Response {
+		req, _ := http.NewRequest("GET", path, nil)
+		req.Header.Set(header, value)
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		return resp
+	}
+
+	// Keys are rejected from addresses outside the allow-list
+	for _, header := range [][2]string{{"X-API-Key", "zpk_aaa_valid"}, {"Authorization", "ApiKey zpk_aaa_valid"}} {
+		resp := request("/me", header[0], header[1])
+		if resp.StatusCode != 401 {
+			t.Fatalf("Expected status 401 from an address outside the allow-list, got %d", resp.StatusCode)
+		}
+	}
+
+	// Keys are accepted in the X-API-Key header and with the ApiKey scheme
+	account.AllowedIPs = []string{"0.0.0.0/0"}
+	for _, header := range [][2]string{{"X-API-Key", "zpk_aaa_valid"}, {"Authorization", "ApiKey zpk_aaa_valid"}} {
+		resp := request("/me", header[0], header[1])
+		if resp.StatusCode != 200 {
+			t.Fatalf("Expected status 200 with %s, got %d", header[0], resp.StatusCode)
+		}
+		var me types.UserContext
+		json.NewDecoder(resp.Body).Decode(&me)
+		if !me.IsServiceAccount || me.ID != account.ID.String() || me.FirstName != "billing-sync" {
+			t.Fatalf("Expected the service account as user, got %+v", me)
+		}
+		if !me.HasPermission("customers:read") || me.HasPermission("customers:write") {
+			t.Fatalf("Expected only the account's permissions, got %v", me.Permissions)
+		}
+	}
+	if lookup.keys["zpk_aaa_valid"].LastUsedAt == nil {
+		t.Fatal("Expected the key's last use to be recorded")
+	}
+
+	// Expired, unknown and other tenants' keys are rejected
+	if resp := request("/me", "X-API-Key", "zpk_bbb_expired"); resp.StatusCode != 401 {
+		t.Fatalf("Expected status 401 for an expired key, got %d", resp.StatusCode)
+	}
+	if resp := request("/me", "X-API-Key", "zpk_ccc_unknown"); resp.StatusCode != 401 {
+		t.Fatalf("Expected status 401 for an unknown key, got %d", resp.StatusCode)
+	}
+	other := setupServiceAccountTestApp(uuid.New(), lookup)
+	req, _ := http.NewRequest("GET", "/me", nil)
+	req.Header.Set("X-API-Key", "zpk_aaa_valid")
+	if resp, _ := other.Test(req, 5000); resp.StatusCode != 401 {
+		t.Fatalf("Expected status 401 for a key of another tenant, got %d", resp.StatusCode)
+	}
+
+	// Service accounts cannot manage service accounts
+	if resp := request("/service-accounts", "X-API-Key", "zpk_aaa_valid"); resp.StatusCode != 403 {
+		t.Fatalf("Expected status 403 for a service account, got %d", resp.StatusCode)
+	}
+
+	t.Log("✓ Service account API key authentication")
+}
+
+func TestServiceAccountAllowListBehindProxy(t *testing.T) {
+	tenantID := uuid.New()
+	account := &models.ServiceAccount{
+		ID:         uuid.New(),
+		TenantID:   tenantID,
+		Name:       "billing-sync",
+		AllowedIPs: []string{"203.0.113.0/24"},
+	}
+	lookup := &fakeAPIKeyLookup{
+		accounts: map[string]*models.ServiceAccount{"zpk_aaa_valid": account},
+		keys:     map[string]*models.APIKey{"zpk_aaa_valid": {ID: uuid.New(), ServiceAccountID: account.ID}},
+	}
+
+	requestFrom := func(app *fiber.App, clientIP string) int {
+		req, _ := http.NewRequest("GET", "/me", nil)
+		req.Header.Set("X-API-Key", "zpk_aaa_valid")
+		req.Header.Set("X-Real-Ip", clientIP)
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		return resp.StatusCode
+	}
+
+	// Behind a trusted proxy the forwarded client address is checked
+	t.Setenv("TRUSTED_PROXIES", "0.0.0.0/0")
+	proxied := setupServiceAccountTestApp(tenantID, lookup)
+	if status := requestFrom(proxied, "203.0.113.7"); status != 200 {
+		t.Fatalf("Expected status 200 for an allowed client behind the proxy, got %d", status)
+	}
+	if status := requestFrom(proxied, "198.51.100.9"); status != 401 {
+		t.Fatalf("Expected status 401 for a client outside the allow-list, got %d", status)
+	}
+
+	// Without a trusted proxy a forwarded address cannot get a key past the allow-list
+	t.Setenv("TRUSTED_PROXIES", "")
+	direct := setupServiceAccountTestApp(tenantID, lookup)
+	if status := requestFrom(direct, "203.0.113.7"); status != 401 {
+		t.Fatalf("Expected status 401 for a spoofed client address, got %d", status)
+	}
+
+	t.Log("✓ API key allow-lists check the client address forwarded by trusted proxies only")
+}
+
+func TestServiceAccountIPAllowList(t *testing.T) {
+	account := &models.ServiceAccount{
+		Name:        "ci",
+		Permissions: []string{"products:read"},
+		AllowedIPs:  []string{"203.0.113.7", "10.1.0.0/16", "2001:db8::/32"},
+	}
+	if err := account.Validate(); err != nil {
+		t.Fatalf("Expected a valid account, got %v", err)
+	}
+
+	for ip, allowed := range map[string]bool{
+		"203.0.113.7": true,
+		"203.0.113.8": false,
+		"10.1.200.3":  true,
+		"10.2.0.1":    false,
+		"2001:db8::1": true,
+		"not-an-ip":   false,
+	} {
+		if account.AllowsIP(ip) != allowed {
+			t.Fatalf("Expected AllowsIP(%s) to be %v", ip, allowed)
+		}
+	}
+
+	account.AllowedIPs = []string{"10.0.0.300"}
+	if err := account.Validate(); err == nil {
+		t.Fatal("Expected an invalid allow-list entry to be rejected")
+	}
+	account.AllowedIPs = nil
+	account.Permissions = nil
+	if err := account.Validate(); err == nil {
+		t.Fatal("Expected an account without permissions to be rejected")
+	}
+
+	// Revoked keys stop working even before they expire
+	expiresAt := time.Now().Add(time.Hour)
+	key := &models.APIKey{ExpiresAt: &expiresAt}
+	if !key.Active(time.Now()) || key.Active(expiresAt) {
+		t.Fatal("Expected the key to be active until it expires")
+	}
+	revokedAt := time.Now()
+	key.RevokedAt = &revokedAt
+	if key.Active(time.Now()) {
+		t.Fatal("Expected a revoked key to be inactive")
+	}
+
+	t.Log("✓ Service account IP allow-list and key lifetime")
+}
+
+func TestAPIKeyPermissionsLimitUserManagement(t *testing.T) {
+	tenantID := uuid.New()
+	account := &models.ServiceAccount{ID: uuid.New(), TenantID: tenantID, Name: "directory-sync", AllowedIPs: []string{"0.0.0.0/0"}}
+	lookup := &fakeAPIKeyLookup{
+		accounts: map[string]*models.ServiceAccount{"zpk_aaa_valid": account},
+		keys:     map[string]*models.APIKey{"zpk_aaa_valid": {ID: uuid.New(), ServiceAccountID: account.ID}},
+	}
+
+	// The handlers only ask for the user service once the permission check passed
+	var served []string
+	app := setupServiceAccountTestApp(tenantID, lookup)
+	userHandler := handlers.NewUserHandler(func(string) *services.UserService {
+		served = append(served, "user service")
+		return nil
+	})
+	app.Get("/users", userHandler.GetUsers)
+	app.Get("/users/:id", userHandler.GetUser)
+	app.Post("/users", userHandler.CreateUser)
+	app.Put("/users/:id", userHandler.UpdateUser)
+	app.Delete("/users/:id", userHandler.DeleteUser)
+
+	id := uuid.NewString()
+	routes := [][2]string{{"GET", "/users"}, {"GET", "/users/" + id}, {"POST", "/users"}, {"PUT", "/users/" + id}, {"DELETE", "/users/" + id}}
+	request := func(route [2]string) int {
+		req, _ := http.NewRequest(route[0], route[1], nil)
+		req.Header.Set("X-API-Key", "zpk_aaa_valid")
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		return resp.StatusCode
+	}
+
+	// A key without permissions cannot read or change users
+	for _, route := range routes {
+		if status := request(route); status != 403 {
+			t.Errorf("Expected status 403 for %s %s without permissions, got %d", route[0], route[1], status)
+		}
+	}
+	if len(served) != 0 {
+		t.Fatalf("Expected no user service to be used, got %d", len(served))
+	}
+
+	// users:write covers reading, creating and updating but not deleting
+	account.Permissions = []string{"users:write"}
+	for _, route := range routes {
+		expected := 500 // No user service in this test
+		if route[0] == "DELETE" {
+			expected = 403
+		}
+		if status := request(route); status != expected {
+			t.Errorf("Expected status %d for %s %s with users:write, got %d", expected, route[0], route[1], status)
+		}
+	}
+
+	t.Log("✓ API keys manage users only within their permissions")
+}
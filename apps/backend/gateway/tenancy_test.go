@@ -0,0 +1,507 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/migrations"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/database"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// searchPathDriver is a fake database that checks every statement on tenant tables that
+// filters by a known tenant ID against the search_path of the connection running it.
+// Tenant isolations are served from system.tenants.
+type searchPathDriver struct {
+	fakeDriver
+	schemas    map[string]string // tenant ID -> quoted schema
+	isolations map[string]string // tenant ID -> isolation
+	lookups    int
+	checked    int
+	violations []string
+}
+
+// check records a statement that ran against another tenant's schema than it filters by
+func (d *searchPathDriver) check(query, searchPath string, args []driver.NamedValue) {
+	// Give other connections the chance to interleave their statements
+	runtime.Gosched()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, arg := range args {
+		value, ok := arg.Value.(string)
+		if !ok {
+			continue
+		}
+		if schema, ok := d.schemas[value]; ok {
+			d.checked++
+			if searchPath != schema {
+				d.violations = append(d.violations, fmt.Sprintf("%s ran with search_path %s", query, searchPath))
+			}
+		}
+	}
+}
+
+// isolation returns the system.tenants row of a tenant with its isolation
+func (d *searchPathDriver) isolation(tenantID string) driver.Rows {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.lookups++
+	isolation, ok := d.isolations[tenantID]
+	if !ok {
+		return fakeColumn("isolation")
+	}
+	return fakeColumn("isolation", isolation)
+}
+
+func (d *searchPathDriver) exec(c *fakeConn, query string, args []driver.NamedValue) (driver.Result, error) {
+	d.check(query, c.searchPath(), args)
+	return driver.RowsAffected(0), nil
+}
+
+func (d *searchPathDriver) query(c *fakeConn, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if strings.Contains(query, `"system"."tenants"`) {
+		return d.isolation(args[0].Value.(string)), nil
+	}
+	if strings.Contains(query, `"system"."tenant_databases"`) {
+		return &fakeRows{
+			columns: []string{"tenant_id", "host", "port", "username", "password", "database", "ssl_mode"},
+			values:  [][]driver.Value{{args[0].Value, "tenant-db", int64(5432), "tenant", "secret", "tenant", "require"}},
+		}, nil
+	}
+	d.check(query, c.searchPath(), args)
+	if strings.Contains(query, "count(") {
+		return fakeColumn("count", int64(0)), nil
+	}
+	return nil, nil
+}
+
+// openSearchPathDB opens a gorm handle over a small pool of fake connections
+func openSearchPathDB(t *testing.T, fake *searchPathDriver) *gorm.DB {
+	db := openFakeDB(t, fake)
+	sqlDB, err := db.DB()
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	sqlDB.SetMaxOpenConns(2)
+	return db
+}
+
+func currentSearchPath(db *gorm.DB) (string, error) {
+	var searchPath string
+	err := db.Raw("SELECT current_setting('search_path')").Scan(&searchPath).Error
+	return searchPath, err
+}
+
+func TestTenantQueriesDoNotLeakSearchPath(t *testing.T) {
+	fake := &searchPathDriver{schemas: map[string]string{}}
+	tenantIDs := make([]uuid.UUID, 8)
+	for i := range tenantIDs {
+		tenantIDs[i] = uuid.New()
+		fake.schemas[tenantIDs[i].String()] = `"` + migrations.TenantSchema(tenantIDs[i]) + `"`
+	}
+	db := openSearchPathDB(t, fake)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 1000)
+	for _, tenantID := range tenantIDs {
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func(tenantID uuid.UUID) {
+				defer wg.Done()
+				users := services.NewUserService(db, tenantID)
+				if _, err := users.GetUser(uuid.New()); err == nil || err.Error() != "user not found" {
+					errs <- fmt.Errorf("expected user not found, got %v", err)
+				}
+				if _, _, err := users.ListUsers(services.UserFilter{}, 0, 20); err != nil {
+					errs <- err
+				}
+			}(tenantID)
+
+			// Statements outside a tenant transaction never see a tenant schema
+			go func() {
+				defer wg.Done()
+				if searchPath, err := currentSearchPath(db); err != nil || searchPath != defaultSearchPath {
+					errs <- fmt.Errorf("expected the default search_path outside a transaction, got %s, %v", searchPath, err)
+				}
+			}()
+		}
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	if fake.checked == 0 {
+		t.Fatal("Expected tenant queries to be checked")
+	}
+	if len(fake.violations) > 0 {
+		t.Fatalf("Tenant queries ran against another schema:\n%s", strings.Join(fake.violations, "\n"))
+	}
+
+	t.Logf("✓ %d concurrent tenant queries ran against their own schema without leaking search_path", fake.checked)
+}
+
+func TestTenantSchemasIsolatedOnPostgres(t *testing.T) {
+	dsn := os.Getenv("DATABASE_URL")
+	if dsn == "" {
+		t.Skip("Set DATABASE_URL to run against PostgreSQL")
+	}
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		t.Fatalf("Failed to connect database: %v", err)
+	}
+	sqlDB, _ := db.DB()
+	defer sqlDB.Close()
+
+	// A small pool makes the tenants take turns on the same connections
+	const poolSize = 4
+	sqlDB.SetMaxOpenConns(poolSize)
+	sqlDB.SetMaxIdleConns(poolSize)
+
+	tenantIDs := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	for _, tenantID := range tenantIDs {
+		schema, _ := migrations.QuoteIdentifier(migrations.TenantSchema(tenantID))
+		t.Cleanup(func() { db.Exec("DROP SCHEMA IF EXISTS " + schema + " CASCADE") })
+		for _, statement := range []string{
+			"CREATE SCHEMA " + schema,
+			"CREATE TABLE " + schema + ".isolation_probe (tenant_id text NOT NULL)",
+		} {
+			if err := db.Exec(statement).Error; err != nil {
+				t.Fatalf("Failed to prepare tenant schema: %v", err)
+			}
+		}
+		if err := db.Exec("INSERT INTO "+schema+".isolation_probe VALUES (?)", tenantID.String()).Error; err != nil {
+			t.Fatalf("Failed to prepare tenant schema: %v", err)
+		}
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 1000)
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(tenantID uuid.UUID) {
+			defer wg.Done()
+			err := tenancy.New(db, tenantID).Transaction(func(tx *gorm.DB) error {
+				var seen []string
+				if err := tx.Raw("SELECT tenant_id FROM isolation_probe").Scan(&seen).Error; err != nil {
+					return err
+				}
+				if len(seen) != 1 || seen[0] != tenantID.String() {
+					return fmt.Errorf("tenant %s saw the rows of %v", tenantID, seen)
+				}
+				return nil
+			})
+			if err != nil {
+				errs <- err
+			}
+		}(tenantIDs[i%len(tenantIDs)])
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	// Every pooled connection is back on the default search_path
+	conns := make([]*sql.Conn, poolSize)
+	for i := range conns {
+		conn, err := sqlDB.Conn(context.Background())
+		if err != nil {
+			t.Fatalf("Failed to get pooled connection: %v", err)
+		}
+		defer conn.Close()
+		conns[i] = conn
+	}
+	for _, conn := range conns {
+		var probe sql.NullString
+		if err := conn.QueryRowContext(context.Background(), "SELECT to_regclass('isolation_probe')::text").Scan(&probe); err != nil {
+			t.Fatalf("Failed to check search_path: %v", err)
+		}
+		if probe.Valid {
+			t.Fatalf("Expected no tenant schema on a pooled connection, found %s", probe.String)
+		}
+	}
+
+	t.Log("✓ Concurrent tenant transactions on PostgreSQL only see their own schema")
+}
+
+func TestNestedTenantTransactions(t *testing.T) {
+	fake := &searchPathDriver{schemas: map[string]string{}}
+	db := openSearchPathDB(t, fake)
+	outer, inner := uuid.New(), uuid.New()
+	outerSchema := `"` + migrations.TenantSchema(outer) + `"`
+
+	err := tenancy.New(db, outer).Transaction(func(tx *gorm.DB) error {
+		err := tenancy.New(tx, inner).Transaction(func(innerTx *gorm.DB) error {
+			if searchPath, _ := currentSearchPath(innerTx); searchPath != `"`+migrations.TenantSchema(inner)+`"` {
+				return fmt.Errorf("expected the inner tenant schema, got %s", searchPath)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		// The outer tenant schema is back once the nested transaction is done
+		if searchPath, _ := currentSearchPath(tx); searchPath != outerSchema {
+			return fmt.Errorf("expected the outer tenant schema to be restored, got %s", searchPath)
+		}
+
+		// A failing nested transaction is rolled back to its savepoint
+		tenancy.New(tx, inner).Transaction(func(*gorm.DB) error {
+			return fmt.Errorf("failed")
+		})
+		if searchPath, _ := currentSearchPath(tx); searchPath != outerSchema {
+			return fmt.Errorf("expected the outer tenant schema after a rollback, got %s", searchPath)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if searchPath, _ := currentSearchPath(db); searchPath != defaultSearchPath {
+		t.Fatalf("Expected the default search_path after the transaction, got %s", searchPath)
+	}
+
+	t.Log("✓ Nested tenant transactions restore the search_path of the outer one")
+}
+
+func TestTenantSchemaValidation(t *testing.T) {
+	fake := &searchPathDriver{schemas: map[string]string{}}
+	db := openSearchPathDB(t, fake)
+
+	for _, schema := range []string{"tenant; DROP SCHEMA system", `reporting"`, "Reporting", "pg_temp"} {
+		if _, err := tenancy.ForSchema(db, schema); err == nil {
+			t.Fatalf("Expected schema %q to be rejected", schema)
+		}
+	}
+	reporting, err := tenancy.ForSchema(db, "reporting")
+	if err != nil {
+		t.Fatalf("Failed to scope to reporting schema: %v", err)
+	}
+	err = reporting.Transaction(func(tx *gorm.DB) error {
+		if searchPath, _ := currentSearchPath(tx); searchPath != `"reporting"` {
+			return fmt.Errorf("expected the quoted reporting schema, got %s", searchPath)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Log("✓ Schema names are validated and quoted before they reach search_path")
+}
+
+func TestTenantIsolationStrategies(t *testing.T) {
+	shared, ownSchema, ownDatabase := uuid.New(), uuid.New(), uuid.New()
+	system := &searchPathDriver{
+		schemas: map[string]string{
+			shared.String():      `"` + tenancy.SharedSchema + `"`,
+			ownSchema.String():   `"` + migrations.TenantSchema(ownSchema) + `"`,
+			ownDatabase.String(): "no tenant tables in the system database",
+		},
+		isolations: map[string]string{
+			shared.String():      "shared",
+			ownSchema.String():   "schema",
+			ownDatabase.String(): "database",
+		},
+	}
+	dedicated := &searchPathDriver{schemas: map[string]string{
+		ownDatabase.String(): `"` + migrations.TenantSchema(ownDatabase) + `"`,
+	}}
+
+	db := openSearchPathDB(t, system)
+	connects := 0
+	router := tenancy.NewRouter(tenancy.NewDatabasePerTenant(func(config database.Config) (*gorm.DB, error) {
+		connects++
+		if config.Host != "tenant-db" || config.Password != "secret" {
+			t.Errorf("Unexpected tenant database settings: %+v", config)
+		}
+		return openSearchPathDB(t, dedicated), nil
+	}))
+	if err := db.Use(router); err != nil {
+		t.Fatalf("Failed to register router: %v", err)
+	}
+
+	// Services run unchanged whatever the isolation of their tenant
+	for _, tenantID := range []uuid.UUID{shared, ownSchema, ownDatabase} {
+		for i := 0; i < 3; i++ {
+			users := services.NewUserService(db, tenantID)
+			if _, _, err := users.ListUsers(services.UserFilter{}, 0, 20); err != nil {
+				t.Fatalf("Failed to list users of tenant %s: %v", tenantID, err)
+			}
+			if _, err := users.GetUser(uuid.New()); err == nil || err.Error() != "user not found" {
+				t.Fatalf("Expected user not found, got %v", err)
+			}
+		}
+	}
+
+	for name, fake := range map[string]*searchPathDriver{"system": system, "dedicated": dedicated} {
+		if fake.checked == 0 {
+			t.Fatalf("Expected tenant queries on the %s database", name)
+		}
+		if len(fake.violations) > 0 {
+			t.Fatalf("Tenant queries on the %s database ran against the wrong schema:\n%s", name, strings.Join(fake.violations, "\n"))
+		}
+	}
+
+	// Isolations are looked up once and the tenant database is connected once
+	if system.lookups != 3 || connects != 1 {
+		t.Fatalf("Expected 3 isolation lookups and 1 connect, got %d and %d", system.lookups, connects)
+	}
+
+	// Unknown tenants are rejected rather than given a schema of their own
+	if err := tenancy.New(db, uuid.New()).Transaction(func(*gorm.DB) error { return nil }); err == nil {
+		t.Fatal("Expected an unknown tenant to be rejected")
+	}
+
+	// Without a router every tenant has a schema of its own
+	plain := openSearchPathDB(t, &searchPathDriver{})
+	if !tenancy.Supports(plain, tenancy.IsolationSchema) || tenancy.Supports(plain, tenancy.IsolationShared) {
+		t.Fatal("Expected only schema isolation without a router")
+	}
+	if !tenancy.Supports(db, tenancy.IsolationDatabase) {
+		t.Fatal("Expected database isolation with a database strategy")
+	}
+	if _, err := tenancy.ParseIsolation("dedicated"); err == nil {
+		t.Fatal("Expected an unknown isolation to be rejected")
+	}
+
+	t.Log("✓ Shared, schema and database isolated tenants are routed to their own tables")
+}
+
+func TestTenantDatabasesConnectIndependently(t *testing.T) {
+	db := openSearchPathDB(t, &searchPathDriver{})
+	slow, fast := uuid.New(), uuid.New()
+
+	// The first tenant's database is slow to answer
+	var mu sync.Mutex
+	connects := 0
+	started, release := make(chan struct{}), make(chan struct{})
+	strategy := tenancy.NewDatabasePerTenant(func(database.Config) (*gorm.DB, error) {
+		mu.Lock()
+		connects++
+		first := connects == 1
+		mu.Unlock()
+		if first {
+			close(started)
+			<-release
+		}
+		return openSearchPathDB(t, &searchPathDriver{}), nil
+	})
+
+	results := make(chan *gorm.DB, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			pool, _ := strategy.Database(db, slow)
+			results <- pool
+		}()
+		if i == 0 {
+			<-started
+		}
+	}
+
+	// Another tenant is connected meanwhile
+	connected := make(chan error, 1)
+	go func() {
+		_, err := strategy.Database(db, fast)
+		connected <- err
+	}()
+	select {
+	case err := <-connected:
+		if err != nil {
+			t.Fatalf("Failed to connect tenant database: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected a tenant database to connect while another one is connecting")
+	}
+
+	// Requests waiting for the slow tenant share its one connection
+	close(release)
+	if first, second := <-results, <-results; first == nil || first != second || connects != 2 {
+		t.Fatalf("Expected one connection per tenant, got %d connects", connects)
+	}
+
+	t.Log("✓ Tenant databases are connected once each without holding up other tenants")
+}
+
+func TestTemplatePushScopedToTenant(t *testing.T) {
+	fake := &roleAssignmentDriver{tenantIDs: []uuid.UUID{uuid.New(), uuid.New()}}
+	db := openFakeDB(t, fake)
+
+	template := &models.RoleTemplate{ID: uuid.New(), Name: "sales", Version: 2}
+	if _, err := services.NewRoleTemplateService(db).PushTemplate(template); err != nil {
+		t.Fatalf("Failed to push template: %v", err)
+	}
+
+	// Tenants with shared isolation share the roles table, so each only sees its own roles
+	pushed := 0
+	for i, statement := range fake.statements {
+		if !strings.Contains(statement, `FROM "roles"`) {
+			continue
+		}
+		if !strings.Contains(statement, "tenant_id = $1") || fake.args[i][0].Value != fake.tenantIDs[pushed].String() {
+			t.Fatalf("Expected the roles of tenant %s, got %s %v", fake.tenantIDs[pushed], statement, fake.args[i])
+		}
+		pushed++
+	}
+	if pushed != 2 {
+		t.Fatalf("Expected the template to be pushed to 2 tenants, got:\n%s", strings.Join(fake.statements, "\n"))
+	}
+
+	t.Log("✓ Template pushes only update the roles of the tenant being pushed")
+}
+
+func TestPendingTemplatePushesRetried(t *testing.T) {
+	fake := &roleAssignmentDriver{
+		tenantIDs: []uuid.UUID{uuid.New(), uuid.New()},
+		template:  []driver.Value{uuid.New().String(), "sales", int64(2), "[]"},
+	}
+	fake.failOn = `FROM "roles"`
+	db := openFakeDB(t, fake)
+	templates := services.NewRoleTemplateService(db)
+
+	pushedTenants := func(from int) []string {
+		var tenants []string
+		for i := from; i < len(fake.statements); i++ {
+			if strings.Contains(fake.statements[i], `FROM "roles"`) {
+				tenants = append(tenants, fmt.Sprint(fake.args[i][0].Value))
+			}
+		}
+		return tenants
+	}
+
+	// A tenant that fails does not stop the push to the others
+	if _, err := templates.PushPending(); err == nil || !strings.Contains(err.Error(), fake.tenantIDs[0].String()) {
+		t.Fatalf("Expected the failing tenant to be reported, got %v", err)
+	}
+	if tenants := pushedTenants(0); len(tenants) != 2 || tenants[1] != fake.tenantIDs[1].String() {
+		t.Fatalf("Expected the push to continue with the next tenant, got %v", tenants)
+	}
+
+	// The next run pushes the template again to the roles still on an older version
+	from := len(fake.statements)
+	if _, err := templates.PushPending(); err != nil {
+		t.Fatalf("Failed to push pending templates: %v", err)
+	}
+	if tenants := pushedTenants(from); len(tenants) != 2 || tenants[0] != fake.tenantIDs[0].String() {
+		t.Fatalf("Expected the failed tenant to be pushed again, got %v", tenants)
+	}
+
+	t.Log("✓ Template updates that failed for a tenant are pushed again")
+}
@@ -0,0 +1,311 @@
+package main
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/handlers"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/gateway/middleware"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/services"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/database"
+	"gorm.io/gorm"
+)
+
+// fakeTenantLookup serves tenants from memory and counts database lookups
+type fakeTenantLookup struct {
+	tenants []*models.Tenant
+	modules map[uuid.UUID][]string
+	lookups int
+}
+
+func (f *fakeTenantLookup) ResolveTenant(identifier string) (*models.Tenant, error) {
+	f.lookups++
+	for _, tenant := range f.tenants {
+		if tenant.Slug == identifier ||
+			(tenant.Domain != nil && *tenant.Domain == identifier) ||
+			(tenant.Subdomain != nil && *tenant.Subdomain == identifier) {
+			return tenant, nil
+		}
+	}
+	return nil, fmt.Errorf("tenant not found")
+}
+
+func (f *fakeTenantLookup) GetEnabledModules(tenantID uuid.UUID) ([]string, error) {
+	return f.modules[tenantID], nil
+}
+
+func newFakeTenantLookup() *fakeTenantLookup {
+	domain := "crm.acme.com"
+	subdomain := "acme-co"
+	acme := &models.Tenant{
+		ID:        uuid.New(),
+		Name:      "ACME Corporation",
+		Slug:      "acme",
+		Domain:    &domain,
+		Subdomain: &subdomain,
+		Status:    "active",
+		Plan: &models.Plan{
+			Name: "Enterprise",
+			Features: map[string]interface{}{
+				"api_access":         true,
+				"advanced_analytics": true,
+				"white_label":        false,
+			},
+		},
+	}
+	suspended := &models.Tenant{
+		ID:     uuid.New(),
+		Name:   "Suspended Corp",
+		Slug:   "suspended",
+		Status: "suspended",
+	}
+
+	return &fakeTenantLookup{
+		tenants: []*models.Tenant{acme, suspended},
+		modules: map[uuid.UUID][]string{
+			acme.ID: {"crm", "hrm"},
+		},
+	}
+}
+
+func setupTenantTestApp(resolver *middleware.TenantResolver) *fiber.App {
+	app := fiber.New()
+	app.Use(middleware.TenantMiddleware(resolver))
+	app.Get("/tenant", func(c *fiber.Ctx) error {
+		return c.JSON(middleware.GetTenantContext(c))
+	})
+	return app
+}
+
+func TestTenantResolutionBySlugDomainAndSubdomain(t *testing.T) {
+	lookup := newFakeTenantLookup()
+	app := setupTenantTestApp(middleware.NewTenantResolver(lookup, time.Minute))
+
+	cases := []struct {
+		name   string
+		header string
+		host   string
+	}{
+		{name: "slug header", header: "acme"},
+		{name: "custom domain", host: "crm.acme.com"},
+		{name: "subdomain", host: "acme-co.zplus.io:8000"},
+	}
+
+	for _, tc := range cases {
+		req, _ := http.NewRequest("GET", "/tenant", nil)
+		if tc.header != "" {
+			req.Header.Set("X-Tenant-ID", tc.header)
+		}
+		if tc.host != "" {
+			req.Host = tc.host
+		}
+
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("%s: request failed: %v", tc.name, err)
+		}
+		if resp.StatusCode != 200 {
+			t.Fatalf("%s: expected status 200, got %d", tc.name, resp.StatusCode)
+		}
+
+		var tenant map[string]interface{}
+		json.NewDecoder(resp.Body).Decode(&tenant)
+		if tenant["slug"] != "acme" {
+			t.Fatalf("%s: expected tenant acme, got %v", tc.name, tenant["slug"])
+		}
+	}
+
+	t.Log("✓ Tenants resolved by slug, custom domain and subdomain")
+}
+
+func TestTenantFeaturesFromModulesAndPlan(t *testing.T) {
+	lookup := newFakeTenantLookup()
+	resolver := middleware.NewTenantResolver(lookup, time.Minute)
+
+	tenant, err := resolver.Resolve("acme")
+	if err != nil {
+		t.Fatalf("Failed to resolve tenant: %v", err)
+	}
+
+	for _, feature := range []string{"CRM", "HRM", "API_ACCESS", "ADVANCED_ANALYTICS"} {
+		if !tenant.HasFeature(feature) {
+			t.Errorf("Expected feature %s, got %v", feature, tenant.Features)
+		}
+	}
+	if tenant.HasFeature("WHITE_LABEL") {
+		t.Error("Disabled plan feature should not be included")
+	}
+	if !tenant.IsActive() {
+		t.Errorf("Expected active tenant, got status %s", tenant.Status)
+	}
+
+	t.Log("✓ Tenant features built from modules and plan")
+}
+
+func TestTenantRejectedWhenUnknownOrSuspended(t *testing.T) {
+	app := setupTenantTestApp(middleware.NewTenantResolver(newFakeTenantLookup(), time.Minute))
+
+	for _, slug := range []string{"unknown", "suspended"} {
+		req, _ := http.NewRequest("GET", "/tenant", nil)
+		req.Header.Set("X-Tenant-ID", slug)
+
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		if resp.StatusCode != 401 {
+			t.Fatalf("Expected status 401 for tenant %s, got %d", slug, resp.StatusCode)
+		}
+	}
+
+	t.Log("✓ Unknown and suspended tenants rejected")
+}
+
+func TestTenantCacheInvalidatedOnTenantChange(t *testing.T) {
+	lookup := newFakeTenantLookup()
+	resolver := middleware.NewTenantResolver(lookup, time.Minute)
+
+	events := services.NewEventBus()
+	events.Subscribe(services.EventTenantChanged, func(event services.Event) {
+		resolver.Invalidate(event.TenantID.String())
+	})
+
+	tenant, _ := resolver.Resolve("acme")
+	resolver.Resolve("acme")
+	if lookup.lookups != 1 {
+		t.Fatalf("Expected 1 database lookup with cache, got %d", lookup.lookups)
+	}
+
+	// Simulate SuspendTenant publishing a change
+	lookup.tenants[0].Status = "suspended"
+	events.Publish(services.Event{Type: services.EventTenantChanged, TenantID: uuid.MustParse(string(tenant.ID))})
+
+	refreshed, _ := resolver.Resolve("acme")
+	if lookup.lookups != 2 {
+		t.Fatalf("Expected cache to be invalidated, got %d lookups", lookup.lookups)
+	}
+	if refreshed.IsActive() {
+		t.Fatal("Expected refreshed tenant to be suspended")
+	}
+
+	t.Log("✓ Tenant cache invalidated on tenant change")
+}
+
+func TestTenantIdentifiersUniqueAcrossSlugDomainAndSubdomain(t *testing.T) {
+	// Another tenant already uses one of the identifiers as slug, domain or subdomain
+	taken := map[string]int64{"LOWER(subdomain) IN": 1}
+	fake := &sagaDriver{counts: taken}
+	tenantService := services.NewTenantService(openFakeDB(t, fake))
+
+	acme := "acme"
+	_, err := tenantService.CreateTenant(services.CreateTenantInput{Name: "Globex", Slug: "globex", Domain: &acme, Isolation: "schema"})
+	if !errors.Is(err, services.ErrTenantIdentifierTaken) {
+		t.Fatalf("Expected ErrTenantIdentifierTaken creating a tenant, got %v", err)
+	}
+	if index := fake.index(`INSERT INTO`, 0); index >= 0 {
+		t.Fatalf("Expected no tenant to be created, got %s", fake.statements[index])
+	}
+	check := fake.index("LOWER(subdomain) IN", 0)
+	if check < 0 || !strings.Contains(fake.statements[check], "id <>") {
+		t.Fatalf("Expected identifiers to be checked against other tenants, got:\n%s", strings.Join(fake.statements, "\n"))
+	}
+
+	// Changing the domain or subdomain of a tenant is checked the same way
+	tenantID := uuid.New()
+	fake = &sagaDriver{counts: taken, tenant: []driver.Value{tenantID.String(), "Globex", "globex", "active"}}
+	tenantService = services.NewTenantService(openFakeDB(t, fake))
+	if _, err := tenantService.UpdateTenant(tenantID, services.UpdateTenantInput{Subdomain: &acme}); !errors.Is(err, services.ErrTenantIdentifierTaken) {
+		t.Fatalf("Expected ErrTenantIdentifierTaken updating a tenant, got %v", err)
+	}
+	if index := fake.index(`UPDATE "system"."tenants"`, 0); index >= 0 {
+		t.Fatalf("Expected the tenant not to be saved, got %s", fake.statements[index])
+	}
+
+	// Without a collision the tenant is saved
+	fake = &sagaDriver{tenant: []driver.Value{tenantID.String(), "Globex", "globex", "active"}}
+	tenantService = services.NewTenantService(openFakeDB(t, fake))
+	if _, err := tenantService.UpdateTenant(tenantID, services.UpdateTenantInput{Subdomain: &acme}); err != nil {
+		t.Fatalf("Expected the tenant to be updated, got %v", err)
+	}
+
+	// Resolution prefers an exact domain, then a subdomain, then a slug
+	fake = &sagaDriver{tenant: []driver.Value{tenantID.String(), "Globex", "globex", "active"}}
+	tenantService = services.NewTenantService(openFakeDB(t, fake))
+	if _, err := tenantService.ResolveTenant("acme"); err != nil {
+		t.Fatalf("Expected the tenant to resolve, got %v", err)
+	}
+	resolve := fake.index(`FROM "system"."tenants"`, 0)
+	if resolve < 0 || !strings.Contains(fake.statements[resolve], "ORDER BY CASE WHEN LOWER(domain)") {
+		t.Fatalf("Expected tenant resolution in a fixed order, got:\n%s", strings.Join(fake.statements, "\n"))
+	}
+
+	t.Log("✓ Tenant slugs, domains and subdomains cannot route to another tenant")
+}
+
+func TestEnterpriseTenantWithoutDatabaseSettings(t *testing.T) {
+	planID := uuid.New()
+	fake := &sagaDriver{
+		plan:      []driver.Value{planID.String(), "Enterprise", "database"},
+		tenant:    []driver.Value{uuid.NewString(), "ACME Corporation", "acme", "active"},
+		isolation: "schema",
+	}
+	db := openFakeDB(t, fake)
+	if err := db.Use(tenancy.NewRouter(tenancy.NewDatabasePerTenant(func(database.Config) (*gorm.DB, error) {
+		t.Fatal("Expected no tenant database to be connected")
+		return nil, nil
+	}))); err != nil {
+		t.Fatalf("Failed to register router: %v", err)
+	}
+
+	app := fiber.New()
+	app.Post("/tenants", handlers.NewTenantHandler(services.NewTenantService(db)).CreateTenant)
+	create := func(body string) *http.Response {
+		req, _ := http.NewRequest("POST", "/tenants", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := app.Test(req, 5000)
+		if err != nil {
+			t.Fatalf("Request failed: %v", err)
+		}
+		return resp
+	}
+
+	// The plan asks for a dedicated database, but without its settings the tenant gets a schema
+	resp := create(fmt.Sprintf(`{"name": "ACME Corporation", "slug": "acme", "plan_id": "%s"}`, planID))
+	if resp.StatusCode != 201 {
+		t.Fatalf("Expected 201 creating an Enterprise tenant without database settings, got %d", resp.StatusCode)
+	}
+	insert := fake.index(`INSERT INTO "system"."tenants"`, 0)
+	if insert < 0 {
+		t.Fatal("Expected the tenant to be created")
+	}
+	isolated := false
+	for _, arg := range fake.args[insert] {
+		isolated = isolated || arg.Value == string(tenancy.IsolationSchema)
+	}
+	if !isolated {
+		t.Fatalf("Expected schema isolation, got args %v", fake.args[insert])
+	}
+
+	// Asking for a dedicated database explicitly without its settings is a validation error
+	resp = create(`{"name": "Globex", "slug": "globex", "isolation": "database"}`)
+	if resp.StatusCode != 400 {
+		t.Fatalf("Expected 400 for database isolation without settings, got %d", resp.StatusCode)
+	}
+	var body map[string]string
+	json.NewDecoder(resp.Body).Decode(&body)
+	if !strings.Contains(body["message"], `"database" connection settings`) {
+		t.Fatalf("Expected the missing database settings to be named, got %q", body["message"])
+	}
+
+	t.Log("✓ Enterprise tenants without database settings get a schema of their own")
+}
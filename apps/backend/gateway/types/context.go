@@ -5,6 +5,8 @@ import (
 	"io"
 	"strconv"
 	"strings"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
 )
 
 // TenantID represents a tenant identifier with validation
@@ -69,7 +71,8 @@ type TenantContext struct {
 	ID       TenantID `json:"id"`
 	Slug     string   `json:"slug"`
 	Name     string   `json:"name"`
-	Schema   string   `json:"schema"`
+	Schema    string   `json:"schema"`
+	Isolation string   `json:"isolation"`
 	Status   string   `json:"status"`
 	PlanID   string   `json:"plan_id"`
 	Features []string `json:"features"`
@@ -92,14 +95,18 @@ func (tc *TenantContext) HasFeature(feature string) bool {
 
 // UserContext represents the current user context for a request
 type UserContext struct {
-	ID          string    `json:"id"`
-	TenantID    TenantID  `json:"tenant_id"`
-	Email       string    `json:"email"`
-	FirstName   string    `json:"first_name"`
-	LastName    string    `json:"last_name"`
-	Roles       []string  `json:"roles"`
-	Permissions []string  `json:"permissions"`
-	IsAdmin     bool      `json:"is_admin"`
+	ID               string            `json:"id"`
+	TenantID         TenantID          `json:"tenant_id"`
+	Email            string            `json:"email"`
+	FirstName        string            `json:"first_name"`
+	LastName         string            `json:"last_name"`
+	Roles            []string          `json:"roles"`
+	Permissions      []string          `json:"permissions"`
+	IsAdmin          bool              `json:"is_admin"`
+	IsServiceAccount bool              `json:"is_service_account"`        // Authenticated with an API key of a service account
+	ImpersonatorID   string            `json:"impersonator_id,omitempty"` // System user acting as this user
+	TokenID          string            `json:"token_id"`                  // ID of the access token, identifies the current session
+	Attributes       map[string]string `json:"attributes,omitempty"`      // Compared against records by access policies
 }
 
 // HasRole checks if the user has a specific role
@@ -112,18 +119,14 @@ func (uc *UserContext) HasRole(role string) bool {
 	return false
 }
 
-// HasPermission checks if the user has a specific permission
+// HasPermission checks if the user has a specific permission, directly or through a
+// wildcard or implied permission
 func (uc *UserContext) HasPermission(permission string) bool {
 	if uc.IsAdmin {
 		return true
 	}
 	
-	for _, p := range uc.Permissions {
-		if p == permission {
-			return true
-		}
-	}
-	return false
+	return models.PermissionsGrant(uc.Permissions, permission)
 }
 
 // CanAccessResource checks if user can access a resource with action
@@ -132,6 +135,16 @@ func (uc *UserContext) CanAccessResource(resource, action string) bool {
 	return uc.HasPermission(permission)
 }
 
+// PolicySubject returns the user as subject of the tenant's access policies
+func (uc *UserContext) PolicySubject() *models.PolicySubject {
+	return &models.PolicySubject{
+		UserID:       uc.ID,
+		Roles:        uc.Roles,
+		Attributes:   uc.Attributes,
+		Unrestricted: uc.IsAdmin,
+	}
+}
+
 // RequestContext combines tenant and user context for GraphQL resolvers
 type RequestContext struct {
 	Tenant *TenantContext `json:"tenant,omitempty"`
@@ -143,9 +156,14 @@ func (rc *RequestContext) IsAuthenticated() bool {
 	return rc.User != nil
 }
 
+// IsImpersonated checks if a system user is acting as the user of the request
+func (rc *RequestContext) IsImpersonated() bool {
+	return rc.User != nil && rc.User.ImpersonatorID != ""
+}
+
 // IsTenantAdmin checks if the user is an admin within their tenant
 func (rc *RequestContext) IsTenantAdmin() bool {
-	return rc.User != nil && rc.User.HasRole("admin")
+	return rc.User != nil && (rc.User.HasRole("admin") || rc.User.HasRole("tenant_admin"))
 }
 
 // IsSystemAdmin checks if the user is a system-level admin
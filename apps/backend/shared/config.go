@@ -1,5 +1,7 @@
 package shared
 
+import "fmt"
+
 // Database configuration
 type DatabaseConfig struct {
 	Host     string
@@ -24,6 +26,11 @@ type RedisConfig struct {
 	DB       int
 }
 
+// Addr returns the host:port address of the Redis server
+func (c RedisConfig) Addr() string {
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
 // Application configuration
 type Config struct {
 	Database DatabaseConfig
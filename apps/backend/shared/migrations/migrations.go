@@ -0,0 +1,51 @@
+// Package migrations applies the versioned SQL migrations of the system schema and of
+// every tenant schema. New schema changes go in a new numbered file of system/ or
+// tenant/; files that have shipped are never edited.
+package migrations
+
+import (
+	"embed"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// SystemSchema is the schema holding the platform-wide tables
+const SystemSchema = "system"
+
+//go:embed system/*.sql tenant/*.sql
+var files embed.FS
+
+// SystemMigrations returns the migrations of the system schema
+func SystemMigrations() ([]Migration, error) {
+	return Load(files, "system")
+}
+
+// TenantMigrations returns the migrations applied to every tenant schema
+func TenantMigrations() ([]Migration, error) {
+	return Load(files, "tenant")
+}
+
+// NewSystemMigrator creates a migrator for the system schema
+func NewSystemMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := SystemMigrations()
+	if err != nil {
+		return nil, err
+	}
+	return NewMigrator(db, migrations), nil
+}
+
+// NewTenantMigrator creates a migrator for tenant schemas
+func NewTenantMigrator(db *gorm.DB) (*Migrator, error) {
+	migrations, err := TenantMigrations()
+	if err != nil {
+		return nil, err
+	}
+	return NewMigrator(db, migrations), nil
+}
+
+// TenantSchema returns the name of a tenant's schema
+func TenantSchema(tenantID uuid.UUID) string {
+	return "tenant_" + strings.ReplaceAll(tenantID.String(), "-", "_")
+}
@@ -0,0 +1,298 @@
+package migrations
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migration errors
+var (
+	// ErrInvalidSchemaName is returned for schema names that are not safe identifiers
+	ErrInvalidSchemaName = errors.New("invalid schema name")
+	// ErrUnknownMigration is returned when a schema has applied a version this build does not ship
+	ErrUnknownMigration = errors.New("schema has applied an unknown migration")
+)
+
+// MigrationsTable records the migrations applied to a schema, inside that schema
+const MigrationsTable = "schema_migrations"
+
+var (
+	migrationFilePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.sql$`)
+	schemaNamePattern    = regexp.MustCompile(`^[a-z_][a-z0-9_]{0,62}$`)
+)
+
+// Migration is a versioned SQL script
+type Migration struct {
+	Version int    `json:"version"`
+	Name    string `json:"name"`
+	SQL     string `json:"-"`
+}
+
+// AppliedMigration is a migration recorded as applied to a schema
+type AppliedMigration struct {
+	Version   int       `json:"version" gorm:"primaryKey"`
+	Name      string    `json:"name"`
+	AppliedAt time.Time `json:"applied_at"`
+}
+
+// Status reports the migrations applied to a schema and those still pending
+type Status struct {
+	Schema  string             `json:"schema"`
+	Version int                `json:"version"` // Latest applied version, 0 for none
+	Applied []AppliedMigration `json:"applied"`
+	Pending []Migration        `json:"pending"`
+}
+
+// UpToDate reports whether every known migration has been applied
+func (s *Status) UpToDate() bool {
+	return len(s.Pending) == 0
+}
+
+// Load reads the migrations of a directory. Files are named NNN_name.sql and are
+// applied in order of their version number.
+func Load(fsys fs.FS, dir string) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %v", err)
+	}
+
+	var migrations []Migration
+	seen := make(map[int]string)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+		version, err := strconv.Atoi(match[1])
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("invalid migration version: %s", entry.Name())
+		}
+		if other, ok := seen[version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %d: %s and %s", version, other, entry.Name())
+		}
+		seen[version] = entry.Name()
+
+		sql, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %v", entry.Name(), err)
+		}
+		migrations = append(migrations, Migration{Version: version, Name: match[2], SQL: string(sql)})
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Migrator applies a set of migrations to database schemas
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// NewMigrator creates a migrator for migrations sorted by version
+func NewMigrator(db *gorm.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}
+}
+
+// Migrations returns the migrations the migrator applies
+func (m *Migrator) Migrations() []Migration {
+	return m.migrations
+}
+
+// Migrate creates a schema when needed and applies its pending migrations in a single
+// transaction with search_path set to the schema, so either all of them are applied
+// or none. Concurrent calls for the same schema wait for each other.
+func (m *Migrator) Migrate(schema string) ([]Migration, error) {
+	quoted, err := QuoteIdentifier(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// Migrations may run inside a caller's transaction, e.g. while creating a tenant,
+	// whose search_path is restored once they are applied
+	var previous string
+	if err := m.db.Raw("SELECT current_setting('search_path')").Scan(&previous).Error; err != nil {
+		return nil, fmt.Errorf("failed to read search path: %v", err)
+	}
+
+	var applied []Migration
+	err = m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", MigrationsTable+":"+schema).Error; err != nil {
+			return fmt.Errorf("failed to lock schema: %v", err)
+		}
+		if err := tx.Exec("CREATE SCHEMA IF NOT EXISTS " + quoted).Error; err != nil {
+			return fmt.Errorf("failed to create schema: %v", err)
+		}
+		if err := setSearchPath(tx, quoted+", public"); err != nil {
+			return err
+		}
+		if err := createMigrationsTable(tx, quoted); err != nil {
+			return err
+		}
+
+		pending, err := m.pending(tx, schema)
+		if err != nil {
+			return err
+		}
+		for _, migration := range pending {
+			if err := tx.Exec(migration.SQL).Error; err != nil {
+				return fmt.Errorf("failed to apply migration %03d_%s: %v", migration.Version, migration.Name, err)
+			}
+			record := AppliedMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}
+			if err := tx.Table(schema + "." + MigrationsTable).Create(&record).Error; err != nil {
+				return fmt.Errorf("failed to record migration %03d_%s: %v", migration.Version, migration.Name, err)
+			}
+		}
+		applied = pending
+		return setSearchPath(tx, previous)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+// Status reports the migrations applied to a schema and those pending. A schema that
+// does not exist yet has every migration pending.
+func (m *Migrator) Status(schema string) (*Status, error) {
+	quoted, err := QuoteIdentifier(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &Status{Schema: schema, Applied: []AppliedMigration{}, Pending: []Migration{}}
+	exists, err := migrationsTableExists(m.db, quoted)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		if err := m.db.Table(schema + "." + MigrationsTable).Order("version").Find(&status.Applied).Error; err != nil {
+			return nil, fmt.Errorf("failed to list applied migrations: %v", err)
+		}
+	}
+
+	versions := make(map[int]bool, len(status.Applied))
+	for _, migration := range status.Applied {
+		versions[migration.Version] = true
+		if migration.Version > status.Version {
+			status.Version = migration.Version
+		}
+	}
+	for _, migration := range m.migrations {
+		if !versions[migration.Version] {
+			status.Pending = append(status.Pending, migration)
+		}
+	}
+	return status, nil
+}
+
+// Baseline records the migrations up to a version as applied without running them,
+// for schemas that were created before the migrator existed
+func (m *Migrator) Baseline(schema string, version int) error {
+	quoted, err := QuoteIdentifier(schema)
+	if err != nil {
+		return err
+	}
+
+	return m.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", MigrationsTable+":"+schema).Error; err != nil {
+			return fmt.Errorf("failed to lock schema: %v", err)
+		}
+		if err := createMigrationsTable(tx, quoted); err != nil {
+			return err
+		}
+		pending, err := m.pending(tx, schema)
+		if err != nil {
+			return err
+		}
+		for _, migration := range pending {
+			if migration.Version > version {
+				break
+			}
+			record := AppliedMigration{Version: migration.Version, Name: migration.Name, AppliedAt: time.Now()}
+			if err := tx.Table(schema + "." + MigrationsTable).Create(&record).Error; err != nil {
+				return fmt.Errorf("failed to record migration %03d_%s: %v", migration.Version, migration.Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// pending returns the migrations not yet applied to a schema
+func (m *Migrator) pending(tx *gorm.DB, schema string) ([]Migration, error) {
+	var versions []int
+	if err := tx.Table(schema+"."+MigrationsTable).Pluck("version", &versions).Error; err != nil {
+		return nil, fmt.Errorf("failed to list applied migrations: %v", err)
+	}
+
+	applied := make(map[int]bool, len(versions))
+	for _, version := range versions {
+		applied[version] = true
+	}
+
+	var pending []Migration
+	for _, migration := range m.migrations {
+		if applied[migration.Version] {
+			delete(applied, migration.Version)
+			continue
+		}
+		pending = append(pending, migration)
+	}
+	for version := range applied {
+		return nil, fmt.Errorf("%w: version %d", ErrUnknownMigration, version)
+	}
+	return pending, nil
+}
+
+// createMigrationsTable creates the table recording the migrations applied to a schema
+func createMigrationsTable(tx *gorm.DB, quoted string) error {
+	err := tx.Exec(`CREATE TABLE IF NOT EXISTS ` + quoted + `.` + MigrationsTable + ` (
+    version INTEGER PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    applied_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+)`).Error
+	if err != nil {
+		return fmt.Errorf("failed to create migrations table: %v", err)
+	}
+	return nil
+}
+
+// migrationsTableExists checks whether a schema has a migrations table
+func migrationsTableExists(db *gorm.DB, quoted string) (bool, error) {
+	var exists bool
+	if err := db.Raw("SELECT to_regclass(?) IS NOT NULL", quoted+"."+MigrationsTable).Scan(&exists).Error; err != nil {
+		return false, fmt.Errorf("failed to check migrations table: %v", err)
+	}
+	return exists, nil
+}
+
+// QuoteIdentifier validates a schema name and quotes it for use in SQL
+func QuoteIdentifier(name string) (string, error) {
+	if !schemaNamePattern.MatchString(name) || strings.HasPrefix(name, "pg_") {
+		return "", fmt.Errorf("%w: %q", ErrInvalidSchemaName, name)
+	}
+	return `"` + name + `"`, nil
+}
+
+// setSearchPath sets the search_path until the end of the current transaction
+func setSearchPath(tx *gorm.DB, searchPath string) error {
+	if err := tx.Exec("SELECT set_config('search_path', ?, true)", searchPath).Error; err != nil {
+		return fmt.Errorf("failed to set search path: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+-- Audit log of system user actions, e.g. while impersonating tenant users
+
+CREATE TABLE IF NOT EXISTS system.audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    action VARCHAR(100) NOT NULL, -- impersonation.started, impersonation.request, impersonation.denied
+    impersonator_id UUID REFERENCES system.system_users(id) ON DELETE SET NULL,
+    tenant_id UUID REFERENCES system.tenants(id) ON DELETE SET NULL,
+    user_id UUID,
+    token_id VARCHAR(100),
+    method VARCHAR(10),
+    path TEXT,
+    status INTEGER,
+    ip_address VARCHAR(45),
+    details JSONB,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_impersonator_id ON system.audit_logs(impersonator_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_tenant_id ON system.audit_logs(tenant_id, created_at);
@@ -0,0 +1,28 @@
+-- Tenant isolation strategies: a tenant's tables live in the shared tenant schema
+-- (rows scoped by tenant_id), in a schema of its own, or in a database of its own.
+-- A tenant keeps the isolation it was created with.
+
+ALTER TABLE system.tenants
+    ADD COLUMN isolation VARCHAR(20) NOT NULL DEFAULT 'schema'
+    CHECK (isolation IN ('shared', 'schema', 'database'));
+
+-- The isolation of the tenants created on a plan; premium plans get stronger isolation
+ALTER TABLE system.plans
+    ADD COLUMN isolation VARCHAR(20) NOT NULL DEFAULT 'schema'
+    CHECK (isolation IN ('shared', 'schema', 'database'));
+
+UPDATE system.plans SET isolation = 'shared' WHERE name = 'Basic';
+UPDATE system.plans SET isolation = 'database' WHERE name = 'Enterprise';
+
+-- Connection settings of the tenants with a dedicated database
+CREATE TABLE system.tenant_databases (
+    tenant_id UUID PRIMARY KEY REFERENCES system.tenants(id) ON DELETE CASCADE,
+    host VARCHAR(255) NOT NULL,
+    port INTEGER NOT NULL DEFAULT 5432,
+    username VARCHAR(255) NOT NULL,
+    password VARCHAR(255) NOT NULL,
+    database VARCHAR(255) NOT NULL,
+    ssl_mode VARCHAR(20) NOT NULL DEFAULT 'require',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
@@ -0,0 +1,29 @@
+-- Progress of tenant onboardings. A tenant is created in steps (tenant row, schema,
+-- roles, first admin, trial subscription, modules, welcome notification); each applied
+-- step is recorded so an interrupted onboarding can be resumed or rolled back.
+
+CREATE TABLE system.tenant_onboardings (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL, -- No foreign key: the tenant row is created by the onboarding
+    slug VARCHAR(100) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'running'
+        CHECK (status IN ('running', 'compensating', 'completed', 'failed')),
+    step VARCHAR(50),
+    steps JSONB NOT NULL DEFAULT '[]',
+    input JSONB NOT NULL DEFAULT '{}',
+    error TEXT,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    completed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_onboardings_tenant_id ON system.tenant_onboardings(tenant_id);
+
+-- Onboardings to resume after a crash
+CREATE INDEX idx_tenant_onboardings_unfinished ON system.tenant_onboardings(locked_until)
+    WHERE status IN ('running', 'compensating');
+
+-- A slug is onboarded by one onboarding at a time
+CREATE UNIQUE INDEX idx_tenant_onboardings_active_slug ON system.tenant_onboardings(slug)
+    WHERE status IN ('running', 'compensating');
@@ -0,0 +1,34 @@
+-- Tenant deletions. A deleted tenant is marked pending_deletion and its data exported;
+-- after a grace period during which it can be restored, its schema and system rows are
+-- purged. The deletion row is kept as the record of the purge.
+
+CREATE TABLE system.tenant_deletions (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL, -- No foreign key: the tenant row is purged
+    slug VARCHAR(100) NOT NULL,
+    isolation VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'restored', 'purged')),
+    previous_status VARCHAR(50) NOT NULL,
+    requested_by UUID, -- System user who asked for the deletion
+    reason TEXT,
+    purge_after TIMESTAMP WITH TIME ZONE NOT NULL,
+    export_path TEXT,
+    export_size BIGINT NOT NULL DEFAULT 0,
+    exported_at TIMESTAMP WITH TIME ZONE,
+    restored_at TIMESTAMP WITH TIME ZONE,
+    purged_at TIMESTAMP WITH TIME ZONE,
+    error TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_tenant_deletions_tenant_id ON system.tenant_deletions(tenant_id);
+
+-- Deletions to purge once their grace period ends
+CREATE INDEX idx_tenant_deletions_purge_after ON system.tenant_deletions(purge_after)
+    WHERE status = 'pending';
+
+-- A tenant has one pending deletion at a time
+CREATE UNIQUE INDEX idx_tenant_deletions_pending_tenant ON system.tenant_deletions(tenant_id)
+    WHERE status = 'pending';
@@ -85,7 +85,7 @@ CREATE TABLE departments (
     tenant_id UUID NOT NULL,
     name VARCHAR(255) NOT NULL,
     description TEXT,
-    manager_id UUID REFERENCES employees(id),
+    manager_id UUID, -- References employees(id), added below
     created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
     updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
     deleted_at TIMESTAMP WITH TIME ZONE,
@@ -116,6 +116,10 @@ CREATE TABLE employees (
     UNIQUE(tenant_id, email)
 );
 
+ALTER TABLE departments
+ADD CONSTRAINT fk_departments_manager_id
+FOREIGN KEY (manager_id) REFERENCES employees(id);
+
 -- Product categories table (for POS module)  
 CREATE TABLE product_categories (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
@@ -0,0 +1,7 @@
+-- Multi-factor authentication of tenant users. Users are pending until their email
+-- address is verified.
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS mfa_enabled BOOLEAN DEFAULT false,
+    ADD COLUMN IF NOT EXISTS mfa_secret VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS mfa_recovery_codes JSONB DEFAULT '[]'; -- SHA-256 hashes of unused recovery codes
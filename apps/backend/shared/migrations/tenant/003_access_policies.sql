@@ -0,0 +1,17 @@
+-- Access policies restricting the records of a resource that roles can act on
+
+CREATE TABLE IF NOT EXISTS access_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    tenant_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    description TEXT,
+    resource VARCHAR(100) NOT NULL, -- e.g. customers, or * for every resource
+    action VARCHAR(50) NOT NULL, -- e.g. read, or * for every action
+    roles JSONB DEFAULT '[]', -- role names the policy applies to, empty for everyone
+    conditions JSONB NOT NULL, -- [{"type": "owner|attribute|tag", "field": ..., "attribute": ..., "value": ...}]
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    deleted_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX IF NOT EXISTS idx_access_policies_tenant_id ON access_policies(tenant_id);
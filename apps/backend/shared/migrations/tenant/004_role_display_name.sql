@@ -0,0 +1,3 @@
+-- Display names of roles, shown instead of their names
+
+ALTER TABLE roles ADD COLUMN IF NOT EXISTS display_name VARCHAR(255);
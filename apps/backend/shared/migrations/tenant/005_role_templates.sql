@@ -0,0 +1,11 @@
+-- Roles created from the templates of system.role_templates, and the template version
+-- they were last updated to. Customised roles are not changed by template updates.
+
+ALTER TABLE roles
+    ADD COLUMN IF NOT EXISTS template_id UUID,
+    ADD COLUMN IF NOT EXISTS template_version INTEGER,
+    ADD COLUMN IF NOT EXISTS customized BOOLEAN DEFAULT false;
+
+-- Every tenant is seeded with a system role per template, the placeholder roles of the
+-- schema template belong to no tenant
+DELETE FROM roles WHERE tenant_id = '00000000-0000-0000-0000-000000000000';
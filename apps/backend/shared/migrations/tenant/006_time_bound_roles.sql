@@ -0,0 +1,7 @@
+-- Role assignments limited to a period. Expired assignments are removed by a sweeper.
+
+ALTER TABLE user_roles
+    ADD COLUMN IF NOT EXISTS valid_from TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN IF NOT EXISTS valid_until TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX IF NOT EXISTS idx_user_roles_valid_until ON user_roles(valid_until) WHERE valid_until IS NOT NULL;
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Audit log actions
+const (
+	AuditImpersonationStarted = "impersonation.started"
+	AuditImpersonatedRequest  = "impersonation.request"
+	AuditImpersonationDenied  = "impersonation.denied"
+
+	AuditTenantDeletionRequested = "tenant.deletion_requested"
+	AuditTenantExported          = "tenant.exported"
+	AuditTenantExportDownloaded  = "tenant.export_downloaded"
+	AuditTenantRestored          = "tenant.restored"
+	AuditTenantPurged            = "tenant.purged"
+	AuditTenantPurgeFailed       = "tenant.purge_failed"
+)
+
+// AuditLog records an action of a system user, e.g. while impersonating a tenant user or
+// deleting a tenant
+type AuditLog struct {
+	ID             uuid.UUID         `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Action         string            `json:"action" gorm:"not null"`
+	ImpersonatorID *uuid.UUID        `json:"impersonator_id" gorm:"type:uuid"` // System user acting as UserID
+	TenantID       *uuid.UUID        `json:"tenant_id" gorm:"type:uuid"`
+	UserID         *uuid.UUID        `json:"user_id" gorm:"type:uuid"`
+	TokenID        string            `json:"token_id"`
+	Method         string            `json:"method"`
+	Path           string            `json:"path"`
+	Status         int               `json:"status"`
+	IPAddress      string            `json:"ip_address"`
+	Details        map[string]string `json:"details,omitempty" gorm:"serializer:json"`
+	CreatedAt      time.Time         `json:"created_at"`
+}
+
+// TableName returns the table name for AuditLog
+func (AuditLog) TableName() string {
+	return "system.audit_logs"
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Customer represents a customer of a tenant (CRM module)
+type Customer struct {
+	ID        uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID  uuid.UUID      `json:"tenant_id" gorm:"type:uuid;not null"`
+	Name      string         `json:"name" gorm:"not null"`
+	Email     *string        `json:"email"`
+	Phone     *string        `json:"phone"`
+	Address   *string        `json:"address"`
+	Company   *string        `json:"company"`
+	Status    string         `json:"status" gorm:"default:'lead'"` // lead, prospect, active, inactive, churned
+	Tags      []string       `json:"tags" gorm:"serializer:json"`
+	Notes     *string        `json:"notes"`
+	CreatedBy *uuid.UUID     `json:"created_by" gorm:"type:uuid"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relationships
+	Creator *TenantUser `json:"creator,omitempty" gorm:"foreignKey:CreatedBy"`
+}
+
+func (Customer) TableName() string {
+	return "customers"
+}
+
+// PolicyAttributes returns the attributes access policies can restrict customers by
+func (c *Customer) PolicyAttributes() map[string]interface{} {
+	return map[string]interface{}{
+		"id":         c.ID,
+		"status":     c.Status,
+		"company":    c.Company,
+		"tags":       c.Tags,
+		"created_by": c.CreatedBy,
+	}
+}
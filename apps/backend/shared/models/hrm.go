@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Department represents a department of a tenant's organization (HRM module)
+type Department struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID    uuid.UUID      `json:"tenant_id" gorm:"type:uuid;not null"`
+	Name        string         `json:"name" gorm:"not null"`
+	Description *string        `json:"description"`
+	ManagerID   *uuid.UUID     `json:"manager_id" gorm:"type:uuid"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+}
+
+// Employee represents an employee of a tenant (HRM module)
+type Employee struct {
+	ID           uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID     uuid.UUID      `json:"tenant_id" gorm:"type:uuid;not null"`
+	EmployeeID   string         `json:"employee_id" gorm:"not null"`
+	FirstName    string         `json:"first_name" gorm:"not null"`
+	LastName     string         `json:"last_name" gorm:"not null"`
+	Email        string         `json:"email" gorm:"not null"`
+	Phone        *string        `json:"phone"`
+	DepartmentID *uuid.UUID     `json:"department_id" gorm:"type:uuid"`
+	Position     string         `json:"position" gorm:"not null"`
+	Salary       *float64       `json:"salary"`
+	HireDate     time.Time      `json:"hire_date" gorm:"type:date;not null"`
+	Status       string         `json:"status" gorm:"default:'active'"` // active, on_leave, terminated, resigned
+	ManagerID    *uuid.UUID     `json:"manager_id" gorm:"type:uuid"`
+	CreatedAt    time.Time      `json:"created_at"`
+	UpdatedAt    time.Time      `json:"updated_at"`
+	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relationships
+	Department *Department `json:"department,omitempty" gorm:"foreignKey:DepartmentID"`
+}
+
+func (Department) TableName() string {
+	return "departments"
+}
+
+func (Employee) TableName() string {
+	return "employees"
+}
+
+// PolicyAttributes returns the attributes access policies can restrict employees by
+func (e *Employee) PolicyAttributes() map[string]interface{} {
+	return map[string]interface{}{
+		"id":            e.ID,
+		"email":         e.Email,
+		"status":        e.Status,
+		"position":      e.Position,
+		"department_id": e.DepartmentID,
+		"manager_id":    e.ManagerID,
+	}
+}
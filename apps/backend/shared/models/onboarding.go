@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Onboarding statuses
+const (
+	OnboardingRunning      = "running"      // Steps are being applied
+	OnboardingCompensating = "compensating" // A step failed and the applied steps are being undone
+	OnboardingCompleted    = "completed"
+	OnboardingFailed       = "failed" // Every applied step was undone
+)
+
+// TenantOnboarding records the progress of creating a tenant. Every step is recorded once
+// it is applied, so an onboarding interrupted by a crash is resumed where it stopped, or
+// rolled back if a step had failed.
+type TenantOnboarding struct {
+	ID          uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID    uuid.UUID  `json:"tenant_id" gorm:"type:uuid;not null"` // Chosen upfront, so steps can be repeated
+	Slug        string     `json:"slug" gorm:"not null"`
+	Status      string     `json:"status" gorm:"not null;default:'running'"`
+	Step        string     `json:"step,omitempty"`                        // Step being applied or undone
+	Steps       []string   `json:"steps" gorm:"serializer:json;not null"` // Applied steps in order
+	Input       string     `json:"-" gorm:"type:jsonb;not null"`          // Cleared once the onboarding ends
+	Error       string     `json:"error,omitempty"`
+	LockedUntil *time.Time `json:"-"` // Lease of the process running the onboarding
+	CompletedAt *time.Time `json:"completed_at"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// TableName returns the table name for TenantOnboarding
+func (TenantOnboarding) TableName() string {
+	return "system.tenant_onboardings"
+}
+
+// Applied reports whether a step of the onboarding has been applied
+func (o *TenantOnboarding) Applied(step string) bool {
+	for _, applied := range o.Steps {
+		if applied == step {
+			return true
+		}
+	}
+	return false
+}
+
+// Finished reports whether the onboarding completed or was rolled back
+func (o *TenantOnboarding) Finished() bool {
+	return o.Status == OnboardingCompleted || o.Status == OnboardingFailed
+}
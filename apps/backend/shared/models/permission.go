@@ -0,0 +1,118 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PermissionWildcard matches any resource or action in a granted permission
+const PermissionWildcard = "*"
+
+// impliedActions lists the actions each action grants in addition to itself
+var impliedActions = map[string][]string{
+	"manage": {"write", "delete"},
+	"write":  {"read", "create", "update"},
+}
+
+// ModuleResources lists the resources of each module. A permission granted on a module
+// covers its resources, e.g. "crm:*" grants "customers:delete".
+var ModuleResources = map[string][]string{
+	"crm": {"customers", "orders"},
+	"hrm": {"employees", "departments"},
+	"pos": {"products", "orders"},
+}
+
+// PermissionGrants reports whether a granted permission covers the required one.
+// Granted permissions may use "*" for the resource, the action or both ("customers:*",
+// "*:read", "*"), name a module for its resources ("crm:*"), and actions imply weaker
+// ones, e.g. write implies read.
+func PermissionGrants(granted, required string) bool {
+	grantedResource, grantedAction := splitPermission(granted)
+	requiredResource, requiredAction := splitPermission(required)
+
+	if !resourceGrants(grantedResource, requiredResource) {
+		return false
+	}
+	return actionGrants(grantedAction, requiredAction)
+}
+
+// PermissionsGrant reports whether any of the granted permissions covers the required one
+func PermissionsGrant(granted []string, required string) bool {
+	for _, permission := range granted {
+		if PermissionGrants(permission, required) {
+			return true
+		}
+	}
+	return false
+}
+
+// NormalizePermission returns the canonical resource:action form of a permission
+func NormalizePermission(permission string) string {
+	resource, action := splitPermission(permission)
+	return resource + ":" + action
+}
+
+// ValidatePermission checks that a permission is resource:action or "*", where the
+// resource and action are either names or a whole "*"
+func ValidatePermission(permission string) error {
+	if strings.TrimSpace(permission) == PermissionWildcard {
+		return nil
+	}
+
+	parts := strings.Split(permission, ":")
+	if len(parts) != 2 {
+		return fmt.Errorf("permission must have the form resource:action")
+	}
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return fmt.Errorf("permission resource and action are required")
+		}
+		if part != PermissionWildcard && strings.Contains(part, PermissionWildcard) {
+			return fmt.Errorf("wildcards must replace a whole resource or action")
+		}
+	}
+	return nil
+}
+
+// resourceGrants reports whether a granted resource covers the required one, either
+// itself, a wildcard or the module holding it
+func resourceGrants(granted, required string) bool {
+	if granted == PermissionWildcard || granted == required {
+		return true
+	}
+	for _, resource := range ModuleResources[granted] {
+		if resource == required {
+			return true
+		}
+	}
+	return false
+}
+
+// actionGrants reports whether a granted action covers the required one, following implied actions
+func actionGrants(granted, required string) bool {
+	if granted == PermissionWildcard || granted == required {
+		return true
+	}
+	for _, implied := range impliedActions[granted] {
+		if actionGrants(implied, required) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitPermission splits a permission into its lowercase resource and action. A bare "*"
+// grants every action on every resource.
+func splitPermission(permission string) (string, string) {
+	permission = strings.ToLower(strings.TrimSpace(permission))
+	if permission == PermissionWildcard {
+		return PermissionWildcard, PermissionWildcard
+	}
+
+	resource, action, found := strings.Cut(permission, ":")
+	if !found {
+		return resource, ""
+	}
+	return strings.TrimSpace(resource), strings.TrimSpace(action)
+}
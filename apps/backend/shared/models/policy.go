@@ -0,0 +1,293 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Policy condition types
+const (
+	// PolicyConditionOwner holds when the record field equals the user's ID
+	PolicyConditionOwner = "owner"
+	// PolicyConditionAttribute holds when the record field equals one of the user's attributes
+	PolicyConditionAttribute = "attribute"
+	// PolicyConditionTag holds when the record's tag list field contains a tag
+	PolicyConditionTag = "tag"
+)
+
+// policyFieldPattern restricts condition fields to plain column names
+var policyFieldPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// policyRecords are the resources access policies can restrict. The attributes of their
+// records are the columns conditions can compare.
+var policyRecords = map[string]PolicyRecord{
+	"customers": &Customer{},
+	"employees": &Employee{},
+}
+
+// AccessPolicy restricts the records of a resource that users with the given roles can
+// act on. A record passes when it satisfies every condition of the policy.
+type AccessPolicy struct {
+	ID          uuid.UUID         `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID    uuid.UUID         `json:"tenant_id" gorm:"type:uuid;not null"`
+	Name        string            `json:"name" gorm:"not null"`
+	Description *string           `json:"description"`
+	Resource    string            `json:"resource" gorm:"not null"`                   // e.g. customers, or * for every resource
+	Action      string            `json:"action" gorm:"not null"`                     // e.g. read, or * for every action
+	Roles       []string          `json:"roles" gorm:"serializer:json"`               // Roles the policy applies to, empty for everyone
+	Conditions  []PolicyCondition `json:"conditions" gorm:"serializer:json;not null"` // All must hold
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt    `json:"-" gorm:"index"`
+}
+
+// PolicyCondition is a condition over a record attribute and the user
+type PolicyCondition struct {
+	Type      string `json:"type"`                // owner, attribute or tag
+	Field     string `json:"field"`               // Record attribute, e.g. created_by or department_id
+	Attribute string `json:"attribute,omitempty"` // User attribute compared by attribute and tag conditions
+	Value     string `json:"value,omitempty"`     // Fixed tag for tag conditions
+}
+
+// PolicySubject is the user access policies are evaluated for
+type PolicySubject struct {
+	UserID       string
+	Roles        []string
+	Attributes   map[string]string // e.g. department_id of the user's employee record
+	Unrestricted bool              // Administrators bypass policies
+}
+
+// PolicyRecord is a record access policies can be evaluated against
+type PolicyRecord interface {
+	PolicyAttributes() map[string]interface{}
+}
+
+func (AccessPolicy) TableName() string {
+	return "access_policies"
+}
+
+// Validate checks that the policy has a resource, an action and well-formed conditions
+func (p *AccessPolicy) Validate() error {
+	if strings.TrimSpace(p.Name) == "" {
+		return fmt.Errorf("policy name is required")
+	}
+	if err := ValidatePermission(p.Resource + ":" + p.Action); err != nil {
+		return fmt.Errorf("invalid policy resource or action: %v", err)
+	}
+	if len(p.Conditions) == 0 {
+		return fmt.Errorf("policy needs at least one condition")
+	}
+	resources, err := policyResources(p.Resource)
+	if err != nil {
+		return err
+	}
+
+	for _, condition := range p.Conditions {
+		if !policyFieldPattern.MatchString(condition.Field) {
+			return fmt.Errorf("invalid condition field: %q", condition.Field)
+		}
+		switch condition.Type {
+		case PolicyConditionOwner:
+		case PolicyConditionAttribute:
+			if condition.Attribute == "" {
+				return fmt.Errorf("attribute condition on %s needs a user attribute", condition.Field)
+			}
+		case PolicyConditionTag:
+			if condition.Value == "" && condition.Attribute == "" {
+				return fmt.Errorf("tag condition on %s needs a tag value or user attribute", condition.Field)
+			}
+		default:
+			return fmt.Errorf("unknown condition type: %q", condition.Type)
+		}
+		if err := condition.validateField(resources); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AppliesTo reports whether the policy restricts the subject's action on the resource
+func (p *AccessPolicy) AppliesTo(subject *PolicySubject, resource, action string) bool {
+	if subject.Unrestricted {
+		return false
+	}
+	if p.Resource != PermissionWildcard && !strings.EqualFold(p.Resource, resource) {
+		return false
+	}
+	if p.Action != PermissionWildcard && !strings.EqualFold(p.Action, action) {
+		return false
+	}
+	if len(p.Roles) == 0 {
+		return true
+	}
+
+	for _, role := range p.Roles {
+		for _, subjectRole := range subject.Roles {
+			if role == subjectRole {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Allows reports whether a record satisfies every condition of the policy for the subject
+func (p *AccessPolicy) Allows(subject *PolicySubject, record PolicyRecord) bool {
+	attributes := record.PolicyAttributes()
+	for _, condition := range p.Conditions {
+		value, exists := attributes[condition.Field]
+		if !exists || !condition.holds(subject, value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Scope returns the SQL condition selecting the records that satisfy the policy. Fields
+// are checked when the policy is created; one that is not a plain column name selects
+// nothing rather than reaching the SQL.
+func (p *AccessPolicy) Scope(subject *PolicySubject) (string, []interface{}) {
+	clauses := make([]string, 0, len(p.Conditions))
+	var args []interface{}
+	for _, condition := range p.Conditions {
+		expected, ok := condition.expected(subject)
+		if !ok || !policyFieldPattern.MatchString(condition.Field) {
+			return "1 = 0", nil
+		}
+		if condition.Type == PolicyConditionTag {
+			tags, _ := json.Marshal([]string{expected})
+			clauses = append(clauses, condition.Field+" @> ?")
+			args = append(args, string(tags))
+		} else {
+			clauses = append(clauses, condition.Field+" = ?")
+			args = append(args, expected)
+		}
+	}
+	return "(" + strings.Join(clauses, " AND ") + ")", args
+}
+
+// ApplicablePolicies returns the policies restricting the subject's action on the resource
+func ApplicablePolicies(policies []AccessPolicy, subject *PolicySubject, resource, action string) []AccessPolicy {
+	var applicable []AccessPolicy
+	for _, policy := range policies {
+		if policy.AppliesTo(subject, resource, action) {
+			applicable = append(applicable, policy)
+		}
+	}
+	return applicable
+}
+
+// PoliciesAllow reports whether the subject may act on the record. Without applicable
+// policies the role permissions alone decide, otherwise one policy has to allow it.
+func PoliciesAllow(policies []AccessPolicy, subject *PolicySubject, resource, action string, record PolicyRecord) bool {
+	applicable := ApplicablePolicies(policies, subject, resource, action)
+	if len(applicable) == 0 {
+		return true
+	}
+
+	for _, policy := range applicable {
+		if policy.Allows(subject, record) {
+			return true
+		}
+	}
+	return false
+}
+
+// policyResources returns the resources a policy on resource restricts, every one for
+// the wildcard
+func policyResources(resource string) ([]string, error) {
+	if resource == PermissionWildcard {
+		resources := make([]string, 0, len(policyRecords))
+		for name := range policyRecords {
+			resources = append(resources, name)
+		}
+		return resources, nil
+	}
+	if _, ok := policyRecords[resource]; !ok {
+		return nil, fmt.Errorf("access policies are not supported on %s", resource)
+	}
+	return []string{resource}, nil
+}
+
+// validateField checks that every resource has the condition's field, as a tag list for
+// tag conditions and as a single value otherwise
+func (c PolicyCondition) validateField(resources []string) error {
+	for _, resource := range resources {
+		value, exists := policyRecords[resource].PolicyAttributes()[c.Field]
+		if !exists {
+			return fmt.Errorf("unknown condition field %q for %s", c.Field, resource)
+		}
+		if _, tags := value.([]string); tags != (c.Type == PolicyConditionTag) {
+			return fmt.Errorf("condition field %q of %s cannot be used in a %s condition", c.Field, resource, c.Type)
+		}
+	}
+	return nil
+}
+
+// holds reports whether a record value satisfies the condition for the subject
+func (c PolicyCondition) holds(subject *PolicySubject, value interface{}) bool {
+	expected, ok := c.expected(subject)
+	if !ok {
+		return false
+	}
+
+	if c.Type == PolicyConditionTag {
+		tags, _ := value.([]string)
+		for _, tag := range tags {
+			if tag == expected {
+				return true
+			}
+		}
+		return false
+	}
+
+	actual := policyValue(value)
+	return actual != "" && actual == expected
+}
+
+// expected returns the value the record field has to match, and false when the subject
+// lacks the user attribute the condition compares against
+func (c PolicyCondition) expected(subject *PolicySubject) (string, bool) {
+	switch {
+	case c.Type == PolicyConditionOwner:
+		return subject.UserID, subject.UserID != ""
+	case c.Type == PolicyConditionTag && c.Attribute == "":
+		return c.Value, true
+	default:
+		value := subject.Attributes[c.Attribute]
+		return value, value != ""
+	}
+}
+
+// policyValue converts a scalar record attribute to its string form, with nil as empty
+func policyValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	case uuid.UUID:
+		if v == uuid.Nil {
+			return ""
+		}
+		return v.String()
+	case *uuid.UUID:
+		if v == nil {
+			return ""
+		}
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,41 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RoleTemplate is a system-wide role definition that is seeded into every new tenant and
+// can be cloned into custom roles. Version increases with every update of the template.
+type RoleTemplate struct {
+	ID          uuid.UUID `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	Name        string    `json:"name" gorm:"unique;not null"`
+	DisplayName string    `json:"display_name" gorm:"not null"`
+	Description *string   `json:"description"`
+	Module      string    `json:"module,omitempty"` // e.g. pos or hrm, empty for core roles
+	Permissions []string  `json:"permissions" gorm:"serializer:json;not null"`
+	Version     int       `json:"version" gorm:"default:1"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for RoleTemplate
+func (RoleTemplate) TableName() string {
+	return "system.role_templates"
+}
+
+// Validate checks that the template has a name and well-formed permissions
+func (t *RoleTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" || strings.TrimSpace(t.DisplayName) == "" {
+		return fmt.Errorf("template name and display name are required")
+	}
+	for _, permission := range t.Permissions {
+		if err := ValidatePermission(permission); err != nil {
+			return err
+		}
+	}
+	return nil
+}
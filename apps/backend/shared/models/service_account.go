@@ -0,0 +1,108 @@
+package models
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// APIKeyPrefix starts every API key, so keys can be told apart from JWTs
+const APIKeyPrefix = "zpk_"
+
+// ServiceAccount is a non-human identity of a tenant used by integrations. It is
+// granted a fixed set of permissions and authenticates with its API keys.
+type ServiceAccount struct {
+	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID    uuid.UUID      `json:"tenant_id" gorm:"type:uuid;not null"`
+	Name        string         `json:"name" gorm:"not null"`
+	Description *string        `json:"description"`
+	Permissions []string       `json:"permissions" gorm:"serializer:json;not null"`
+	AllowedIPs  []string       `json:"allowed_ips" gorm:"serializer:json"` // IPs or CIDR ranges, empty allows any address
+	CreatedBy   *uuid.UUID     `json:"created_by" gorm:"type:uuid"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// Relationships
+	Keys []APIKey `json:"keys,omitempty" gorm:"foreignKey:ServiceAccountID"`
+}
+
+// APIKey is a credential of a service account. Only a hash of the key is stored; the
+// key itself is shown once when it is created.
+type APIKey struct {
+	ID               uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID         uuid.UUID  `json:"tenant_id" gorm:"type:uuid;not null"`
+	ServiceAccountID uuid.UUID  `json:"service_account_id" gorm:"type:uuid;not null"`
+	Prefix           string     `json:"prefix" gorm:"unique;not null"` // Public part of the key used to look it up
+	KeyHash          string     `json:"-" gorm:"not null"`
+	ExpiresAt        *time.Time `json:"expires_at"`
+	LastUsedAt       *time.Time `json:"last_used_at"`
+	RevokedAt        *time.Time `json:"revoked_at"`
+	CreatedAt        time.Time  `json:"created_at"`
+}
+
+func (ServiceAccount) TableName() string {
+	return "service_accounts"
+}
+
+func (APIKey) TableName() string {
+	return "api_keys"
+}
+
+// Validate checks the name, permissions and IP allow-list of the service account
+func (a *ServiceAccount) Validate() error {
+	if strings.TrimSpace(a.Name) == "" {
+		return fmt.Errorf("name is required")
+	}
+	if len(a.Permissions) == 0 {
+		return fmt.Errorf("at least one permission is required")
+	}
+	for _, permission := range a.Permissions {
+		if err := ValidatePermission(permission); err != nil {
+			return err
+		}
+	}
+	for _, entry := range a.AllowedIPs {
+		if net.ParseIP(entry) == nil {
+			if _, _, err := net.ParseCIDR(entry); err != nil {
+				return fmt.Errorf("invalid IP address or range: %s", entry)
+			}
+		}
+	}
+	return nil
+}
+
+// AllowsIP reports whether requests from the address may use the account's keys
+func (a *ServiceAccount) AllowsIP(address string) bool {
+	if len(a.AllowedIPs) == 0 {
+		return true
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, entry := range a.AllowedIPs {
+		if allowed := net.ParseIP(entry); allowed != nil {
+			if allowed.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		if _, network, err := net.ParseCIDR(entry); err == nil && network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Active reports whether the key can be used at the given time
+func (k *APIKey) Active(at time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || at.Before(*k.ExpiresAt)
+}
@@ -24,6 +24,12 @@ func (SystemUser) TableName() string {
 	return "system.system_users"
 }
 
+// IsAdministrator reports whether the system user administers the platform. Support
+// staff sign in to the system tenant but do not get admin access.
+func (u *SystemUser) IsAdministrator() bool {
+	return u.Role == "super_admin" || u.Role == "admin"
+}
+
 // Tenant represents a tenant/organization in the system
 type Tenant struct {
 	ID         uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
@@ -34,7 +40,8 @@ type Tenant struct {
 	PlanID     *uuid.UUID     `json:"plan_id" gorm:"type:uuid"`
 	Plan       *Plan          `json:"plan,omitempty" gorm:"foreignKey:PlanID"`
 	Status     string         `json:"status" gorm:"default:'active'"` // active, suspended, trial, expired
-	Settings   map[string]interface{} `json:"settings" gorm:"type:jsonb;default:'{}'"`
+	Isolation  string         `json:"isolation" gorm:"default:'schema'"` // shared, schema, database; fixed once created
+	Settings   map[string]interface{} `json:"settings" gorm:"type:jsonb;serializer:json;default:'{}'"`
 	CreatedAt  time.Time      `json:"created_at"`
 	UpdatedAt  time.Time      `json:"updated_at"`
 	DeletedAt  gorm.DeletedAt `json:"-" gorm:"index"`
@@ -54,6 +61,7 @@ type Plan struct {
 	Features    map[string]interface{} `json:"features" gorm:"type:jsonb;default:'{}'"`
 	MaxUsers    *int           `json:"max_users"`
 	MaxStorage  *int64         `json:"max_storage"` // bytes
+	Isolation   string         `json:"isolation" gorm:"default:'schema'"` // isolation of the plan's new tenants
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
@@ -64,6 +72,24 @@ func (Plan) TableName() string {
 	return "system.plans"
 }
 
+// TenantDatabase holds the connection settings of a tenant isolated in a database of its own
+type TenantDatabase struct {
+	TenantID  uuid.UUID `json:"tenant_id" gorm:"type:uuid;primaryKey"`
+	Host      string    `json:"host" gorm:"not null"`
+	Port      int       `json:"port" gorm:"not null;default:5432"`
+	Username  string    `json:"username" gorm:"not null"`
+	Password  string    `json:"-" gorm:"not null"`
+	Database  string    `json:"database" gorm:"not null"`
+	SSLMode   string    `json:"ssl_mode" gorm:"default:'require'"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// TableName returns the table name for TenantDatabase
+func (TenantDatabase) TableName() string {
+	return "system.tenant_databases"
+}
+
 // Module represents available system modules
 type Module struct {
 	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
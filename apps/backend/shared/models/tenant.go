@@ -15,14 +15,23 @@ type TenantUser struct {
 	FirstName   string         `json:"first_name" gorm:"not null"`
 	LastName    string         `json:"last_name" gorm:"not null"`
 	Avatar      *string        `json:"avatar"`
-	Status      string         `json:"status" gorm:"default:'active'"` // active, inactive, suspended
+	Status      string         `json:"status" gorm:"default:'active'"` // pending, active, inactive, suspended
 	LastLoginAt *time.Time     `json:"last_login_at"`
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
 	
+	// Multi-factor authentication
+	MFAEnabled       bool     `json:"mfa_enabled" gorm:"column:mfa_enabled;default:false"`
+	MFASecret        *string  `json:"-" gorm:"column:mfa_secret"`
+	MFARecoveryCodes []string `json:"-" gorm:"column:mfa_recovery_codes;serializer:json"` // SHA-256 hashes of unused codes
+	
 	// Relationships
 	Roles       []Role         `json:"roles,omitempty" gorm:"many2many:user_roles;"`
+	
+	// RolesChangeAt is when a time-bound role assignment of the user starts or ends next,
+	// which changes Roles. It is set when the roles are loaded; nil when none is pending.
+	RolesChangeAt *time.Time `json:"-" gorm:"-"`
 }
 
 // Role represents a role within a tenant
@@ -30,12 +39,19 @@ type Role struct {
 	ID          uuid.UUID      `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
 	TenantID    uuid.UUID      `json:"tenant_id" gorm:"type:uuid;not null"`
 	Name        string         `json:"name" gorm:"not null"`
+	DisplayName string         `json:"display_name"`
 	Description *string        `json:"description"`
 	IsSystemRole bool          `json:"is_system_role" gorm:"default:false"`
 	CreatedAt   time.Time      `json:"created_at"`
 	UpdatedAt   time.Time      `json:"updated_at"`
 	DeletedAt   gorm.DeletedAt `json:"-" gorm:"index"`
 	
+	// Role template the role was seeded or cloned from. Template updates are applied
+	// to the role until the tenant customises it.
+	TemplateID      *uuid.UUID `json:"template_id,omitempty" gorm:"type:uuid"`
+	TemplateVersion int        `json:"template_version,omitempty"`
+	Customized      bool       `json:"customized" gorm:"default:false"`
+	
 	// Relationships
 	Permissions []Permission   `json:"permissions,omitempty" gorm:"many2many:role_permissions;"`
 	Users       []TenantUser   `json:"users,omitempty" gorm:"many2many:user_roles;"`
@@ -55,11 +71,22 @@ type Permission struct {
 	Roles       []Role         `json:"roles,omitempty" gorm:"many2many:role_permissions;"`
 }
 
-// UserRole represents the many-to-many relationship between users and roles
+// UserRole represents the many-to-many relationship between users and roles.
+// ValidFrom and ValidUntil limit the assignment to a period; nil bounds are open.
 type UserRole struct {
-	UserID    uuid.UUID `json:"user_id" gorm:"type:uuid;primaryKey"`
-	RoleID    uuid.UUID `json:"role_id" gorm:"type:uuid;primaryKey"`
-	AssignedAt time.Time `json:"assigned_at" gorm:"default:CURRENT_TIMESTAMP"`
+	UserID     uuid.UUID  `json:"user_id" gorm:"type:uuid;primaryKey"`
+	RoleID     uuid.UUID  `json:"role_id" gorm:"type:uuid;primaryKey"`
+	AssignedAt time.Time  `json:"assigned_at" gorm:"default:CURRENT_TIMESTAMP"`
+	ValidFrom  *time.Time `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+}
+
+// ActiveAt reports whether the assignment grants its role at the given time
+func (ur *UserRole) ActiveAt(at time.Time) bool {
+	if ur.ValidFrom != nil && at.Before(*ur.ValidFrom) {
+		return false
+	}
+	return ur.ValidUntil == nil || at.Before(*ur.ValidUntil)
 }
 
 // RolePermission represents the many-to-many relationship between roles and permissions
@@ -118,10 +145,12 @@ func (u *TenantUser) GetPermissions() []string {
 	return permissions
 }
 
+// HasPermission checks if any role of the user grants the permission, including wildcard
+// and implied permissions (see PermissionGrants)
 func (u *TenantUser) HasPermission(permissionName string) bool {
 	for _, role := range u.Roles {
 		for _, permission := range role.Permissions {
-			if permission.Name == permissionName {
+			if PermissionGrants(permission.Name, permissionName) {
 				return true
 			}
 		}
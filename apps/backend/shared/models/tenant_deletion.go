@@ -0,0 +1,45 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TenantStatusPendingDeletion is the status of a tenant waiting to be purged. Its users
+// can no longer sign in.
+const TenantStatusPendingDeletion = "pending_deletion"
+
+// Tenant deletion statuses
+const (
+	TenantDeletionPending  = "pending"  // Within the grace period, the tenant can be restored
+	TenantDeletionRestored = "restored" // The tenant was restored before it was purged
+	TenantDeletionPurged   = "purged"   // The tenant's data and system rows are gone
+)
+
+// TenantDeletion tracks the deletion of a tenant: its data is exported, kept for a grace
+// period during which the tenant can be restored, and then purged
+type TenantDeletion struct {
+	ID             uuid.UUID  `json:"id" gorm:"type:uuid;primaryKey;default:gen_random_uuid()"`
+	TenantID       uuid.UUID  `json:"tenant_id" gorm:"type:uuid;not null"` // No foreign key: outlives the tenant
+	Slug           string     `json:"slug" gorm:"not null"`
+	Isolation      string     `json:"isolation" gorm:"not null"`
+	Status         string     `json:"status" gorm:"not null;default:'pending'"`
+	PreviousStatus string     `json:"previous_status" gorm:"not null"` // Status the tenant gets back when restored
+	RequestedBy    *uuid.UUID `json:"requested_by" gorm:"type:uuid"`
+	Reason         *string    `json:"reason"`
+	PurgeAfter     time.Time  `json:"purge_after" gorm:"not null"`
+	ExportPath     string     `json:"-"`
+	ExportSize     int64      `json:"export_size"`
+	ExportedAt     *time.Time `json:"exported_at"`
+	RestoredAt     *time.Time `json:"restored_at"`
+	PurgedAt       *time.Time `json:"purged_at"`
+	Error          string     `json:"error,omitempty"` // Last failure to export or purge
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// TableName returns the table name for TenantDeletion
+func (TenantDeletion) TableName() string {
+	return "system.tenant_deletions"
+}
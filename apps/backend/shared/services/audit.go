@@ -0,0 +1,71 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+)
+
+// AuditService stores and lists the audit log
+type AuditService struct {
+	db *gorm.DB
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(db *gorm.DB) *AuditService {
+	return &AuditService{db: db}
+}
+
+// AuditLogFilter represents filters for listing audit log entries
+type AuditLogFilter struct {
+	ImpersonatorID *uuid.UUID `json:"impersonator_id"`
+	TenantID       *uuid.UUID `json:"tenant_id"`
+	UserID         *uuid.UUID `json:"user_id"`
+	Action         *string    `json:"action"`
+	Since          *time.Time `json:"since"`
+}
+
+// Record appends an entry to the audit log
+func (s *AuditService) Record(entry *models.AuditLog) error {
+	if err := s.db.Create(entry).Error; err != nil {
+		return fmt.Errorf("failed to record audit log: %v", err)
+	}
+	return nil
+}
+
+// ListAuditLogs returns audit log entries, newest first
+func (s *AuditService) ListAuditLogs(filter AuditLogFilter, offset, limit int) ([]*models.AuditLog, int64, error) {
+	query := s.db.Model(&models.AuditLog{})
+
+	// Apply filters
+	if filter.ImpersonatorID != nil {
+		query = query.Where("impersonator_id = ?", *filter.ImpersonatorID)
+	}
+	if filter.TenantID != nil {
+		query = query.Where("tenant_id = ?", *filter.TenantID)
+	}
+	if filter.UserID != nil {
+		query = query.Where("user_id = ?", *filter.UserID)
+	}
+	if filter.Action != nil {
+		query = query.Where("action = ?", *filter.Action)
+	}
+	if filter.Since != nil {
+		query = query.Where("created_at >= ?", *filter.Since)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit logs: %v", err)
+	}
+
+	var entries []*models.AuditLog
+	if err := query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&entries).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit logs: %v", err)
+	}
+	return entries, total, nil
+}
@@ -0,0 +1,103 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// CustomerService reads a tenant's customers, restricted by the tenant's access policies
+type CustomerService struct {
+	db       *tenancy.DB
+	tenantID uuid.UUID
+	policies *PolicyService
+}
+
+// NewCustomerService creates a new customer service for a specific tenant
+func NewCustomerService(db *gorm.DB, tenantID uuid.UUID) *CustomerService {
+	return &CustomerService{
+		db:       tenancy.New(db, tenantID),
+		tenantID: tenantID,
+		policies: NewPolicyService(db, tenantID),
+	}
+}
+
+// CustomerFilter represents filtering options for customers
+type CustomerFilter struct {
+	Status string   `json:"status"`
+	Tags   []string `json:"tags"`
+	Search string   `json:"search"`
+}
+
+// ListCustomers returns the customers the subject may read
+func (s *CustomerService) ListCustomers(subject *models.PolicySubject, filter CustomerFilter, offset, limit int) ([]*models.Customer, int64, error) {
+	var customers []*models.Customer
+	var total int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.Customer{}).
+			Preload("Creator").
+			Where("tenant_id = ?", s.tenantID)
+
+		query, err := s.policies.Scope(query, subject, "customers", "read")
+		if err != nil {
+			return err
+		}
+
+		// Apply filters
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+		for _, tag := range filter.Tags {
+			tags, _ := json.Marshal([]string{tag})
+			query = query.Where("tags @> ?", string(tags))
+		}
+		if filter.Search != "" {
+			search := "%" + filter.Search + "%"
+			query = query.Where("name ILIKE ? OR email ILIKE ? OR company ILIKE ?", search, search, search)
+		}
+
+		// Get total count
+		if err := query.Count(&total).Error; err != nil {
+			return fmt.Errorf("failed to count customers: %v", err)
+		}
+
+		// Get paginated results
+		err = query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&customers).Error
+		if err != nil {
+			return fmt.Errorf("failed to list customers: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return customers, total, nil
+}
+
+// GetCustomer returns a customer, or ErrAccessDenied when the subject may not read it
+func (s *CustomerService) GetCustomer(subject *models.PolicySubject, id uuid.UUID) (*models.Customer, error) {
+	var customer models.Customer
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Preload("Creator").
+			Where("tenant_id = ?", s.tenantID).
+			First(&customer, id).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("customer not found")
+		}
+		return nil, fmt.Errorf("failed to get customer: %v", err)
+	}
+
+	if err := s.policies.Authorize(subject, "customers", "read", &customer); err != nil {
+		return nil, err
+	}
+	return &customer, nil
+}
@@ -0,0 +1,105 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// EmployeeService reads a tenant's employees, restricted by the tenant's access policies
+type EmployeeService struct {
+	db       *tenancy.DB
+	tenantID uuid.UUID
+	policies *PolicyService
+}
+
+// NewEmployeeService creates a new employee service for a specific tenant
+func NewEmployeeService(db *gorm.DB, tenantID uuid.UUID) *EmployeeService {
+	return &EmployeeService{
+		db:       tenancy.New(db, tenantID),
+		tenantID: tenantID,
+		policies: NewPolicyService(db, tenantID),
+	}
+}
+
+// EmployeeFilter represents filtering options for employees
+type EmployeeFilter struct {
+	Status       string `json:"status"`
+	DepartmentID string `json:"department_id"`
+	Position     string `json:"position"`
+	Search       string `json:"search"`
+}
+
+// ListEmployees returns the employees the subject may read
+func (s *EmployeeService) ListEmployees(subject *models.PolicySubject, filter EmployeeFilter, offset, limit int) ([]*models.Employee, int64, error) {
+	var employees []*models.Employee
+	var total int64
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.Employee{}).
+			Preload("Department").
+			Where("tenant_id = ?", s.tenantID)
+
+		query, err := s.policies.Scope(query, subject, "employees", "read")
+		if err != nil {
+			return err
+		}
+
+		// Apply filters
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+		if filter.DepartmentID != "" {
+			query = query.Where("department_id = ?", filter.DepartmentID)
+		}
+		if filter.Position != "" {
+			query = query.Where("position = ?", filter.Position)
+		}
+		if filter.Search != "" {
+			search := "%" + filter.Search + "%"
+			query = query.Where("first_name ILIKE ? OR last_name ILIKE ? OR email ILIKE ?", search, search, search)
+		}
+
+		// Get total count
+		if err := query.Count(&total).Error; err != nil {
+			return fmt.Errorf("failed to count employees: %v", err)
+		}
+
+		// Get paginated results
+		err = query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&employees).Error
+		if err != nil {
+			return fmt.Errorf("failed to list employees: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return employees, total, nil
+}
+
+// GetEmployee returns an employee, or ErrAccessDenied when the subject may not read it
+func (s *EmployeeService) GetEmployee(subject *models.PolicySubject, id uuid.UUID) (*models.Employee, error) {
+	var employee models.Employee
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Preload("Department").
+			Where("tenant_id = ?", s.tenantID).
+			First(&employee, id).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("employee not found")
+		}
+		return nil, fmt.Errorf("failed to get employee: %v", err)
+	}
+
+	if err := s.policies.Authorize(subject, "employees", "read", &employee); err != nil {
+		return nil, err
+	}
+	return &employee, nil
+}
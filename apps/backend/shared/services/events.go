@@ -0,0 +1,133 @@
+package services
+
+import (
+	"encoding/json"
+	"log"
+	"sync"
+
+	"github.com/google/uuid"
+)
+
+// EventType identifies a change published by a service
+type EventType string
+
+const (
+	// EventTenantChanged is published after a tenant is updated, suspended, activated or deleted
+	EventTenantChanged EventType = "tenant.changed"
+	// EventUserChanged is published after a tenant user is updated or deleted
+	EventUserChanged EventType = "user.changed"
+	// EventRolesChanged is published after role assignments or role permissions change
+	EventRolesChanged EventType = "roles.changed"
+	// EventRoleAssignmentExpired is published after expired time-bound role assignments of a user are removed
+	EventRoleAssignmentExpired EventType = "roles.assignment_expired"
+)
+
+// Event describes a change that other components may react to, e.g. by invalidating caches
+type Event struct {
+	Type     EventType `json:"type"`
+	TenantID uuid.UUID `json:"tenant_id"`
+	UserID   uuid.UUID `json:"user_id"` // uuid.Nil when the change affects every user of the tenant
+}
+
+// EventHandler handles a published event
+type EventHandler func(event Event)
+
+// EventsChannel is the channel the services relay their events on
+const EventsChannel = "events"
+
+// EventTransport carries events between processes, e.g. Redis pub/sub
+type EventTransport interface {
+	Publish(channel string, message []byte) error
+	Subscribe(channel string, handler func(message []byte)) (stop func(), err error)
+}
+
+// relayedEvent is an event sent over a transport, tagged with the bus that published it
+type relayedEvent struct {
+	Origin string `json:"origin"`
+	Event  Event  `json:"event"`
+}
+
+// EventBus is a simple publish/subscribe hub shared between services. Events are
+// delivered in process and, once relayed, to the buses of other processes.
+type EventBus struct {
+	handlers  map[EventType][]EventHandler
+	mutex     sync.RWMutex
+	origin    string
+	transport EventTransport
+	channel   string
+}
+
+// NewEventBus creates a new event bus
+func NewEventBus() *EventBus {
+	return &EventBus{
+		handlers: make(map[EventType][]EventHandler),
+		origin:   uuid.New().String(),
+	}
+}
+
+// Relay connects the bus to the buses of other processes sharing the transport channel:
+// published events are sent to them and the events they publish are delivered here, so
+// every replica invalidates its caches. It returns a function that disconnects the bus.
+func (b *EventBus) Relay(transport EventTransport, channel string) (stop func(), err error) {
+	stop, err = transport.Subscribe(channel, func(message []byte) {
+		var relayed relayedEvent
+		if err := json.Unmarshal(message, &relayed); err != nil {
+			log.Printf("Failed to decode relayed event: %v", err)
+			return
+		}
+		// Events published here were already delivered
+		if relayed.Origin != b.origin {
+			b.deliver(relayed.Event)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b.mutex.Lock()
+	b.transport = transport
+	b.channel = channel
+	b.mutex.Unlock()
+	return stop, nil
+}
+
+// Subscribe registers a handler for the given event type
+func (b *EventBus) Subscribe(eventType EventType, handler EventHandler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.handlers[eventType] = append(b.handlers[eventType], handler)
+}
+
+// Publish delivers an event synchronously to all subscribed handlers and sends it to
+// the relayed buses. Publishing on a nil bus is a no-op so services work without one.
+func (b *EventBus) Publish(event Event) {
+	if b == nil {
+		return
+	}
+	b.deliver(event)
+
+	b.mutex.RLock()
+	transport, channel := b.transport, b.channel
+	b.mutex.RUnlock()
+	if transport == nil {
+		return
+	}
+	message, err := json.Marshal(relayedEvent{Origin: b.origin, Event: event})
+	if err == nil {
+		err = transport.Publish(channel, message)
+	}
+	if err != nil {
+		log.Printf("Failed to relay %s event: %v", event.Type, err)
+	}
+}
+
+// deliver passes an event to the handlers subscribed in this process
+func (b *EventBus) deliver(event Event) {
+	b.mutex.RLock()
+	handlers := append([]EventHandler(nil), b.handlers[event.Type]...)
+	b.mutex.RUnlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
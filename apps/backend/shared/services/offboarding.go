@@ -0,0 +1,813 @@
+package services
+
+import (
+	"archive/zip"
+	"database/sql"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/migrations"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// DefaultDeletionGracePeriod is how long a deleted tenant can be restored before it is purged
+const DefaultDeletionGracePeriod = 30 * 24 * time.Hour
+
+var (
+	// ErrDeletionPending is returned when a tenant is already waiting to be purged
+	ErrDeletionPending = errors.New("tenant deletion is already pending")
+	// ErrNoPendingDeletion is returned when a tenant is not waiting to be purged
+	ErrNoPendingDeletion = errors.New("tenant has no pending deletion")
+	// ErrGracePeriod is returned when a tenant is purged before its grace period ended
+	ErrGracePeriod = errors.New("tenant deletion grace period has not ended")
+	// ErrExportUnavailable is returned when the data export of a tenant is not available
+	ErrExportUnavailable = errors.New("tenant export is not available")
+)
+
+// systemExportTables are the system tables holding rows of a tenant, with the condition
+// selecting them
+var systemExportTables = []tenantTable{
+	{name: "tenants", filter: "id = ?"},
+	{name: "subscriptions", filter: "tenant_id = ?"},
+	{name: "tenant_modules", filter: "tenant_id = ?"},
+}
+
+// exportOmittedColumns are the credentials of each table left out of exports
+var exportOmittedColumns = map[string][]string{
+	"users":    {"password_hash", "mfa_secret", "mfa_recovery_codes"},
+	"api_keys": {"key_hash"},
+}
+
+// sharedTableScopes select the rows of a tenant in the tables of the shared schema that
+// have no tenant_id column. Other such tables are catalogues shared by every tenant.
+var sharedTableScopes = map[string]string{
+	"user_roles":       "user_id IN (SELECT id FROM users WHERE tenant_id = ?)",
+	"role_permissions": "role_id IN (SELECT id FROM roles WHERE tenant_id = ?)",
+}
+
+// OffboardingService deletes tenants in stages: a deleted tenant is marked pending
+// deletion and its data exported, it can be restored during a grace period, and is then
+// purged. Every stage is recorded in the audit log along with the change it makes.
+type OffboardingService struct {
+	db          *gorm.DB
+	events      *EventBus
+	exportDir   string
+	gracePeriod time.Duration
+}
+
+// NewOffboardingService creates a new offboarding service
+func NewOffboardingService(db *gorm.DB) *OffboardingService {
+	return &OffboardingService{
+		db:          db,
+		exportDir:   filepath.Join(os.TempDir(), "tenant-exports"),
+		gracePeriod: DefaultDeletionGracePeriod,
+	}
+}
+
+// SetEventBus sets the event bus used to publish tenant changes
+func (s *OffboardingService) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
+// SetExportDir sets the directory the data exports of deleted tenants are written to
+func (s *OffboardingService) SetExportDir(dir string) {
+	s.exportDir = dir
+}
+
+// SetGracePeriod sets how long a deleted tenant can be restored before it is purged
+func (s *OffboardingService) SetGracePeriod(period time.Duration) {
+	s.gracePeriod = period
+}
+
+// RequestDeletionInput represents input for deleting a tenant
+type RequestDeletionInput struct {
+	RequestedBy *uuid.UUID `json:"-"` // System user deleting the tenant
+	Reason      *string    `json:"reason"`
+}
+
+// tenantTable is a table holding rows of a tenant, with the condition selecting them, or
+// no condition when every row belongs to the tenant
+type tenantTable struct {
+	name   string
+	filter string
+}
+
+// RequestDeletion marks a tenant pending deletion, which stops its users from signing
+// in, and exports its data. A failed export is recorded on the deletion and retried
+// before the tenant is purged.
+func (s *OffboardingService) RequestDeletion(tenantID uuid.UUID, input RequestDeletionInput) (*models.TenantDeletion, error) {
+	var tenant models.Tenant
+	if err := s.db.First(&tenant, tenantID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("tenant not found")
+		}
+		return nil, fmt.Errorf("failed to find tenant: %v", err)
+	}
+	if tenant.Status == models.TenantStatusPendingDeletion {
+		return nil, ErrDeletionPending
+	}
+	isolation, err := tenancy.ParseIsolation(tenant.Isolation)
+	if err != nil {
+		return nil, err
+	}
+
+	deletion := &models.TenantDeletion{
+		ID:             uuid.New(),
+		TenantID:       tenant.ID,
+		Slug:           tenant.Slug,
+		Isolation:      string(isolation),
+		Status:         models.TenantDeletionPending,
+		PreviousStatus: tenant.Status,
+		RequestedBy:    input.RequestedBy,
+		Reason:         input.Reason,
+		PurgeAfter:     time.Now().Add(s.gracePeriod),
+	}
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(deletion).Error; err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return ErrDeletionPending
+			}
+			return fmt.Errorf("failed to create tenant deletion: %v", err)
+		}
+		if err := tx.Model(&models.Tenant{}).Where("id = ?", tenant.ID).
+			Update("status", models.TenantStatusPendingDeletion).Error; err != nil {
+			return fmt.Errorf("failed to update tenant status: %v", err)
+		}
+
+		details := map[string]string{"purge_after": deletion.PurgeAfter.Format(time.RFC3339)}
+		if input.Reason != nil {
+			details["reason"] = *input.Reason
+		}
+		return s.record(tx, models.AuditTenantDeletionRequested, deletion, input.RequestedBy, details)
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.events.Publish(Event{Type: EventTenantChanged, TenantID: tenant.ID})
+
+	if err := s.export(deletion, input.RequestedBy); err != nil {
+		log.Printf("Failed to export tenant %s: %v", deletion.Slug, err)
+	}
+	return deletion, nil
+}
+
+// GetDeletion retrieves the latest deletion of a tenant
+func (s *OffboardingService) GetDeletion(tenantID uuid.UUID) (*models.TenantDeletion, error) {
+	var deletion models.TenantDeletion
+	err := s.db.Where("tenant_id = ?", tenantID).Order("created_at DESC").First(&deletion).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("tenant deletion not found")
+		}
+		return nil, fmt.Errorf("failed to get tenant deletion: %v", err)
+	}
+	return &deletion, nil
+}
+
+// Export exports the data of a tenant pending deletion again, replacing its export
+func (s *OffboardingService) Export(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error) {
+	deletion, err := s.pendingDeletion(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.export(deletion, actorID); err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// DownloadExport returns the deletion of a tenant pending deletion, whose ExportPath is
+// the archive of its data, and records the download
+func (s *OffboardingService) DownloadExport(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error) {
+	deletion, err := s.pendingDeletion(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if deletion.ExportPath == "" {
+		return nil, ErrExportUnavailable
+	}
+	if _, err := os.Stat(deletion.ExportPath); err != nil {
+		return nil, ErrExportUnavailable
+	}
+	if err := s.record(s.db, models.AuditTenantExportDownloaded, deletion, actorID, nil); err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// Restore gives a tenant pending deletion its previous status back and removes its export
+func (s *OffboardingService) Restore(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error) {
+	deletion, err := s.pendingDeletion(tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	exportPath := deletion.ExportPath
+	now := time.Now()
+	deletion.Status = models.TenantDeletionRestored
+	deletion.RestoredAt = &now
+	deletion.ExportPath = ""
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Tenant{}).Where("id = ?", tenantID).
+			Update("status", deletion.PreviousStatus).Error; err != nil {
+			return fmt.Errorf("failed to restore tenant status: %v", err)
+		}
+		if err := tx.Model(deletion).
+			Select("status", "restored_at", "export_path").
+			Updates(deletion).Error; err != nil {
+			return fmt.Errorf("failed to update tenant deletion: %v", err)
+		}
+		return s.record(tx, models.AuditTenantRestored, deletion, actorID, map[string]string{"status": deletion.PreviousStatus})
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.events.Publish(Event{Type: EventTenantChanged, TenantID: tenantID})
+
+	removeExport(exportPath)
+	return deletion, nil
+}
+
+// Purge purges a tenant whose grace period has ended
+func (s *OffboardingService) Purge(tenantID uuid.UUID, actorID *uuid.UUID) (*models.TenantDeletion, error) {
+	deletion, err := s.pendingDeletion(tenantID)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().Before(deletion.PurgeAfter) {
+		return nil, ErrGracePeriod
+	}
+	if err := s.purge(deletion, actorID); err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// PurgeExpired purges the tenants whose grace period ended before now and returns the
+// number purged. A tenant that fails to be purged does not stop the others.
+func (s *OffboardingService) PurgeExpired(now time.Time) (int, error) {
+	var deletions []models.TenantDeletion
+	if err := s.db.Where("status = ? AND purge_after <= ?", models.TenantDeletionPending, now).
+		Order("purge_after").
+		Find(&deletions).Error; err != nil {
+		return 0, fmt.Errorf("failed to list expired tenant deletions: %v", err)
+	}
+
+	purged := 0
+	var firstErr error
+	for i := range deletions {
+		if err := s.purge(&deletions[i], nil); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("tenant %s: %w", deletions[i].Slug, err)
+			}
+			continue
+		}
+		purged++
+	}
+	return purged, firstErr
+}
+
+// Start purges the tenants whose grace period ended every interval in the background
+func (s *OffboardingService) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for now := range ticker.C {
+			if purged, err := s.PurgeExpired(now); err != nil {
+				log.Printf("Failed to purge deleted tenants: %v", err)
+			} else if purged > 0 {
+				log.Printf("Purged %d deleted tenants", purged)
+			}
+		}
+	}()
+}
+
+// Helper methods
+
+// pendingDeletion returns the pending deletion of a tenant
+func (s *OffboardingService) pendingDeletion(tenantID uuid.UUID) (*models.TenantDeletion, error) {
+	var deletion models.TenantDeletion
+	err := s.db.Where("tenant_id = ? AND status = ?", tenantID, models.TenantDeletionPending).First(&deletion).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, ErrNoPendingDeletion
+		}
+		return nil, fmt.Errorf("failed to get tenant deletion: %v", err)
+	}
+	return &deletion, nil
+}
+
+// record appends a stage of a deletion to the audit log. The tenant is also named in
+// the details, as the tenant_id of its entries is cleared once the tenant is purged.
+func (s *OffboardingService) record(tx *gorm.DB, action string, deletion *models.TenantDeletion, actorID *uuid.UUID, details map[string]string) error {
+	entry := &models.AuditLog{
+		Action: action,
+		Details: map[string]string{
+			"tenant_id":   deletion.TenantID.String(),
+			"slug":        deletion.Slug,
+			"deletion_id": deletion.ID.String(),
+		},
+	}
+	if deletion.Status != models.TenantDeletionPurged {
+		entry.TenantID = &deletion.TenantID
+	}
+	if actorID != nil {
+		entry.Details["actor_id"] = actorID.String()
+	}
+	for key, value := range details {
+		entry.Details[key] = value
+	}
+	return NewAuditService(tx).Record(entry)
+}
+
+// export writes the archive of a tenant's data and records it on the deletion. A failure
+// is recorded on the deletion too.
+func (s *OffboardingService) export(deletion *models.TenantDeletion, actorID *uuid.UUID) error {
+	path, size, err := s.writeExport(deletion)
+	if err != nil {
+		deletion.Error = fmt.Sprintf("export: %v", err)
+		if err := s.db.Model(deletion).Update("error", deletion.Error).Error; err != nil {
+			log.Printf("Failed to record export failure of tenant %s: %v", deletion.Slug, err)
+		}
+		return err
+	}
+
+	previous := deletion.ExportPath
+	now := time.Now()
+	deletion.ExportPath = path
+	deletion.ExportSize = size
+	deletion.ExportedAt = &now
+	deletion.Error = ""
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(deletion).
+			Select("export_path", "export_size", "exported_at", "error").
+			Updates(deletion).Error; err != nil {
+			return fmt.Errorf("failed to update tenant deletion: %v", err)
+		}
+		return s.record(tx, models.AuditTenantExported, deletion, actorID, map[string]string{"size": fmt.Sprint(size)})
+	})
+	if err != nil {
+		return err
+	}
+	if previous != path {
+		removeExport(previous)
+	}
+	return nil
+}
+
+// writeExport writes a zip archive with a JSON and a CSV file for every table holding
+// rows of the tenant, and returns its path and size
+func (s *OffboardingService) writeExport(deletion *models.TenantDeletion) (string, int64, error) {
+	if err := os.MkdirAll(s.exportDir, 0o700); err != nil {
+		return "", 0, fmt.Errorf("failed to create export directory: %v", err)
+	}
+	file, err := os.CreateTemp(s.exportDir, deletion.Slug+"-*.zip.tmp")
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to create export: %v", err)
+	}
+	defer os.Remove(file.Name()) // Left behind only when the export fails
+
+	archive := zip.NewWriter(file)
+	err = s.writeTables(archive, deletion)
+	if closeErr := archive.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", 0, err
+	}
+
+	path := filepath.Join(s.exportDir, fmt.Sprintf("%s-%s.zip", deletion.Slug, deletion.ID))
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", 0, fmt.Errorf("failed to store export: %v", err)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to store export: %v", err)
+	}
+	return path, info.Size(), nil
+}
+
+// writeTables adds the system rows and the tenant tables of a tenant to an archive
+func (s *OffboardingService) writeTables(archive *zip.Writer, deletion *models.TenantDeletion) error {
+	for _, table := range systemExportTables {
+		query := s.db.Table(migrations.SystemSchema+"."+table.name).Where(table.filter, deletion.TenantID)
+		if err := exportTable(archive, "system/"+table.name, table.name, query); err != nil {
+			return err
+		}
+	}
+
+	tenantDB := tenancy.New(s.db, deletion.TenantID)
+	schema, err := tenantDB.Schema()
+	if err != nil {
+		return err
+	}
+	shared := tenancy.Isolation(deletion.Isolation) == tenancy.IsolationShared
+	return tenantDB.Transaction(func(tx *gorm.DB) error {
+		tables, err := tenantTables(tx, schema, shared)
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			query := tx.Table(table.name)
+			if table.filter != "" {
+				query = query.Where(table.filter, deletion.TenantID)
+			}
+			if err := exportTable(archive, "tenant/"+table.name, table.name, query); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// purge deletes the data and the system rows of a tenant. A failure is recorded on the
+// deletion and in the audit log, and the purge is retried later.
+func (s *OffboardingService) purge(deletion *models.TenantDeletion, actorID *uuid.UUID) error {
+	err := s.purgeTenant(deletion, actorID)
+	if err == nil {
+		return nil
+	}
+
+	deletion.Error = fmt.Sprintf("purge: %v", err)
+	recordErr := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(deletion).Update("error", deletion.Error).Error; err != nil {
+			return err
+		}
+		return s.record(tx, models.AuditTenantPurgeFailed, deletion, actorID, map[string]string{"error": err.Error()})
+	})
+	if recordErr != nil {
+		log.Printf("Failed to record purge failure of tenant %s: %v", deletion.Slug, recordErr)
+	}
+	return err
+}
+
+// purgeTenant drops the tenant's data, then deletes its system rows and its export. The
+// steps can be repeated, so a purge that stopped halfway is completed by the next one.
+func (s *OffboardingService) purgeTenant(deletion *models.TenantDeletion, actorID *uuid.UUID) error {
+	// The export is the last copy of the tenant's data
+	if deletion.ExportedAt == nil {
+		if err := s.export(deletion, actorID); err != nil {
+			return fmt.Errorf("failed to export tenant data: %v", err)
+		}
+	}
+
+	// The tenant row goes last, so a tenant without one has no data left
+	var existingCount int64
+	if err := s.db.Model(&models.Tenant{}).Unscoped().
+		Where("id = ?", deletion.TenantID).
+		Count(&existingCount).Error; err != nil {
+		return fmt.Errorf("failed to check tenant: %v", err)
+	}
+	if existingCount > 0 {
+		if err := s.purgeTenantData(deletion); err != nil {
+			return err
+		}
+	}
+
+	exportPath := deletion.ExportPath
+	now := time.Now()
+	deletion.Status = models.TenantDeletionPurged
+	deletion.PurgedAt = &now
+	deletion.ExportPath = ""
+	deletion.Error = ""
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		tenantID := deletion.TenantID
+		for _, model := range []interface{}{
+			&models.TenantModule{},
+			&models.Subscription{},
+			&models.TenantDatabase{},
+			&models.TenantOnboarding{},
+		} {
+			if err := tx.Unscoped().Where("tenant_id = ?", tenantID).Delete(model).Error; err != nil {
+				return fmt.Errorf("failed to delete system rows of tenant: %v", err)
+			}
+		}
+		if err := tx.Unscoped().Where("id = ?", tenantID).Delete(&models.Tenant{}).Error; err != nil {
+			return fmt.Errorf("failed to delete tenant: %v", err)
+		}
+		if err := tx.Model(deletion).
+			Select("status", "purged_at", "export_path", "error").
+			Updates(deletion).Error; err != nil {
+			return fmt.Errorf("failed to update tenant deletion: %v", err)
+		}
+		return s.record(tx, models.AuditTenantPurged, deletion, actorID, nil)
+	})
+	if err != nil {
+		deletion.Status = models.TenantDeletionPending
+		deletion.PurgedAt = nil
+		deletion.ExportPath = exportPath
+		return err
+	}
+
+	if router := tenancy.RouterOf(s.db); router != nil {
+		if err := router.Forget(deletion.TenantID); err != nil {
+			log.Printf("Failed to close database of tenant %s: %v", deletion.Slug, err)
+		}
+	}
+	removeExport(exportPath)
+	s.events.Publish(Event{Type: EventTenantChanged, TenantID: deletion.TenantID})
+
+	return nil
+}
+
+// purgeTenantData drops the schema of a tenant with a schema or a database of its own,
+// and deletes the rows of a tenant sharing tables with others. A dedicated database is
+// left in place, empty, for its operators to remove.
+func (s *OffboardingService) purgeTenantData(deletion *models.TenantDeletion) error {
+	if tenancy.Isolation(deletion.Isolation) != tenancy.IsolationShared {
+		return NewSchemaMigrationService(s.db).DropTenant(deletion.TenantID)
+	}
+
+	tenantDB := tenancy.New(s.db, deletion.TenantID)
+	schema, err := tenantDB.Schema()
+	if err != nil {
+		return err
+	}
+	return tenantDB.Transaction(func(tx *gorm.DB) error {
+		tables, err := tenantTables(tx, schema, true)
+		if err != nil {
+			return err
+		}
+		tables, err = deletionOrder(tx, schema, tables)
+		if err != nil {
+			return err
+		}
+		for _, table := range tables {
+			quoted, err := migrations.QuoteIdentifier(table.name)
+			if err != nil {
+				return err
+			}
+			if err := tx.Exec("DELETE FROM "+quoted+" WHERE "+table.filter, deletion.TenantID).Error; err != nil {
+				return fmt.Errorf("failed to delete rows of %s: %v", table.name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// tenantTables lists the tables of a tenant schema holding rows of the tenant. In the
+// shared schema each table is given the condition selecting the tenant's rows.
+func tenantTables(tx *gorm.DB, schema string, shared bool) ([]tenantTable, error) {
+	var names []string
+	if err := tx.Raw(`SELECT table_name FROM information_schema.tables
+		WHERE table_schema = ? AND table_type = 'BASE TABLE' AND table_name <> ?
+		ORDER BY table_name`, schema, migrations.MigrationsTable).Scan(&names).Error; err != nil {
+		return nil, fmt.Errorf("failed to list tables of %s: %v", schema, err)
+	}
+	var scoped []string
+	if err := tx.Raw(`SELECT table_name FROM information_schema.columns
+		WHERE table_schema = ? AND column_name = 'tenant_id'`, schema).Scan(&scoped).Error; err != nil {
+		return nil, fmt.Errorf("failed to list tables of %s: %v", schema, err)
+	}
+
+	hasTenantID := make(map[string]bool, len(scoped))
+	for _, name := range scoped {
+		hasTenantID[name] = true
+	}
+
+	tables := make([]tenantTable, 0, len(names))
+	for _, name := range names {
+		switch {
+		case !shared:
+			tables = append(tables, tenantTable{name: name})
+		case hasTenantID[name]:
+			tables = append(tables, tenantTable{name: name, filter: "tenant_id = ?"})
+		case sharedTableScopes[name] != "":
+			tables = append(tables, tenantTable{name: name, filter: sharedTableScopes[name]})
+		}
+	}
+	return tables, nil
+}
+
+// deletionOrder orders tables so that tables referencing others by foreign key come
+// before the tables they reference. Tables in a reference cycle keep their order.
+func deletionOrder(tx *gorm.DB, schema string, tables []tenantTable) ([]tenantTable, error) {
+	var references []struct {
+		Referencing string
+		Referenced  string
+	}
+	if err := tx.Raw(`SELECT DISTINCT tc.table_name AS referencing, ccu.table_name AS referenced
+		FROM information_schema.table_constraints tc
+		JOIN information_schema.constraint_column_usage ccu
+			ON ccu.constraint_schema = tc.constraint_schema AND ccu.constraint_name = tc.constraint_name
+		WHERE tc.constraint_type = 'FOREIGN KEY' AND tc.table_schema = ?`, schema).Scan(&references).Error; err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys of %s: %v", schema, err)
+	}
+
+	remaining := append([]tenantTable(nil), tables...)
+	ordered := make([]tenantTable, 0, len(tables))
+	for len(remaining) > 0 {
+		next := -1
+		for i, table := range remaining {
+			referenced := false
+			for _, reference := range references {
+				if reference.Referenced == table.name && reference.Referencing != table.name && containsTable(remaining, reference.Referencing) {
+					referenced = true
+					break
+				}
+			}
+			if !referenced {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return append(ordered, remaining...), nil
+		}
+		ordered = append(ordered, remaining[next])
+		remaining = append(remaining[:next], remaining[next+1:]...)
+	}
+	return ordered, nil
+}
+
+// containsTable reports whether a table is in tables
+func containsTable(tables []tenantTable, name string) bool {
+	for _, table := range tables {
+		if table.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// exportTable adds the rows of a query to an archive as name.json and name.csv, leaving
+// out the credentials of the table
+func exportTable(archive *zip.Writer, name, table string, query *gorm.DB) error {
+	omitted := make(map[string]bool)
+	for _, column := range exportOmittedColumns[table] {
+		omitted[column] = true
+	}
+	for _, format := range []string{"json", "csv"} {
+		entry, err := archive.Create(name + "." + format)
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %v", name, err)
+		}
+		rows, err := query.Session(&gorm.Session{}).Rows()
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %v", name, err)
+		}
+		if format == "json" {
+			err = writeJSONRows(entry, rows, omitted)
+		} else {
+			err = writeCSVRows(entry, rows, omitted)
+		}
+		rows.Close()
+		if err != nil {
+			return fmt.Errorf("failed to export %s: %v", name, err)
+		}
+	}
+	return nil
+}
+
+// writeJSONRows writes rows as a JSON array of objects keyed by column, without the
+// omitted columns
+func writeJSONRows(w io.Writer, rows *sql.Rows, omitted map[string]bool) error {
+	columns, err := rows.ColumnTypes()
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+
+	values, pointers := scanTargets(len(columns))
+	for count := 0; rows.Next(); count++ {
+		if err := rows.Scan(pointers...); err != nil {
+			return err
+		}
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			if omitted[column.Name()] {
+				continue
+			}
+			row[column.Name()] = jsonValue(values[i], column.DatabaseTypeName())
+		}
+		encoded, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, "\n"); err != nil {
+			return err
+		}
+		if _, err := w.Write(encoded); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n]\n")
+	return err
+}
+
+// writeCSVRows writes rows as CSV with a header of column names, without the omitted
+// columns
+func writeCSVRows(w io.Writer, rows *sql.Rows, omitted map[string]bool) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	kept := make([]int, 0, len(columns))
+	header := make([]string, 0, len(columns))
+	for i, column := range columns {
+		if !omitted[column] {
+			kept = append(kept, i)
+			header = append(header, column)
+		}
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	values, pointers := scanTargets(len(columns))
+	record := make([]string, len(kept))
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return err
+		}
+		for i, column := range kept {
+			record[i] = csvValue(values[column])
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// scanTargets returns values to scan a row into and pointers to them
+func scanTargets(count int) ([]interface{}, []interface{}) {
+	values := make([]interface{}, count)
+	pointers := make([]interface{}, count)
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	return values, pointers
+}
+
+// jsonValue converts a scanned column value for JSON, keeping JSON columns as JSON
+func jsonValue(value interface{}, databaseType string) interface{} {
+	isJSON := databaseType == "JSON" || databaseType == "JSONB"
+	switch v := value.(type) {
+	case []byte:
+		if isJSON {
+			return json.RawMessage(v)
+		}
+		return string(v)
+	case string:
+		if isJSON {
+			return json.RawMessage(v)
+		}
+	}
+	return value
+}
+
+// csvValue formats a scanned column value for CSV
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []byte:
+		return string(v)
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// removeExport deletes an export archive, if there is one
+func removeExport(path string) {
+	if path == "" {
+		return
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		log.Printf("Failed to remove tenant export %s: %v", path, err)
+	}
+}
@@ -0,0 +1,661 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// Onboarding steps, in the order they are applied
+const (
+	OnboardingStepTenant       = "tenant"
+	OnboardingStepSchema       = "schema"
+	OnboardingStepRoles        = "roles"
+	OnboardingStepAdmin        = "admin"
+	OnboardingStepSubscription = "subscription"
+	OnboardingStepModules      = "modules"
+	OnboardingStepWelcome      = "welcome"
+)
+
+// onboardingLease is how long a process may run an onboarding before another process
+// considers it crashed and resumes the onboarding
+const onboardingLease = 5 * time.Minute
+
+// ErrOnboardingInProgress is returned when an onboarding is being run by another process
+var ErrOnboardingInProgress = errors.New("onboarding is in progress")
+
+// ErrOnboardingLeaseLost is returned when the lease of a running onboarding expired and
+// another process took the onboarding over
+var ErrOnboardingLeaseLost = errors.New("onboarding was taken over by another process")
+
+// Notifier delivers the notifications sent while onboarding a tenant
+type Notifier interface {
+	// NotifyWelcome welcomes the first admin of a new tenant
+	NotifyWelcome(tenant *models.Tenant, admin *models.TenantUser) error
+}
+
+// OnboardingInput represents input for onboarding a tenant
+type OnboardingInput struct {
+	Tenant      CreateTenantInput `json:"tenant"`
+	Admin       *OnboardingAdmin  `json:"admin"`        // First user of the tenant, none when nil
+	TrialPeriod time.Duration     `json:"trial_period"` // Trial subscription to the tenant's plan, none when zero
+}
+
+// OnboardingAdmin represents the first admin user of an onboarded tenant
+type OnboardingAdmin struct {
+	Email        string `json:"email"`
+	Password     string `json:"-"` // Hashed before the onboarding is stored
+	PasswordHash string `json:"password_hash"`
+	FirstName    string `json:"first_name"`
+	LastName     string `json:"last_name"`
+	Role         string `json:"role"`   // defaults to tenant_admin
+	Status       string `json:"status"` // defaults to active
+}
+
+// OnboardingService creates tenants as a saga of idempotent steps. Each applied step is
+// persisted; when a step fails the applied steps are undone in reverse order. Progress
+// is stored outside any transaction, so the service must be given a database handle
+// that is not in one.
+type OnboardingService struct {
+	db       *gorm.DB
+	notifier Notifier
+	events   *EventBus
+}
+
+// NewOnboardingService creates a new onboarding service
+func NewOnboardingService(db *gorm.DB) *OnboardingService {
+	return &OnboardingService{db: db}
+}
+
+// SetNotifier sets the notifier welcoming the first admin of new tenants
+func (s *OnboardingService) SetNotifier(notifier Notifier) {
+	s.notifier = notifier
+}
+
+// SetEventBus sets the event bus used to publish new tenants
+func (s *OnboardingService) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
+// onboardingStep is an idempotent step of an onboarding with the action undoing it
+type onboardingStep struct {
+	name       string
+	apply      func(o *onboarding) error
+	compensate func(o *onboarding) error // nil when there is nothing to undo
+	optional   bool                      // A failure is recorded but does not undo the onboarding
+}
+
+// onboarding is an onboarding being run
+type onboarding struct {
+	record *models.TenantOnboarding
+	input  OnboardingInput
+	lease  time.Time // locked_until as last stored by this process
+}
+
+// Onboard creates a tenant with its schema, roles, first admin, trial subscription and
+// modules, and welcomes the admin. If a step fails, the tenant is rolled back and the
+// error of the step is returned.
+func (s *OnboardingService) Onboard(input OnboardingInput) (*models.TenantOnboarding, error) {
+	// Validate slug format
+	if !IsValidSlug(input.Tenant.Slug) {
+		return nil, fmt.Errorf("invalid slug format: must contain only lowercase letters, numbers, and hyphens")
+	}
+
+	var existingCount int64
+	if err := s.db.Model(&models.Tenant{}).Unscoped().
+		Where("slug = ?", input.Tenant.Slug).
+		Count(&existingCount).Error; err != nil {
+		return nil, fmt.Errorf("failed to check slug uniqueness: %v", err)
+	}
+	if existingCount > 0 {
+		return nil, fmt.Errorf("tenant with slug '%s' already exists", input.Tenant.Slug)
+	}
+	if err := NewTenantService(s.db).checkIdentifiers(uuid.Nil, input.Tenant.Slug, input.Tenant.Domain, input.Tenant.Subdomain); err != nil {
+		return nil, err
+	}
+
+	// The isolation is picked once, so a resumed onboarding creates the same tenant
+	isolation, err := NewTenantService(s.db).tenantIsolation(input.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	input.Tenant.Isolation = string(isolation)
+
+	if input.Admin != nil && input.Admin.PasswordHash == "" {
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Admin.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %v", err)
+		}
+		input.Admin.PasswordHash = string(hashedPassword)
+	}
+
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode onboarding: %v", err)
+	}
+	lockedUntil := newOnboardingLease()
+	record := &models.TenantOnboarding{
+		ID:          uuid.New(),
+		TenantID:    uuid.New(),
+		Slug:        input.Tenant.Slug,
+		Status:      models.OnboardingRunning,
+		Steps:       []string{},
+		Input:       string(encoded),
+		LockedUntil: &lockedUntil,
+	}
+	if err := s.db.Create(record).Error; err != nil {
+		if strings.Contains(err.Error(), "duplicate key") {
+			return nil, fmt.Errorf("tenant with slug '%s' already exists", input.Tenant.Slug)
+		}
+		return nil, fmt.Errorf("failed to start onboarding: %v", err)
+	}
+
+	if err := s.run(&onboarding{record: record, input: input, lease: lockedUntil}); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// GetOnboarding retrieves an onboarding by ID
+func (s *OnboardingService) GetOnboarding(id uuid.UUID) (*models.TenantOnboarding, error) {
+	var record models.TenantOnboarding
+	if err := s.db.First(&record, id).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("onboarding not found")
+		}
+		return nil, fmt.Errorf("failed to get onboarding: %v", err)
+	}
+	return &record, nil
+}
+
+// Resume finishes an interrupted onboarding: a running one applies its remaining steps,
+// one that was rolling back undoes its remaining steps. A finished onboarding is returned
+// unchanged. The onboarding is returned along with the error of a failed step.
+func (s *OnboardingService) Resume(id uuid.UUID) (*models.TenantOnboarding, error) {
+	record, err := s.GetOnboarding(id)
+	if err != nil {
+		return nil, err
+	}
+	if record.Finished() {
+		return record, nil
+	}
+	if err := s.claim(record); err != nil {
+		return record, err
+	}
+
+	var input OnboardingInput
+	if err := json.Unmarshal([]byte(record.Input), &input); err != nil {
+		return record, fmt.Errorf("failed to decode onboarding: %v", err)
+	}
+	return record, s.run(&onboarding{record: record, input: input, lease: *record.LockedUntil})
+}
+
+// ResumePending resumes the onboardings left unfinished by a process that stopped, and
+// returns them. The error is the first one that left an onboarding unfinished.
+func (s *OnboardingService) ResumePending() ([]*models.TenantOnboarding, error) {
+	var ids []uuid.UUID
+	if err := s.db.Model(&models.TenantOnboarding{}).
+		Where("status IN ? AND (locked_until IS NULL OR locked_until < ?)", unfinishedOnboarding, time.Now()).
+		Order("created_at").
+		Pluck("id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to list unfinished onboardings: %v", err)
+	}
+
+	var resumed []*models.TenantOnboarding
+	var firstErr error
+	for _, id := range ids {
+		record, err := s.Resume(id)
+		if err == ErrOnboardingInProgress || err == ErrOnboardingLeaseLost {
+			continue
+		}
+		if record != nil {
+			resumed = append(resumed, record)
+		}
+		if err != nil && (record == nil || !record.Finished()) && firstErr == nil {
+			firstErr = fmt.Errorf("onboarding %s: %w", id, err)
+		}
+	}
+	return resumed, firstErr
+}
+
+// Helper methods
+
+// unfinishedOnboarding are the statuses of onboardings that still have steps to run
+var unfinishedOnboarding = []string{models.OnboardingRunning, models.OnboardingCompensating}
+
+// steps returns the steps of an onboarding in the order they are applied
+func (s *OnboardingService) steps() []onboardingStep {
+	return []onboardingStep{
+		{name: OnboardingStepTenant, apply: s.createTenant, compensate: s.deleteTenant},
+		{name: OnboardingStepSchema, apply: s.migrateSchema, compensate: s.dropSchema},
+		{name: OnboardingStepRoles, apply: s.seedRoles, compensate: s.deleteRoles},
+		{name: OnboardingStepAdmin, apply: s.createAdmin, compensate: s.deleteAdmin},
+		{name: OnboardingStepSubscription, apply: s.createSubscription, compensate: s.deleteSubscription},
+		{name: OnboardingStepModules, apply: s.enableModules, compensate: s.disableModules},
+		{name: OnboardingStepWelcome, apply: s.welcome, optional: true},
+	}
+}
+
+// run applies the steps an onboarding has not applied yet, or undoes the applied steps
+// of an onboarding that is rolling back
+func (s *OnboardingService) run(o *onboarding) error {
+	steps := s.steps()
+	if o.record.Status == models.OnboardingCompensating {
+		return s.compensate(o, steps, errors.New(o.record.Error))
+	}
+
+	for _, step := range steps {
+		if o.record.Applied(step.name) {
+			continue
+		}
+
+		// The step is recorded before it runs, so a crash in it is undone on rollback
+		o.record.Step = step.name
+		if err := s.save(o); err != nil {
+			return err
+		}
+
+		if err := step.apply(o); err != nil {
+			if !step.optional {
+				o.record.Status = models.OnboardingCompensating
+				o.record.Error = fmt.Sprintf("%s: %v", step.name, err)
+				if err := s.save(o); err != nil {
+					return err
+				}
+				return s.compensate(o, steps, err)
+			}
+			o.record.Error = fmt.Sprintf("%s: %v", step.name, err)
+		}
+		o.record.Steps = append(o.record.Steps, step.name)
+	}
+
+	now := time.Now()
+	o.record.Status = models.OnboardingCompleted
+	o.record.Step = ""
+	o.record.Input = "{}"
+	o.record.CompletedAt = &now
+	o.record.LockedUntil = nil
+	if err := s.save(o); err != nil {
+		return err
+	}
+	s.events.Publish(Event{Type: EventTenantChanged, TenantID: o.record.TenantID})
+
+	return nil
+}
+
+// compensate undoes the applied steps of an onboarding, and the step it stopped in, in
+// reverse order. cause is the error that made the onboarding roll back.
+func (s *OnboardingService) compensate(o *onboarding, steps []onboardingStep, cause error) error {
+	for i := len(steps) - 1; i >= 0; i-- {
+		step := steps[i]
+		if !o.record.Applied(step.name) && o.record.Step != step.name {
+			continue
+		}
+
+		o.record.Step = step.name
+		if step.compensate != nil {
+			if err := step.compensate(o); err != nil {
+				// The lease is released, so the rollback is retried by ResumePending
+				o.record.LockedUntil = nil
+				if saveErr := s.save(o); saveErr != nil {
+					return saveErr
+				}
+				return fmt.Errorf("%w (undoing step %s failed: %v)", cause, step.name, err)
+			}
+		}
+		o.record.Steps = removeStep(o.record.Steps, step.name)
+		if err := s.save(o); err != nil {
+			return err
+		}
+	}
+
+	o.record.Status = models.OnboardingFailed
+	o.record.Step = ""
+	o.record.Input = "{}"
+	o.record.LockedUntil = nil
+	if err := s.save(o); err != nil {
+		return err
+	}
+	return cause
+}
+
+// claim takes the lease of an onboarding that no other process is running
+func (s *OnboardingService) claim(record *models.TenantOnboarding) error {
+	now := time.Now()
+	lockedUntil := newOnboardingLease()
+	result := s.db.Model(&models.TenantOnboarding{}).
+		Where("id = ? AND status IN ? AND (locked_until IS NULL OR locked_until < ?)", record.ID, unfinishedOnboarding, now).
+		Update("locked_until", lockedUntil)
+	if result.Error != nil {
+		return fmt.Errorf("failed to claim onboarding: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrOnboardingInProgress
+	}
+	record.LockedUntil = &lockedUntil
+	return nil
+}
+
+// save persists the progress of an onboarding and extends its lease while it runs. The
+// progress is only saved while the lease is still the one this process stored; once
+// another process took the onboarding over, ErrOnboardingLeaseLost stops this one.
+func (s *OnboardingService) save(o *onboarding) error {
+	record := o.record
+	if record.LockedUntil != nil {
+		lockedUntil := newOnboardingLease()
+		record.LockedUntil = &lockedUntil
+	}
+	result := s.db.Model(record).
+		Where("locked_until = ?", o.lease).
+		Select("status", "step", "steps", "input", "error", "locked_until", "completed_at").
+		Updates(record)
+	if result.Error != nil {
+		return fmt.Errorf("failed to save onboarding progress: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return ErrOnboardingLeaseLost
+	}
+	if record.LockedUntil != nil {
+		o.lease = *record.LockedUntil
+	}
+	return nil
+}
+
+// newOnboardingLease returns the end of a lease taken now. It is truncated to the
+// precision of the database, so the stored lease compares equal to it.
+func newOnboardingLease() time.Time {
+	return time.Now().Add(onboardingLease).Truncate(time.Microsecond)
+}
+
+// createTenant creates the tenant row, and the settings of its database with database
+// isolation, unless a previous run created them
+func (s *OnboardingService) createTenant(o *onboarding) error {
+	var existingCount int64
+	if err := s.db.Model(&models.Tenant{}).Unscoped().
+		Where("id = ?", o.record.TenantID).
+		Count(&existingCount).Error; err != nil {
+		return fmt.Errorf("failed to check tenant: %v", err)
+	}
+	if existingCount > 0 {
+		return nil
+	}
+
+	input := o.input.Tenant
+	isolation, err := NewTenantService(s.db).tenantIsolation(input)
+	if err != nil {
+		return err
+	}
+
+	status := "active"
+	if input.Status != "" {
+		status = input.Status
+	}
+
+	tenant := &models.Tenant{
+		ID:        o.record.TenantID,
+		Name:      input.Name,
+		Slug:      input.Slug,
+		Domain:    input.Domain,
+		Subdomain: input.Subdomain,
+		PlanID:    input.PlanID,
+		Settings:  input.Settings,
+		Status:    status,
+		Isolation: string(isolation),
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(tenant).Error; err != nil {
+			if strings.Contains(err.Error(), "duplicate key") {
+				return fmt.Errorf("tenant with slug '%s' already exists", input.Slug)
+			}
+			return fmt.Errorf("failed to create tenant: %v", err)
+		}
+
+		if isolation == tenancy.IsolationDatabase {
+			if err := tx.Create(&models.TenantDatabase{
+				TenantID: tenant.ID,
+				Host:     input.Database.Host,
+				Port:     input.Database.Port,
+				Username: input.Database.Username,
+				Password: input.Database.Password,
+				Database: input.Database.Database,
+				SSLMode:  input.Database.SSLMode,
+			}).Error; err != nil {
+				return fmt.Errorf("failed to store tenant database: %v", err)
+			}
+		}
+		return nil
+	})
+}
+
+// deleteTenant removes the tenant row and its database settings
+func (s *OnboardingService) deleteTenant(o *onboarding) error {
+	tenantID := o.record.TenantID
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tenant_id = ?", tenantID).Delete(&models.TenantDatabase{}).Error; err != nil {
+			return fmt.Errorf("failed to delete tenant database: %v", err)
+		}
+		if err := tx.Unscoped().Where("id = ?", tenantID).Delete(&models.Tenant{}).Error; err != nil {
+			return fmt.Errorf("failed to delete tenant: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if router := tenancy.RouterOf(s.db); router != nil {
+		return router.Forget(tenantID)
+	}
+	return nil
+}
+
+// migrateSchema creates the tenant schema and applies the pending tenant migrations
+func (s *OnboardingService) migrateSchema(o *onboarding) error {
+	_, err := NewSchemaMigrationService(s.db).MigrateTenant(o.record.TenantID)
+	return err
+}
+
+// dropSchema drops the tenant schema, unless the tenant shares the tables of others
+func (s *OnboardingService) dropSchema(o *onboarding) error {
+	return NewSchemaMigrationService(s.db).DropTenant(o.record.TenantID)
+}
+
+// seedRoles creates the tenant's system roles from the role templates
+func (s *OnboardingService) seedRoles(o *onboarding) error {
+	return NewRoleTemplateService(s.db).SeedTenant(o.record.TenantID)
+}
+
+// deleteRoles removes the roles of the tenant along with their grants and assignments
+func (s *OnboardingService) deleteRoles(o *onboarding) error {
+	tenantID := o.record.TenantID
+	return tenancy.New(s.db, tenantID).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("tenant_id = ?", tenantID).Delete(&models.Role{}).Error; err != nil {
+			return fmt.Errorf("failed to delete tenant roles: %v", err)
+		}
+		return nil
+	})
+}
+
+// createAdmin creates the first admin of the tenant unless a user with its email exists
+func (s *OnboardingService) createAdmin(o *onboarding) error {
+	admin := o.input.Admin
+	if admin == nil {
+		return nil
+	}
+
+	tenantID := o.record.TenantID
+	userService := NewUserService(s.db, tenantID)
+	if _, err := userService.GetUserByEmail(admin.Email); err == nil {
+		return nil
+	}
+
+	roleName := admin.Role
+	if roleName == "" {
+		roleName = "tenant_admin"
+	}
+	var role models.Role
+	err := tenancy.New(s.db, tenantID).Transaction(func(tx *gorm.DB) error {
+		return tx.Where("tenant_id = ? AND name = ?", tenantID, roleName).
+			Attrs(models.Role{TenantID: tenantID, Name: roleName, IsSystemRole: true}).
+			FirstOrCreate(&role).Error
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get role: %v", err)
+	}
+
+	_, err = userService.CreateUser(CreateUserInput{
+		Email:        admin.Email,
+		PasswordHash: admin.PasswordHash,
+		FirstName:    admin.FirstName,
+		LastName:     admin.LastName,
+		RoleIDs:      []uuid.UUID{role.ID},
+		Status:       admin.Status,
+	})
+	return err
+}
+
+// deleteAdmin removes the first admin of the tenant and its role assignments
+func (s *OnboardingService) deleteAdmin(o *onboarding) error {
+	if o.input.Admin == nil {
+		return nil
+	}
+
+	tenantID := o.record.TenantID
+	email := strings.ToLower(strings.TrimSpace(o.input.Admin.Email))
+	return tenancy.New(s.db, tenantID).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().
+			Where("tenant_id = ? AND email = ?", tenantID, email).
+			Delete(&models.TenantUser{}).Error; err != nil {
+			return fmt.Errorf("failed to delete admin user: %v", err)
+		}
+		return nil
+	})
+}
+
+// createSubscription starts the trial subscription of the tenant to its plan, unless the
+// tenant already has a subscription
+func (s *OnboardingService) createSubscription(o *onboarding) error {
+	planID := o.input.Tenant.PlanID
+	if o.input.TrialPeriod <= 0 || planID == nil {
+		return nil
+	}
+
+	var existingCount int64
+	if err := s.db.Model(&models.Subscription{}).
+		Where("tenant_id = ?", o.record.TenantID).
+		Count(&existingCount).Error; err != nil {
+		return fmt.Errorf("failed to check existing subscriptions: %v", err)
+	}
+	if existingCount > 0 {
+		return nil
+	}
+
+	// The trial runs from the start of the onboarding, however often it is resumed
+	trialEndDate := o.record.CreatedAt.Add(o.input.TrialPeriod)
+	_, err := NewSubscriptionService(s.db).CreateSubscription(CreateSubscriptionInput{
+		TenantID:     o.record.TenantID,
+		PlanID:       *planID,
+		StartDate:    &o.record.CreatedAt,
+		TrialEndDate: &trialEndDate,
+	})
+	return err
+}
+
+// deleteSubscription removes the subscriptions of the tenant
+func (s *OnboardingService) deleteSubscription(o *onboarding) error {
+	if err := s.db.Unscoped().Where("tenant_id = ?", o.record.TenantID).Delete(&models.Subscription{}).Error; err != nil {
+		return fmt.Errorf("failed to delete subscription: %v", err)
+	}
+	return nil
+}
+
+// enableModules enables the requested modules for the tenant. Modules already enabled
+// are left unchanged.
+func (s *OnboardingService) enableModules(o *onboarding) error {
+	names := o.input.Tenant.Modules
+	if len(names) == 0 {
+		return nil
+	}
+
+	var modules []struct {
+		ID   uuid.UUID
+		Name string
+	}
+	if err := s.db.Table("system.modules").
+		Select("id, name").
+		Where("name IN ? AND enabled = ?", names, true).
+		Scan(&modules).Error; err != nil {
+		return fmt.Errorf("failed to get modules: %v", err)
+	}
+
+	found := make(map[string]bool, len(modules))
+	tenantModules := make([]models.TenantModule, 0, len(modules))
+	for _, module := range modules {
+		found[module.Name] = true
+		tenantModules = append(tenantModules, models.TenantModule{
+			TenantID: o.record.TenantID,
+			ModuleID: module.ID,
+			Enabled:  true,
+		})
+	}
+	for _, name := range names {
+		if !found[name] {
+			return fmt.Errorf("module %s not found", name)
+		}
+	}
+
+	err := s.db.Omit(clause.Associations, "Configuration").
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&tenantModules).Error
+	if err != nil {
+		return fmt.Errorf("failed to enable modules: %v", err)
+	}
+	return nil
+}
+
+// disableModules removes the modules of the tenant
+func (s *OnboardingService) disableModules(o *onboarding) error {
+	if err := s.db.Where("tenant_id = ?", o.record.TenantID).Delete(&models.TenantModule{}).Error; err != nil {
+		return fmt.Errorf("failed to disable modules: %v", err)
+	}
+	return nil
+}
+
+// welcome notifies the first admin that the tenant is ready
+func (s *OnboardingService) welcome(o *onboarding) error {
+	if s.notifier == nil || o.input.Admin == nil {
+		return nil
+	}
+
+	tenant, err := NewTenantService(s.db).GetTenant(o.record.TenantID)
+	if err != nil {
+		return err
+	}
+	admin, err := NewUserService(s.db, o.record.TenantID).GetUserByEmail(o.input.Admin.Email)
+	if err != nil {
+		return err
+	}
+	return s.notifier.NotifyWelcome(tenant, admin)
+}
+
+// removeStep returns steps without the given step
+func removeStep(steps []string, step string) []string {
+	remaining := make([]string, 0, len(steps))
+	for _, applied := range steps {
+		if applied != step {
+			remaining = append(remaining, applied)
+		}
+	}
+	return remaining
+}
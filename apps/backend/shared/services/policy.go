@@ -0,0 +1,148 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// ErrAccessDenied is returned when an access policy does not allow access to a record
+var ErrAccessDenied = errors.New("access denied")
+
+// PolicyService manages a tenant's access policies and evaluates them for list
+// filtering and single-record authorization
+type PolicyService struct {
+	db       *tenancy.DB
+	tenantID uuid.UUID
+}
+
+// NewPolicyService creates a new policy service for a specific tenant
+func NewPolicyService(db *gorm.DB, tenantID uuid.UUID) *PolicyService {
+	return &PolicyService{
+		db:       tenancy.New(db, tenantID),
+		tenantID: tenantID,
+	}
+}
+
+// ListPolicies returns the tenant's access policies
+func (s *PolicyService) ListPolicies() ([]models.AccessPolicy, error) {
+	var policies []models.AccessPolicy
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		policies, err = s.listPolicies(tx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return policies, nil
+}
+
+// CreatePolicy validates and stores an access policy
+func (s *PolicyService) CreatePolicy(policy *models.AccessPolicy) error {
+	policy.TenantID = s.tenantID
+	policy.Resource = strings.ToLower(strings.TrimSpace(policy.Resource))
+	policy.Action = strings.ToLower(strings.TrimSpace(policy.Action))
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(policy).Error; err != nil {
+			return fmt.Errorf("failed to create policy: %v", err)
+		}
+		return nil
+	})
+}
+
+// DeletePolicy removes an access policy
+func (s *PolicyService) DeletePolicy(id uuid.UUID) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("tenant_id = ?", s.tenantID).Delete(&models.AccessPolicy{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete policy: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("policy not found")
+		}
+		return nil
+	})
+}
+
+// Authorize returns ErrAccessDenied unless the policies allow the subject's action on the record
+func (s *PolicyService) Authorize(subject *models.PolicySubject, resource, action string, record models.PolicyRecord) error {
+	policies, err := s.ListPolicies()
+	if err != nil {
+		return err
+	}
+	if !models.PoliciesAllow(policies, subject, resource, action, record) {
+		return ErrAccessDenied
+	}
+	return nil
+}
+
+// Scope restricts a query of the resource to the records the subject's action is allowed
+// on. The query must run in a transaction of the tenant, which the policies are read in.
+func (s *PolicyService) Scope(query *gorm.DB, subject *models.PolicySubject, resource, action string) (*gorm.DB, error) {
+	policies, err := s.listPolicies(query.Session(&gorm.Session{NewDB: true}))
+	if err != nil {
+		return nil, err
+	}
+	return ScopeQuery(query, policies, subject, resource, action), nil
+}
+
+// SubjectAttributes returns the user attributes policies can compare records against,
+// taken from the employee record with the user's email
+func (s *PolicyService) SubjectAttributes(email string) (map[string]string, error) {
+	attributes := make(map[string]string)
+
+	var employee models.Employee
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Where("tenant_id = ? AND email = ?", s.tenantID, email).First(&employee).Error
+	})
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return attributes, nil
+		}
+		return nil, fmt.Errorf("failed to load user attributes: %v", err)
+	}
+
+	attributes["employee_id"] = employee.ID.String()
+	if employee.DepartmentID != nil {
+		attributes["department_id"] = employee.DepartmentID.String()
+	}
+	return attributes, nil
+}
+
+// listPolicies reads the tenant's access policies in a transaction of the tenant
+func (s *PolicyService) listPolicies(tx *gorm.DB) ([]models.AccessPolicy, error) {
+	var policies []models.AccessPolicy
+	if err := tx.Where("tenant_id = ?", s.tenantID).Order("created_at").Find(&policies).Error; err != nil {
+		return nil, fmt.Errorf("failed to list policies: %v", err)
+	}
+	return policies, nil
+}
+
+// ScopeQuery adds the conditions of the applicable policies to a query. Records have to
+// satisfy one of the policies; without applicable policies the query is unchanged.
+func ScopeQuery(query *gorm.DB, policies []models.AccessPolicy, subject *models.PolicySubject, resource, action string) *gorm.DB {
+	applicable := models.ApplicablePolicies(policies, subject, resource, action)
+	if len(applicable) == 0 {
+		return query
+	}
+
+	clauses := make([]string, 0, len(applicable))
+	var args []interface{}
+	for _, policy := range applicable {
+		clause, clauseArgs := policy.Scope(subject)
+		clauses = append(clauses, clause)
+		args = append(args, clauseArgs...)
+	}
+	return query.Where(strings.Join(clauses, " OR "), args...)
+}
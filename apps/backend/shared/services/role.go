@@ -0,0 +1,123 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// RoleService manages the permissions of a tenant's roles
+type RoleService struct {
+	db       *tenancy.DB
+	tenantID uuid.UUID
+	events   *EventBus
+}
+
+// NewRoleService creates a new role service for a specific tenant
+func NewRoleService(db *gorm.DB, tenantID uuid.UUID) *RoleService {
+	return &RoleService{
+		db:       tenancy.New(db, tenantID),
+		tenantID: tenantID,
+	}
+}
+
+// SetEventBus sets the event bus used to publish role permission changes
+func (s *RoleService) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
+// GetRole retrieves a role with its permissions
+func (s *RoleService) GetRole(id uuid.UUID) (*models.Role, error) {
+	var role *models.Role
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		role, err = s.getRole(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// AssignPermissions grants permissions to a role in addition to the ones it has
+func (s *RoleService) AssignPermissions(roleID uuid.UUID, permissionIDs []uuid.UUID) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		role, err := s.getRole(tx, roleID)
+		if err != nil {
+			return err
+		}
+
+		var permissions []models.Permission
+		if err := tx.Where("id IN ?", permissionIDs).Find(&permissions).Error; err != nil {
+			return fmt.Errorf("failed to verify permissions: %v", err)
+		}
+		if len(permissions) != len(permissionIDs) {
+			return fmt.Errorf("some permissions were not found")
+		}
+
+		if err := tx.Model(role).Association("Permissions").Append(&permissions); err != nil {
+			return fmt.Errorf("failed to assign permissions: %v", err)
+		}
+		return s.customize(tx, roleID)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish()
+
+	return nil
+}
+
+// RemovePermissions revokes permissions from a role
+func (s *RoleService) RemovePermissions(roleID uuid.UUID, permissionIDs []uuid.UUID) error {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := s.getRole(tx, roleID); err != nil {
+			return err
+		}
+
+		if err := tx.Where("role_id = ? AND permission_id IN ?", roleID, permissionIDs).
+			Delete(&models.RolePermission{}).Error; err != nil {
+			return fmt.Errorf("failed to remove permissions: %v", err)
+		}
+		return s.customize(tx, roleID)
+	})
+	if err != nil {
+		return err
+	}
+	s.publish()
+
+	return nil
+}
+
+// getRole retrieves a role of the tenant with its permissions
+func (s *RoleService) getRole(tx *gorm.DB, id uuid.UUID) (*models.Role, error) {
+	var role models.Role
+	err := tx.Preload("Permissions").
+		Where("tenant_id = ?", s.tenantID).
+		First(&role, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("role not found")
+		}
+		return nil, fmt.Errorf("failed to get role: %v", err)
+	}
+	return &role, nil
+}
+
+// customize marks a role as changed by the tenant, so updates of its template no longer apply
+func (s *RoleService) customize(tx *gorm.DB, roleID uuid.UUID) error {
+	if err := tx.Model(&models.Role{}).Where("id = ?", roleID).Update("customized", true).Error; err != nil {
+		return fmt.Errorf("failed to mark role as customized: %v", err)
+	}
+	return nil
+}
+
+// publish announces that the permissions of every holder of a role changed
+func (s *RoleService) publish() {
+	s.events.Publish(Event{Type: EventRolesChanged, TenantID: s.tenantID})
+}
@@ -0,0 +1,161 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// RoleAssignmentMode selects how a role change treats the roles a user already has
+type RoleAssignmentMode string
+
+const (
+	// RoleModeAdd assigns the roles in addition to the user's other roles
+	RoleModeAdd RoleAssignmentMode = "add"
+	// RoleModeRemove removes the roles and keeps the user's other roles
+	RoleModeRemove RoleAssignmentMode = "remove"
+	// RoleModeReplace makes the roles the only roles of the user
+	RoleModeReplace RoleAssignmentMode = "replace"
+)
+
+// Valid reports whether the mode is one of the known modes
+func (m RoleAssignmentMode) Valid() bool {
+	return m == RoleModeAdd || m == RoleModeRemove || m == RoleModeReplace
+}
+
+// ErrInvalidRoleMode is returned for unknown role assignment modes
+var ErrInvalidRoleMode = errors.New("mode must be add, remove or replace")
+
+// ErrInvalidRoleWindow is returned for role assignment windows that end before they start or in the past
+var ErrInvalidRoleWindow = errors.New("valid_until must be in the future and after valid_from")
+
+// RoleWindow limits a role assignment to a period. Nil bounds are open, so a window
+// without valid_from starts immediately and one without valid_until never expires.
+type RoleWindow struct {
+	ValidFrom  *time.Time `json:"valid_from"`
+	ValidUntil *time.Time `json:"valid_until"`
+}
+
+// Validate checks that the window ends after it starts and has not ended yet.
+// A nil window is valid.
+func (w *RoleWindow) Validate() error {
+	if w == nil || w.ValidUntil == nil {
+		return nil
+	}
+	if !w.ValidUntil.After(time.Now()) {
+		return ErrInvalidRoleWindow
+	}
+	if w.ValidFrom != nil && !w.ValidUntil.After(*w.ValidFrom) {
+		return ErrInvalidRoleWindow
+	}
+	return nil
+}
+
+// assignment returns the assignment of a role to a user within the window
+func (w *RoleWindow) assignment(userID, roleID uuid.UUID, assignedAt time.Time) *models.UserRole {
+	userRole := &models.UserRole{
+		UserID:     userID,
+		RoleID:     roleID,
+		AssignedAt: assignedAt,
+	}
+	if w != nil {
+		userRole.ValidFrom = w.ValidFrom
+		userRole.ValidUntil = w.ValidUntil
+	}
+	return userRole
+}
+
+// distinctIDs returns the IDs without duplicates, keeping their order
+func distinctIDs(ids []uuid.UUID) []uuid.UUID {
+	result := make([]uuid.UUID, 0, len(ids))
+	seen := make(map[uuid.UUID]bool)
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// RoleAssignmentSweeper removes role assignments whose window has ended. Permission
+// checks already ignore them; sweeping keeps the assignments tables clean and tells
+// subscribers which users lost roles.
+type RoleAssignmentSweeper struct {
+	db     *gorm.DB
+	events *EventBus
+}
+
+// NewRoleAssignmentSweeper creates a new role assignment sweeper
+func NewRoleAssignmentSweeper(db *gorm.DB) *RoleAssignmentSweeper {
+	return &RoleAssignmentSweeper{db: db}
+}
+
+// SetEventBus sets the event bus used to publish removed assignments
+func (s *RoleAssignmentSweeper) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
+// Sweep removes the role assignments that expired before now from every tenant and
+// publishes an EventRoleAssignmentExpired for every affected user. It returns the number
+// removed; a tenant that fails to sweep does not stop the others.
+func (s *RoleAssignmentSweeper) Sweep(now time.Time) (int, error) {
+	removed := 0
+	err := forEachTenant(s.db, func(tenantID uuid.UUID, tenantDB *tenancy.DB) error {
+		var expired []models.UserRole
+		err := tenantDB.Transaction(func(tx *gorm.DB) error {
+			// Tenants with shared isolation share the assignments table, so only the
+			// assignments of this tenant's users are swept
+			if err := tx.Where("user_id IN (SELECT id FROM users WHERE tenant_id = ?)", tenantID).
+				Where("valid_until <= ?", now).Find(&expired).Error; err != nil {
+				return fmt.Errorf("failed to find expired role assignments: %v", err)
+			}
+
+			for _, assignment := range expired {
+				if err := tx.Where("user_id IN (SELECT id FROM users WHERE tenant_id = ?)", tenantID).
+					Where("user_id = ? AND role_id = ? AND valid_until <= ?", assignment.UserID, assignment.RoleID, now).
+					Delete(&models.UserRole{}).Error; err != nil {
+					return fmt.Errorf("failed to remove expired role assignment: %v", err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		removed += len(expired)
+		published := make(map[uuid.UUID]bool)
+		for _, assignment := range expired {
+			if !published[assignment.UserID] {
+				published[assignment.UserID] = true
+				s.events.Publish(Event{Type: EventRoleAssignmentExpired, TenantID: tenantID, UserID: assignment.UserID})
+			}
+		}
+		return nil
+	})
+	return removed, err
+}
+
+// Start sweeps expired role assignments in the background at the given interval
+func (s *RoleAssignmentSweeper) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for now := range ticker.C {
+			if removed, err := s.Sweep(now); err != nil {
+				log.Printf("Failed to sweep expired role assignments: %v", err)
+			} else if removed > 0 {
+				log.Printf("Removed %d expired role assignments", removed)
+			}
+		}
+	}()
+}
@@ -0,0 +1,383 @@
+package services
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// DefaultRoleTemplates is the catalogue of system role templates created when missing
+var DefaultRoleTemplates = []models.RoleTemplate{
+	{
+		Name:        "tenant_admin",
+		DisplayName: "Tenant Administrator",
+		Description: stringPtr("Full access to the tenant and its users"),
+		Permissions: []string{"users:manage", "roles:manage", "customers:manage", "employees:manage", "departments:manage", "products:manage", "orders:manage"},
+	},
+	{
+		Name:        "manager",
+		DisplayName: "Manager",
+		Description: stringPtr("Manages business data and reads users"),
+		Permissions: []string{"users:read", "customers:write", "employees:write", "departments:read", "products:write", "orders:write"},
+	},
+	{
+		Name:        "user",
+		DisplayName: "User",
+		Description: stringPtr("Read-only access to business data"),
+		Permissions: []string{"customers:read", "products:read"},
+	},
+	{
+		Name:        "cashier",
+		DisplayName: "Cashier",
+		Description: stringPtr("Rings up sales at the point of sale"),
+		Module:      "pos",
+		Permissions: []string{"orders:write", "products:read", "customers:read"},
+	},
+	{
+		Name:        "hr_officer",
+		DisplayName: "HR Officer",
+		Description: stringPtr("Maintains employee and department records"),
+		Module:      "hrm",
+		Permissions: []string{"employees:manage", "departments:write", "users:read"},
+	},
+}
+
+// RoleTemplateService manages the system role templates, seeds them into tenants, clones
+// them into custom roles and applies template updates to the roles created from them
+type RoleTemplateService struct {
+	db     *gorm.DB
+	events *EventBus
+}
+
+// NewRoleTemplateService creates a new role template service
+func NewRoleTemplateService(db *gorm.DB) *RoleTemplateService {
+	return &RoleTemplateService{db: db}
+}
+
+// SetEventBus sets the event bus used to publish role changes caused by template updates
+func (s *RoleTemplateService) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
+// UpdateRoleTemplateInput represents input for updating a role template
+type UpdateRoleTemplateInput struct {
+	DisplayName *string  `json:"display_name"`
+	Description *string  `json:"description"`
+	Permissions []string `json:"permissions"` // nil keeps the current permissions
+}
+
+// CloneRoleTemplateInput represents input for cloning a role template into a custom role
+type CloneRoleTemplateInput struct {
+	Name        string  `json:"name" validate:"required"`
+	DisplayName string  `json:"display_name"`
+	Description *string `json:"description"`
+}
+
+// EnsureDefaultTemplates creates the default templates that do not exist yet. Existing
+// templates are left unchanged.
+func (s *RoleTemplateService) EnsureDefaultTemplates() error {
+	for _, template := range DefaultRoleTemplates {
+		template := template
+		template.Version = 1
+		err := s.db.Where("name = ?", template.Name).
+			Attrs(template).
+			FirstOrCreate(&models.RoleTemplate{}).Error
+		if err != nil {
+			return fmt.Errorf("failed to create role template %s: %v", template.Name, err)
+		}
+	}
+	return nil
+}
+
+// ListTemplates returns all role templates ordered by module and name
+func (s *RoleTemplateService) ListTemplates() ([]models.RoleTemplate, error) {
+	var templates []models.RoleTemplate
+	if err := s.db.Order("module, name").Find(&templates).Error; err != nil {
+		return nil, fmt.Errorf("failed to list role templates: %v", err)
+	}
+	return templates, nil
+}
+
+// GetTemplate retrieves a role template by name
+func (s *RoleTemplateService) GetTemplate(name string) (*models.RoleTemplate, error) {
+	var template models.RoleTemplate
+	if err := s.db.Where("name = ?", name).First(&template).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("role template not found")
+		}
+		return nil, fmt.Errorf("failed to get role template: %v", err)
+	}
+	return &template, nil
+}
+
+// UpdateTemplate changes a role template and applies the change to the tenant roles
+// created from it that were not customised. It returns the number of roles updated.
+// Tenants that fail to update keep their older template version and are brought up to
+// date by PushPending.
+func (s *RoleTemplateService) UpdateTemplate(name string, input UpdateRoleTemplateInput) (*models.RoleTemplate, int, error) {
+	template, err := s.GetTemplate(name)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if input.DisplayName != nil {
+		template.DisplayName = strings.TrimSpace(*input.DisplayName)
+	}
+	if input.Description != nil {
+		template.Description = input.Description
+	}
+	if input.Permissions != nil {
+		template.Permissions = input.Permissions
+	}
+	if err := template.Validate(); err != nil {
+		return nil, 0, err
+	}
+	template.Permissions = normalizePermissions(template.Permissions)
+	template.Version++
+
+	if err := s.db.Save(template).Error; err != nil {
+		return nil, 0, fmt.Errorf("failed to update role template: %v", err)
+	}
+
+	updated, err := s.PushTemplate(template)
+	if err != nil {
+		log.Printf("Failed to push role template %s, it will be retried: %v", template.Name, err)
+	}
+	return template, updated, nil
+}
+
+// PushTemplate brings the uncustomised tenant roles created from a template up to its
+// current version. It returns the number of roles updated; a tenant that fails to update
+// does not stop the others.
+func (s *RoleTemplateService) PushTemplate(template *models.RoleTemplate) (int, error) {
+	updated := 0
+	err := forEachTenant(s.db, func(tenantID uuid.UUID, tenantDB *tenancy.DB) error {
+		var roles []models.Role
+		err := tenantDB.Transaction(func(tx *gorm.DB) error {
+			// Tenants with shared isolation share the roles table
+			err := tx.Where("tenant_id = ? AND template_id = ? AND customized = ? AND template_version < ?", tenantID, template.ID, false, template.Version).
+				Find(&roles).Error
+			if err != nil {
+				return fmt.Errorf("failed to find roles of template: %v", err)
+			}
+			if len(roles) == 0 {
+				return nil
+			}
+
+			permissions, err := resolvePermissions(tx, template.Permissions)
+			if err != nil {
+				return err
+			}
+
+			for i := range roles {
+				role := &roles[i]
+				if err := tx.Where("role_id = ?", role.ID).Delete(&models.RolePermission{}).Error; err != nil {
+					return fmt.Errorf("failed to remove role permissions: %v", err)
+				}
+				for _, permission := range permissions {
+					if err := tx.Create(&models.RolePermission{RoleID: role.ID, PermissionID: permission.ID}).Error; err != nil {
+						return fmt.Errorf("failed to assign role permission: %v", err)
+					}
+				}
+
+				err := tx.Model(role).Updates(map[string]interface{}{
+					"display_name":     template.DisplayName,
+					"description":      template.Description,
+					"template_version": template.Version,
+				}).Error
+				if err != nil {
+					return fmt.Errorf("failed to update role: %v", err)
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		if len(roles) > 0 {
+			updated += len(roles)
+			s.events.Publish(Event{Type: EventRolesChanged, TenantID: tenantID})
+		}
+		return nil
+	})
+	return updated, err
+}
+
+// PushPending pushes every template to the tenant roles still on an older version of
+// it, e.g. after an update failed to reach some tenants. It returns the number of roles
+// updated.
+func (s *RoleTemplateService) PushPending() (int, error) {
+	templates, err := s.ListTemplates()
+	if err != nil {
+		return 0, err
+	}
+
+	updated := 0
+	var firstErr error
+	for i := range templates {
+		count, err := s.PushTemplate(&templates[i])
+		updated += count
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("role template %s: %w", templates[i].Name, err)
+		}
+	}
+	return updated, firstErr
+}
+
+// Start pushes pending template updates in the background at the given interval
+func (s *RoleTemplateService) Start(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if updated, err := s.PushPending(); err != nil {
+				log.Printf("Failed to push role templates: %v", err)
+			} else if updated > 0 {
+				log.Printf("Updated %d roles from their templates", updated)
+			}
+		}
+	}()
+}
+
+// SeedTenant creates a system role for every template in a tenant. Roles the tenant
+// already has are skipped, so seeding can be repeated.
+func (s *RoleTemplateService) SeedTenant(tenantID uuid.UUID) error {
+	if err := s.EnsureDefaultTemplates(); err != nil {
+		return err
+	}
+	templates, err := s.ListTemplates()
+	if err != nil {
+		return err
+	}
+
+	return tenancy.New(s.db, tenantID).Transaction(func(tx *gorm.DB) error {
+		for i := range templates {
+			template := &templates[i]
+
+			var count int64
+			if err := tx.Model(&models.Role{}).
+				Where("tenant_id = ? AND name = ?", tenantID, template.Name).
+				Count(&count).Error; err != nil {
+				return fmt.Errorf("failed to check role %s: %v", template.Name, err)
+			}
+			if count > 0 {
+				continue
+			}
+
+			role := &models.Role{
+				TenantID:     tenantID,
+				Name:         template.Name,
+				DisplayName:  template.DisplayName,
+				Description:  template.Description,
+				IsSystemRole: true,
+			}
+			if err := createFromTemplate(tx, role, template); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// CloneTemplate creates a custom role in a tenant with the permissions of a template.
+// The clone is customised from the start, so later template updates leave it unchanged.
+func (s *RoleTemplateService) CloneTemplate(tenantID uuid.UUID, templateName string, input CloneRoleTemplateInput) (*models.Role, error) {
+	template, err := s.GetTemplate(templateName)
+	if err != nil {
+		return nil, err
+	}
+
+	name := strings.ToLower(strings.TrimSpace(input.Name))
+	if name == "" {
+		return nil, fmt.Errorf("role name is required")
+	}
+
+	role := &models.Role{
+		TenantID:    tenantID,
+		Name:        name,
+		DisplayName: strings.TrimSpace(input.DisplayName),
+		Description: input.Description,
+		Customized:  true,
+	}
+	if role.DisplayName == "" {
+		role.DisplayName = template.DisplayName
+	}
+	if role.Description == nil {
+		role.Description = template.Description
+	}
+
+	err = tenancy.New(s.db, tenantID).Transaction(func(tx *gorm.DB) error {
+		var count int64
+		if err := tx.Model(&models.Role{}).
+			Where("tenant_id = ? AND LOWER(name) = ?", tenantID, name).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to check role name: %v", err)
+		}
+		if count > 0 {
+			return fmt.Errorf("role already exists")
+		}
+		return createFromTemplate(tx, role, template)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return role, nil
+}
+
+// createFromTemplate creates a role linked to the template with the template's
+// permissions, in a transaction of the role's tenant
+func createFromTemplate(tx *gorm.DB, role *models.Role, template *models.RoleTemplate) error {
+	permissions, err := resolvePermissions(tx, template.Permissions)
+	if err != nil {
+		return err
+	}
+
+	role.TemplateID = &template.ID
+	role.TemplateVersion = template.Version
+	role.Permissions = permissions
+	if err := tx.Create(role).Error; err != nil {
+		return fmt.Errorf("failed to create role %s: %v", role.Name, err)
+	}
+	return nil
+}
+
+// resolvePermissions returns the catalogue permissions with the given names, adding
+// the ones the catalogue does not have yet
+func resolvePermissions(db *gorm.DB, names []string) ([]models.Permission, error) {
+	permissions := make([]models.Permission, 0, len(names))
+	for _, name := range normalizePermissions(names) {
+		resource, action, _ := strings.Cut(name, ":")
+		var permission models.Permission
+		err := db.Where("name = ?", name).
+			Attrs(models.Permission{Name: name, Resource: resource, Action: action}).
+			FirstOrCreate(&permission).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to get permission %s: %v", name, err)
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions, nil
+}
+
+// normalizePermissions returns the distinct canonical forms of permission names
+func normalizePermissions(names []string) []string {
+	result := make([]string, 0, len(names))
+	seen := make(map[string]bool)
+	for _, name := range names {
+		normalized := models.NormalizePermission(name)
+		if !seen[normalized] {
+			seen[normalized] = true
+			result = append(result, normalized)
+		}
+	}
+	return result
+}
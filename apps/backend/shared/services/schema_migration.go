@@ -0,0 +1,218 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/migrations"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// SchemaMigrationService applies the system and tenant schema migrations
+type SchemaMigrationService struct {
+	db *gorm.DB
+}
+
+// NewSchemaMigrationService creates a new schema migration service
+func NewSchemaMigrationService(db *gorm.DB) *SchemaMigrationService {
+	return &SchemaMigrationService{db: db}
+}
+
+// TenantMigrationResult is the outcome of migrating one tenant's schema
+type TenantMigrationResult struct {
+	TenantID uuid.UUID              `json:"tenant_id"`
+	Slug     string                 `json:"slug"`
+	Schema   string                 `json:"schema"`
+	Applied  []migrations.Migration `json:"applied"`
+	Error    string                 `json:"error,omitempty"`
+}
+
+// TenantMigrationStatus is the migration status of one tenant's schema
+type TenantMigrationStatus struct {
+	TenantID uuid.UUID `json:"tenant_id"`
+	Slug     string    `json:"slug"`
+	*migrations.Status
+}
+
+// MigrateSystem applies the pending migrations of the system schema. A system schema
+// that was set up by hand before migrations were versioned is recorded as version 1.
+func (s *SchemaMigrationService) MigrateSystem() ([]migrations.Migration, error) {
+	migrator, err := migrations.NewSystemMigrator(s.db)
+	if err != nil {
+		return nil, err
+	}
+	if err := adoptExistingSchema(s.db, migrator, migrations.SystemSchema, "tenants"); err != nil {
+		return nil, err
+	}
+	return migrator.Migrate(migrations.SystemSchema)
+}
+
+// SystemStatus reports the migration status of the system schema
+func (s *SchemaMigrationService) SystemStatus() (*migrations.Status, error) {
+	migrator, err := migrations.NewSystemMigrator(s.db)
+	if err != nil {
+		return nil, err
+	}
+	return migrator.Status(migrations.SystemSchema)
+}
+
+// MigrateTenant creates a tenant's schema when needed and applies its pending migrations.
+// The schema is the one of the tenant's isolation strategy, so tenants sharing tables
+// share the migrations of the shared schema.
+func (s *SchemaMigrationService) MigrateTenant(tenantID uuid.UUID) ([]migrations.Migration, error) {
+	_, applied, err := s.migrateTenant(tenantID)
+	return applied, err
+}
+
+// MigrateAllTenants rolls every tenant schema forward to the latest migration. A failing
+// tenant does not stop the others; its error is reported in its result.
+func (s *SchemaMigrationService) MigrateAllTenants() ([]TenantMigrationResult, error) {
+	tenants, err := s.listTenants()
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]TenantMigrationResult, 0, len(tenants))
+	for _, tenant := range tenants {
+		result := TenantMigrationResult{
+			TenantID: tenant.ID,
+			Slug:     tenant.Slug,
+			Applied:  []migrations.Migration{},
+		}
+		schema, applied, err := s.migrateTenant(tenant.ID)
+		result.Schema = schema
+		if err != nil {
+			result.Error = err.Error()
+		} else if applied != nil {
+			result.Applied = applied
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// DropTenant drops the schema of a tenant with a schema or a database of its own. The
+// shared schema of tenants sharing tables is kept; their rows are left to the caller.
+func (s *SchemaMigrationService) DropTenant(tenantID uuid.UUID) error {
+	strategy, err := tenancy.StrategyOf(s.db, tenantID)
+	if err != nil {
+		return err
+	}
+	if strategy.Isolation() == tenancy.IsolationShared {
+		return nil
+	}
+
+	db, schema, err := locateTenant(s.db, tenantID)
+	if err != nil {
+		return err
+	}
+	quoted, err := migrations.QuoteIdentifier(schema)
+	if err != nil {
+		return err
+	}
+	if err := db.Exec("DROP SCHEMA IF EXISTS " + quoted + " CASCADE").Error; err != nil {
+		return fmt.Errorf("failed to drop schema %s: %v", schema, err)
+	}
+	return nil
+}
+
+// TenantStatuses reports the migration status of every tenant schema
+func (s *SchemaMigrationService) TenantStatuses() ([]TenantMigrationStatus, error) {
+	tenants, err := s.listTenants()
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]TenantMigrationStatus, 0, len(tenants))
+	for _, tenant := range tenants {
+		db, schema, err := locateTenant(s.db, tenant.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get migration status of tenant %s: %v", tenant.Slug, err)
+		}
+		migrator, err := migrations.NewTenantMigrator(db)
+		if err != nil {
+			return nil, err
+		}
+		status, err := migrator.Status(schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get migration status of tenant %s: %v", tenant.Slug, err)
+		}
+		statuses = append(statuses, TenantMigrationStatus{
+			TenantID: tenant.ID,
+			Slug:     tenant.Slug,
+			Status:   status,
+		})
+	}
+	return statuses, nil
+}
+
+// Helper methods
+
+// migrateTenant applies the pending migrations of a tenant's schema and returns its name
+func (s *SchemaMigrationService) migrateTenant(tenantID uuid.UUID) (string, []migrations.Migration, error) {
+	db, schema, err := locateTenant(s.db, tenantID)
+	if err != nil {
+		return "", nil, err
+	}
+	migrator, err := migrations.NewTenantMigrator(db)
+	if err != nil {
+		return schema, nil, err
+	}
+	if err := adoptExistingSchema(db, migrator, schema, "users"); err != nil {
+		return schema, nil, err
+	}
+	applied, err := migrator.Migrate(schema)
+	return schema, applied, err
+}
+
+// locateTenant returns the database holding a tenant's schema, which is the tenant's own
+// database with database isolation, and the name of the schema
+func locateTenant(db *gorm.DB, tenantID uuid.UUID) (*gorm.DB, string, error) {
+	tenantDB := tenancy.New(db, tenantID)
+	schema, err := tenantDB.Schema()
+	if err != nil {
+		return nil, "", err
+	}
+	conn, err := tenantDB.Database()
+	if err != nil {
+		return nil, "", err
+	}
+	return conn, schema, nil
+}
+
+// listTenants returns the tenants whose schemas are migrated
+func (s *SchemaMigrationService) listTenants() ([]models.Tenant, error) {
+	var tenants []models.Tenant
+	if err := s.db.Order("created_at").Find(&tenants).Error; err != nil {
+		return nil, fmt.Errorf("failed to list tenants: %v", err)
+	}
+	return tenants, nil
+}
+
+// adoptExistingSchema records the first migration of a schema as applied when the schema
+// has no migration history yet but already contains the given table of that migration
+func adoptExistingSchema(db *gorm.DB, migrator *migrations.Migrator, schema, table string) error {
+	status, err := migrator.Status(schema)
+	if err != nil {
+		return err
+	}
+	if status.Version > 0 {
+		return nil
+	}
+
+	quoted, err := migrations.QuoteIdentifier(schema)
+	if err != nil {
+		return err
+	}
+	var exists bool
+	if err := db.Raw("SELECT to_regclass(?) IS NOT NULL", quoted+"."+table).Scan(&exists).Error; err != nil {
+		return fmt.Errorf("failed to inspect schema %s: %v", schema, err)
+	}
+	if !exists {
+		return nil
+	}
+	return migrator.Baseline(schema, 1)
+}
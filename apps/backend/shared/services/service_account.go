@@ -0,0 +1,351 @@
+package services
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+)
+
+// API key errors
+var (
+	// ErrInvalidAPIKey is returned for keys that are malformed or unknown
+	ErrInvalidAPIKey = errors.New("invalid API key")
+	// ErrAPIKeyInactive is returned for keys that expired or were revoked
+	ErrAPIKeyInactive = errors.New("API key expired or revoked")
+	// ErrIPNotAllowed is returned when a key is used from an address outside its account's allow-list
+	ErrIPNotAllowed = errors.New("IP address not allowed for this API key")
+	// ErrInvalidKeyExpiry is returned for key expiry times that are not in the future
+	ErrInvalidKeyExpiry = errors.New("expires_at must be in the future")
+	// ErrInvalidServiceAccount is returned for service accounts that fail validation
+	ErrInvalidServiceAccount = errors.New("invalid service account")
+)
+
+// apiKeyUsageInterval limits how often the last-used time of a key is written
+const apiKeyUsageInterval = time.Minute
+
+// ServiceAccountService manages a tenant's service accounts and their API keys
+type ServiceAccountService struct {
+	db       *tenancy.DB
+	tenantID uuid.UUID
+}
+
+// NewServiceAccountService creates a new service account service for a specific tenant
+func NewServiceAccountService(db *gorm.DB, tenantID uuid.UUID) *ServiceAccountService {
+	return &ServiceAccountService{
+		db:       tenancy.New(db, tenantID),
+		tenantID: tenantID,
+	}
+}
+
+// CreateServiceAccountInput represents input for creating a service account
+type CreateServiceAccountInput struct {
+	Name        string     `json:"name" validate:"required"`
+	Description *string    `json:"description"`
+	Permissions []string   `json:"permissions" validate:"required"`
+	AllowedIPs  []string   `json:"allowed_ips"`
+	ExpiresAt   *time.Time `json:"expires_at"` // Expiry of the first key, nil for none
+	CreatedBy   *uuid.UUID `json:"-"`
+}
+
+// CreateServiceAccount creates a service account with its first API key. The key is
+// returned once and cannot be retrieved later.
+func (s *ServiceAccountService) CreateServiceAccount(input CreateServiceAccountInput) (*models.ServiceAccount, string, error) {
+	account := &models.ServiceAccount{
+		TenantID:    s.tenantID,
+		Name:        strings.TrimSpace(input.Name),
+		Description: input.Description,
+		Permissions: normalizePermissions(input.Permissions),
+		AllowedIPs:  input.AllowedIPs,
+		CreatedBy:   input.CreatedBy,
+	}
+	if err := account.Validate(); err != nil {
+		return nil, "", fmt.Errorf("%w: %v", ErrInvalidServiceAccount, err)
+	}
+	if err := validateKeyExpiry(input.ExpiresAt); err != nil {
+		return nil, "", err
+	}
+
+	var key string
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(account).Error; err != nil {
+			return fmt.Errorf("failed to create service account: %v", err)
+		}
+		apiKey, plaintext, err := s.issueKey(tx, account.ID, input.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		account.Keys = []models.APIKey{*apiKey}
+		key = plaintext
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return account, key, nil
+}
+
+// ListServiceAccounts returns the tenant's service accounts with their keys
+func (s *ServiceAccountService) ListServiceAccounts() ([]models.ServiceAccount, error) {
+	var accounts []models.ServiceAccount
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		return tx.Preload("Keys", func(db *gorm.DB) *gorm.DB { return db.Order("created_at") }).
+			Where("tenant_id = ?", s.tenantID).
+			Order("created_at").
+			Find(&accounts).Error
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list service accounts: %v", err)
+	}
+	return accounts, nil
+}
+
+// GetServiceAccount retrieves a service account with its keys
+func (s *ServiceAccountService) GetServiceAccount(id uuid.UUID) (*models.ServiceAccount, error) {
+	var account *models.ServiceAccount
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		account, err = s.getServiceAccount(tx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+// DeleteServiceAccount revokes the keys of a service account and deletes it
+func (s *ServiceAccountService) DeleteServiceAccount(id uuid.UUID) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("tenant_id = ?", s.tenantID).Delete(&models.ServiceAccount{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete service account: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("service account not found")
+		}
+		if err := tx.Model(&models.APIKey{}).
+			Where("service_account_id = ? AND revoked_at IS NULL", id).
+			Update("revoked_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to revoke API keys: %v", err)
+		}
+		return nil
+	})
+}
+
+// CreateKey issues another API key for a service account
+func (s *ServiceAccountService) CreateKey(accountID uuid.UUID, expiresAt *time.Time) (*models.APIKey, string, error) {
+	if err := validateKeyExpiry(expiresAt); err != nil {
+		return nil, "", err
+	}
+
+	var apiKey *models.APIKey
+	var key string
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := s.getServiceAccount(tx, accountID); err != nil {
+			return err
+		}
+		var err error
+		apiKey, key, err = s.issueKey(tx, accountID, expiresAt)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+// RotateKey revokes an API key and issues its replacement. Without an expiry the new
+// key gets the lifetime the old key was issued with.
+func (s *ServiceAccountService) RotateKey(accountID, keyID uuid.UUID, expiresAt *time.Time) (*models.APIKey, string, error) {
+	if err := validateKeyExpiry(expiresAt); err != nil {
+		return nil, "", err
+	}
+
+	var apiKey *models.APIKey
+	var key string
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		old, err := s.getKey(tx, accountID, keyID)
+		if err != nil {
+			return err
+		}
+		if old.RevokedAt != nil {
+			return ErrAPIKeyInactive
+		}
+
+		if expiresAt == nil && old.ExpiresAt != nil {
+			expiry := time.Now().Add(old.ExpiresAt.Sub(old.CreatedAt))
+			expiresAt = &expiry
+		}
+		if err := tx.Model(old).Update("revoked_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to revoke API key: %v", err)
+		}
+
+		apiKey, key, err = s.issueKey(tx, accountID, expiresAt)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return apiKey, key, nil
+}
+
+// RevokeKey revokes an API key of a service account
+func (s *ServiceAccountService) RevokeKey(accountID, keyID uuid.UUID) error {
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		apiKey, err := s.getKey(tx, accountID, keyID)
+		if err != nil {
+			return err
+		}
+		if apiKey.RevokedAt != nil {
+			return nil
+		}
+		if err := tx.Model(apiKey).Update("revoked_at", time.Now()).Error; err != nil {
+			return fmt.Errorf("failed to revoke API key: %v", err)
+		}
+		return nil
+	})
+}
+
+// Authenticate returns the service account an API key belongs to when the key is
+// active and used from an allowed address, and records when the key was last used
+func (s *ServiceAccountService) Authenticate(key, ip string) (*models.ServiceAccount, error) {
+	prefix, ok := apiKeyLookupPrefix(key)
+	if !ok {
+		return nil, ErrInvalidAPIKey
+	}
+
+	var account models.ServiceAccount
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var apiKey models.APIKey
+		if err := tx.Where("tenant_id = ? AND prefix = ?", s.tenantID, prefix).First(&apiKey).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrInvalidAPIKey
+			}
+			return fmt.Errorf("failed to get API key: %v", err)
+		}
+		if subtle.ConstantTimeCompare([]byte(hashAPIKey(key)), []byte(apiKey.KeyHash)) != 1 {
+			return ErrInvalidAPIKey
+		}
+
+		now := time.Now()
+		if !apiKey.Active(now) {
+			return ErrAPIKeyInactive
+		}
+
+		if err := tx.Where("tenant_id = ?", s.tenantID).First(&account, apiKey.ServiceAccountID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return ErrAPIKeyInactive
+			}
+			return fmt.Errorf("failed to get service account: %v", err)
+		}
+		if !account.AllowsIP(ip) {
+			return ErrIPNotAllowed
+		}
+
+		if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= apiKeyUsageInterval {
+			if err := tx.Model(&apiKey).UpdateColumn("last_used_at", now).Error; err != nil {
+				return fmt.Errorf("failed to record API key use: %v", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+// Helper methods
+
+// getServiceAccount retrieves a service account of the tenant with its keys
+func (s *ServiceAccountService) getServiceAccount(tx *gorm.DB, id uuid.UUID) (*models.ServiceAccount, error) {
+	var account models.ServiceAccount
+	err := tx.Preload("Keys", func(db *gorm.DB) *gorm.DB { return db.Order("created_at") }).
+		Where("tenant_id = ?", s.tenantID).
+		First(&account, id).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("service account not found")
+		}
+		return nil, fmt.Errorf("failed to get service account: %v", err)
+	}
+	return &account, nil
+}
+
+// issueKey generates an API key for a service account and stores its hash
+func (s *ServiceAccountService) issueKey(db *gorm.DB, accountID uuid.UUID, expiresAt *time.Time) (*models.APIKey, string, error) {
+	prefix := make([]byte, 6)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %v", err)
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %v", err)
+	}
+
+	lookup := hex.EncodeToString(prefix)
+	key := models.APIKeyPrefix + lookup + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	apiKey := &models.APIKey{
+		TenantID:         s.tenantID,
+		ServiceAccountID: accountID,
+		Prefix:           lookup,
+		KeyHash:          hashAPIKey(key),
+		ExpiresAt:        expiresAt,
+	}
+	if err := db.Create(apiKey).Error; err != nil {
+		return nil, "", fmt.Errorf("failed to create API key: %v", err)
+	}
+	return apiKey, key, nil
+}
+
+// getKey retrieves an API key of a service account of the tenant
+func (s *ServiceAccountService) getKey(db *gorm.DB, accountID, keyID uuid.UUID) (*models.APIKey, error) {
+	var apiKey models.APIKey
+	err := db.Where("tenant_id = ? AND service_account_id = ?", s.tenantID, accountID).
+		First(&apiKey, keyID).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("API key not found")
+		}
+		return nil, fmt.Errorf("failed to get API key: %v", err)
+	}
+	return &apiKey, nil
+}
+
+// validateKeyExpiry checks that an optional key expiry is in the future
+func validateKeyExpiry(expiresAt *time.Time) error {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return ErrInvalidKeyExpiry
+	}
+	return nil
+}
+
+// apiKeyLookupPrefix returns the public lookup part of an API key
+func apiKeyLookupPrefix(key string) (string, bool) {
+	if !strings.HasPrefix(key, models.APIKeyPrefix) {
+		return "", false
+	}
+	prefix, secret, found := strings.Cut(strings.TrimPrefix(key, models.APIKeyPrefix), "_")
+	if !found || prefix == "" || secret == "" {
+		return "", false
+	}
+	return prefix, true
+}
+
+// hashAPIKey hashes an API key for storage. Keys are random, so a fast hash suffices.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
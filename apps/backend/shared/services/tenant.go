@@ -1,17 +1,34 @@
 package services
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/database"
 )
 
+// ErrIsolationUnavailable is returned when a tenant asks for an isolation this
+// deployment does not offer, or for a dedicated database without its settings
+var ErrIsolationUnavailable = errors.New("tenant isolation unavailable")
+
+// ErrTenantIdentifierTaken is returned when another tenant already uses a slug, domain or
+// subdomain as any of the three. Requests are routed by all of them, so they must not overlap.
+var ErrTenantIdentifierTaken = errors.New("tenant slug, domain or subdomain already exists")
+
+// ErrTenantPendingDeletion is returned when the status of a tenant pending deletion is
+// changed other than by restoring it
+var ErrTenantPendingDeletion = errors.New("tenant is pending deletion")
+
 // TenantService handles CRUD operations for tenants
 type TenantService struct {
-	db *gorm.DB
+	db     *gorm.DB
+	events *EventBus
 }
 
 // NewTenantService creates a new tenant service
@@ -19,6 +36,11 @@ func NewTenantService(db *gorm.DB) *TenantService {
 	return &TenantService{db: db}
 }
 
+// SetEventBus sets the event bus used to publish tenant changes
+func (s *TenantService) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
 // CreateTenantInput represents input for creating a tenant
 type CreateTenantInput struct {
 	Name      string                 `json:"name" validate:"required"`
@@ -27,6 +49,10 @@ type CreateTenantInput struct {
 	Subdomain *string                `json:"subdomain"`
 	PlanID    *uuid.UUID             `json:"plan_id"`
 	Settings  map[string]interface{} `json:"settings"`
+	Status    string                 `json:"status"` // defaults to active
+	Isolation string                 `json:"isolation"` // defaults to the isolation of the plan
+	Database  *database.Config       `json:"database"`  // required for database isolation
+	Modules   []string               `json:"modules"`   // names of the modules enabled for the tenant
 }
 
 // UpdateTenantInput represents input for updating a tenant
@@ -46,38 +72,16 @@ type TenantFilter struct {
 	Search string  `json:"search"`
 }
 
-// CreateTenant creates a new tenant
+// CreateTenant creates a new tenant with its schema, roles and modules. It runs as an
+// onboarding, so a tenant that fails to be set up is rolled back entirely.
 func (s *TenantService) CreateTenant(input CreateTenantInput) (*models.Tenant, error) {
-	// Validate slug format
-	if !isValidSlug(input.Slug) {
-		return nil, fmt.Errorf("invalid slug format: must contain only lowercase letters, numbers, and hyphens")
-	}
-
-	tenant := &models.Tenant{
-		Name:      input.Name,
-		Slug:      input.Slug,
-		Domain:    input.Domain,
-		Subdomain: input.Subdomain,
-		PlanID:    input.PlanID,
-		Settings:  input.Settings,
-		Status:    "active",
-	}
-
-	if err := s.db.Create(tenant).Error; err != nil {
-		if strings.Contains(err.Error(), "duplicate key") {
-			return nil, fmt.Errorf("tenant with slug '%s' already exists", input.Slug)
-		}
-		return nil, fmt.Errorf("failed to create tenant: %v", err)
-	}
-
-	// Create tenant-specific database schema
-	if err := s.createTenantSchema(tenant.ID.String()); err != nil {
-		// Rollback tenant creation if schema creation fails
-		s.db.Delete(tenant)
-		return nil, fmt.Errorf("failed to create tenant schema: %v", err)
+	onboarding := NewOnboardingService(s.db)
+	onboarding.SetEventBus(s.events)
+	record, err := onboarding.Onboard(OnboardingInput{Tenant: input})
+	if err != nil {
+		return nil, err
 	}
-
-	return tenant, nil
+	return s.GetTenant(record.TenantID)
 }
 
 // GetTenant retrieves a tenant by ID
@@ -106,6 +110,45 @@ func (s *TenantService) GetTenantBySlug(slug string) (*models.Tenant, error) {
 	return &tenant, nil
 }
 
+// ResolveTenant retrieves a tenant by slug, custom domain or subdomain
+func (s *TenantService) ResolveTenant(identifier string) (*models.Tenant, error) {
+	identifier = strings.ToLower(strings.TrimSpace(identifier))
+	if identifier == "" {
+		return nil, fmt.Errorf("tenant not found")
+	}
+
+	// Domains win over subdomains and subdomains over slugs, should identifiers ever overlap
+	var tenant models.Tenant
+	err := s.db.Preload("Plan").
+		Where("slug = ? OR LOWER(domain) = ? OR LOWER(subdomain) = ?", identifier, identifier, identifier).
+		Order(clause.OrderBy{Expression: clause.Expr{
+			SQL:                "CASE WHEN LOWER(domain) = ? THEN 0 WHEN LOWER(subdomain) = ? THEN 1 ELSE 2 END",
+			Vars:               []interface{}{identifier, identifier},
+			WithoutParentheses: true,
+		}}).
+		Take(&tenant).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("tenant not found")
+		}
+		return nil, fmt.Errorf("failed to resolve tenant: %v", err)
+	}
+	return &tenant, nil
+}
+
+// GetEnabledModules returns the names of the modules enabled for a tenant
+func (s *TenantService) GetEnabledModules(tenantID uuid.UUID) ([]string, error) {
+	var names []string
+	err := s.db.Table("system.tenant_modules AS tm").
+		Joins("JOIN system.modules AS m ON m.id = tm.module_id").
+		Where("tm.tenant_id = ? AND tm.enabled = ? AND m.enabled = ?", tenantID, true, true).
+		Pluck("m.name", &names).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tenant modules: %v", err)
+	}
+	return names, nil
+}
+
 // ListTenants retrieves tenants with filtering and pagination
 func (s *TenantService) ListTenants(filter TenantFilter, offset, limit int) ([]*models.Tenant, int64, error) {
 	query := s.db.Model(&models.Tenant{}).Preload("Plan")
@@ -158,10 +201,18 @@ func (s *TenantService) UpdateTenant(id uuid.UUID, input UpdateTenantInput) (*mo
 	if input.Subdomain != nil {
 		tenant.Subdomain = input.Subdomain
 	}
+	if input.Domain != nil || input.Subdomain != nil {
+		if err := s.checkIdentifiers(tenant.ID, tenant.Slug, tenant.Domain, tenant.Subdomain); err != nil {
+			return nil, err
+		}
+	}
 	if input.PlanID != nil {
 		tenant.PlanID = input.PlanID
 	}
 	if input.Status != nil {
+		if tenant.Status == models.TenantStatusPendingDeletion || *input.Status == models.TenantStatusPendingDeletion {
+			return nil, ErrTenantPendingDeletion
+		}
 		tenant.Status = *input.Status
 	}
 	if input.Settings != nil {
@@ -172,24 +223,32 @@ func (s *TenantService) UpdateTenant(id uuid.UUID, input UpdateTenantInput) (*mo
 		return nil, fmt.Errorf("failed to update tenant: %v", err)
 	}
 
+	s.events.Publish(Event{Type: EventTenantChanged, TenantID: tenant.ID})
+
 	return &tenant, nil
 }
 
-// DeleteTenant soft deletes a tenant
-func (s *TenantService) DeleteTenant(id uuid.UUID) error {
-	var tenant models.Tenant
-	if err := s.db.First(&tenant, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("tenant not found")
+// checkIdentifiers returns ErrTenantIdentifierTaken when a tenant other than tenantID uses
+// the slug, domain or subdomain as its slug, domain or subdomain
+func (s *TenantService) checkIdentifiers(tenantID uuid.UUID, slug string, domain, subdomain *string) error {
+	identifiers := []string{strings.ToLower(slug)}
+	for _, identifier := range []*string{domain, subdomain} {
+		if identifier != nil && strings.TrimSpace(*identifier) != "" {
+			identifiers = append(identifiers, strings.ToLower(strings.TrimSpace(*identifier)))
 		}
-		return fmt.Errorf("failed to find tenant: %v", err)
 	}
 
-	// Soft delete the tenant
-	if err := s.db.Delete(&tenant).Error; err != nil {
-		return fmt.Errorf("failed to delete tenant: %v", err)
+	var count int64
+	err := s.db.Model(&models.Tenant{}).Unscoped().
+		Where("id <> ?", tenantID).
+		Where("slug IN ? OR LOWER(domain) IN ? OR LOWER(subdomain) IN ?", identifiers, identifiers, identifiers).
+		Count(&count).Error
+	if err != nil {
+		return fmt.Errorf("failed to check tenant identifiers: %v", err)
+	}
+	if count > 0 {
+		return ErrTenantIdentifierTaken
 	}
-
 	return nil
 }
 
@@ -206,31 +265,78 @@ func (s *TenantService) ActivateTenant(id uuid.UUID) error {
 // Helper methods
 
 func (s *TenantService) updateTenantStatus(id uuid.UUID, status string) error {
-	result := s.db.Model(&models.Tenant{}).Where("id = ?", id).Update("status", status)
+	result := s.db.Model(&models.Tenant{}).
+		Where("id = ? AND status <> ?", id, models.TenantStatusPendingDeletion).
+		Update("status", status)
 	if result.Error != nil {
 		return fmt.Errorf("failed to update tenant status: %v", result.Error)
 	}
 	if result.RowsAffected == 0 {
+		var pendingCount int64
+		if err := s.db.Model(&models.Tenant{}).Where("id = ?", id).Count(&pendingCount).Error; err == nil && pendingCount > 0 {
+			return ErrTenantPendingDeletion
+		}
 		return fmt.Errorf("tenant not found")
 	}
+
+	s.events.Publish(Event{Type: EventTenantChanged, TenantID: id})
 	return nil
 }
 
-func (s *TenantService) createTenantSchema(tenantID string) error {
-	schemaName := fmt.Sprintf("tenant_%s", strings.ReplaceAll(tenantID, "-", "_"))
-	
-	// Create schema
-	if err := s.db.Exec(fmt.Sprintf("CREATE SCHEMA IF NOT EXISTS %s", schemaName)).Error; err != nil {
-		return err
+// tenantIsolation picks the isolation of a new tenant: the one asked for, or else the one
+// of its plan. A plan asking for an isolation this deployment does not offer, or for a
+// dedicated database without its settings, gets a schema per tenant instead.
+func (s *TenantService) tenantIsolation(input CreateTenantInput) (tenancy.Isolation, error) {
+	name := input.Isolation
+	if name == "" && input.PlanID != nil {
+		var plan models.Plan
+		if err := s.db.First(&plan, *input.PlanID).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return "", fmt.Errorf("plan not found")
+			}
+			return "", fmt.Errorf("failed to get plan: %v", err)
+		}
+		name = plan.Isolation
+		if isolation, err := tenancy.ParseIsolation(name); err == nil && !tenancy.Supports(s.db, isolation) {
+			name = string(tenancy.IsolationSchema)
+		}
+		if name == string(tenancy.IsolationDatabase) && input.Database == nil {
+			name = string(tenancy.IsolationSchema)
+		}
 	}
 
-	// TODO: Create tenant-specific tables here
-	// This could be done by reading a schema template and executing it
-	
-	return nil
+	isolation, err := tenancy.ParseIsolation(name)
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrIsolationUnavailable, err)
+	}
+	if !tenancy.Supports(s.db, isolation) {
+		return "", fmt.Errorf("%w: %s isolation is not enabled", ErrIsolationUnavailable, isolation)
+	}
+	if isolation == tenancy.IsolationDatabase && input.Database == nil {
+		return "", fmt.Errorf("%w: database isolation needs the \"database\" connection settings of the tenant", ErrIsolationUnavailable)
+	}
+	return isolation, nil
+}
+
+// forEachTenant calls fn with the database handle of every tenant. A failing tenant does
+// not stop the others; the first error is returned.
+func forEachTenant(db *gorm.DB, fn func(tenantID uuid.UUID, tenantDB *tenancy.DB) error) error {
+	var tenantIDs []uuid.UUID
+	if err := db.Model(&models.Tenant{}).Order("created_at").Pluck("id", &tenantIDs).Error; err != nil {
+		return fmt.Errorf("failed to list tenants: %v", err)
+	}
+
+	var firstErr error
+	for _, tenantID := range tenantIDs {
+		if err := fn(tenantID, tenancy.New(db, tenantID)); err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("tenant %s: %w", tenantID, err)
+		}
+	}
+	return firstErr
 }
 
-func isValidSlug(slug string) bool {
+// IsValidSlug checks that a slug only contains lowercase letters, numbers and inner hyphens
+func IsValidSlug(slug string) bool {
 	if len(slug) == 0 || len(slug) > 50 {
 		return false
 	}
@@ -9,22 +9,29 @@ import (
 	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/tenancy"
 )
 
 // UserService handles CRUD operations for tenant users
 type UserService struct {
-	db       *gorm.DB
+	db       *tenancy.DB
 	tenantID uuid.UUID
+	events   *EventBus
 }
 
 // NewUserService creates a new user service for a specific tenant
 func NewUserService(db *gorm.DB, tenantID uuid.UUID) *UserService {
 	return &UserService{
-		db:       db,
+		db:       tenancy.New(db, tenantID),
 		tenantID: tenantID,
 	}
 }
 
+// SetEventBus sets the event bus used to publish user and role assignment changes
+func (s *UserService) SetEventBus(events *EventBus) {
+	s.events = events
+}
+
 // CreateUserInput represents input for creating a user
 type CreateUserInput struct {
 	Email     string      `json:"email" validate:"required,email"`
@@ -33,6 +40,11 @@ type CreateUserInput struct {
 	LastName  string      `json:"last_name" validate:"required"`
 	Avatar    *string     `json:"avatar"`
 	RoleIDs   []uuid.UUID `json:"role_ids"`
+	Status    string      `json:"status"` // defaults to active
+
+	// PasswordHash is a bcrypt hash used instead of Password, for callers that only keep
+	// the hash, e.g. a resumed tenant onboarding
+	PasswordHash string `json:"-"`
 }
 
 // UpdateUserInput represents input for updating a user
@@ -53,22 +65,19 @@ type UserFilter struct {
 
 // CreateUser creates a new user within the tenant
 func (s *UserService) CreateUser(input CreateUserInput) (*models.TenantUser, error) {
-	// Validate email is unique within tenant
-	var existingCount int64
-	if err := s.db.Model(&models.TenantUser{}).
-		Where("tenant_id = ? AND email = ?", s.tenantID, input.Email).
-		Count(&existingCount).Error; err != nil {
-		return nil, fmt.Errorf("failed to check email uniqueness: %v", err)
-	}
-
-	if existingCount > 0 {
-		return nil, fmt.Errorf("user with email '%s' already exists in this tenant", input.Email)
+	// Hash password
+	hashedPassword := []byte(input.PasswordHash)
+	if len(hashedPassword) == 0 {
+		var err error
+		hashedPassword, err = bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, fmt.Errorf("failed to hash password: %v", err)
+		}
 	}
 
-	// Hash password
-	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(input.Password), bcrypt.DefaultCost)
-	if err != nil {
-		return nil, fmt.Errorf("failed to hash password: %v", err)
+	status := "active"
+	if input.Status != "" {
+		status = input.Status
 	}
 
 	user := &models.TenantUser{
@@ -78,24 +87,40 @@ func (s *UserService) CreateUser(input CreateUserInput) (*models.TenantUser, err
 		FirstName:    input.FirstName,
 		LastName:     input.LastName,
 		Avatar:       input.Avatar,
-		Status:       "active",
+		Status:       status,
 	}
 
-	// Create user
-	if err := s.db.Create(user).Error; err != nil {
-		return nil, fmt.Errorf("failed to create user: %v", err)
-	}
+	// Create user with the roles provided, if any
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Validate email is unique within tenant
+		var existingCount int64
+		if err := tx.Model(&models.TenantUser{}).
+			Where("tenant_id = ? AND email = ?", s.tenantID, input.Email).
+			Count(&existingCount).Error; err != nil {
+			return fmt.Errorf("failed to check email uniqueness: %v", err)
+		}
 
-	// Assign roles if provided
-	if len(input.RoleIDs) > 0 {
-		if err := s.assignRolesToUser(user.ID, input.RoleIDs); err != nil {
-			return nil, fmt.Errorf("failed to assign roles: %v", err)
+		if existingCount > 0 {
+			return fmt.Errorf("user with email '%s' already exists in this tenant", input.Email)
+		}
+
+		if err := tx.Create(user).Error; err != nil {
+			return fmt.Errorf("failed to create user: %v", err)
+		}
+		if len(input.RoleIDs) > 0 {
+			if err := s.applyRoleChange(tx, []uuid.UUID{user.ID}, RoleModeReplace, distinctIDs(input.RoleIDs), nil); err != nil {
+				return fmt.Errorf("failed to assign roles: %v", err)
+			}
 		}
-	}
 
-	// Load user with roles
-	if err := s.db.Preload("Roles").Preload("Roles.Permissions").First(user, user.ID).Error; err != nil {
-		return nil, fmt.Errorf("failed to load user details: %v", err)
+		// Load user with roles
+		if err := tx.Preload("Roles").Preload("Roles.Permissions").First(user, user.ID).Error; err != nil {
+			return fmt.Errorf("failed to load user details: %v", err)
+		}
+		return s.dropInactiveRoles(tx, user)
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	return user, nil
@@ -104,14 +129,20 @@ func (s *UserService) CreateUser(input CreateUserInput) (*models.TenantUser, err
 // GetUser retrieves a user by ID
 func (s *UserService) GetUser(id uuid.UUID) (*models.TenantUser, error) {
 	var user models.TenantUser
-	err := s.db.Preload("Roles").Preload("Roles.Permissions").
-		Where("tenant_id = ?", s.tenantID).
-		First(&user, id).Error
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found")
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Preload("Roles").Preload("Roles.Permissions").
+			Where("tenant_id = ?", s.tenantID).
+			First(&user, id).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("user not found")
+			}
+			return fmt.Errorf("failed to get user: %v", err)
 		}
-		return nil, fmt.Errorf("failed to get user: %v", err)
+		return s.dropInactiveRoles(tx, &user)
+	})
+	if err != nil {
+		return nil, err
 	}
 	return &user, nil
 }
@@ -119,49 +150,62 @@ func (s *UserService) GetUser(id uuid.UUID) (*models.TenantUser, error) {
 // GetUserByEmail retrieves a user by email
 func (s *UserService) GetUserByEmail(email string) (*models.TenantUser, error) {
 	var user models.TenantUser
-	err := s.db.Preload("Roles").Preload("Roles.Permissions").
-		Where("tenant_id = ? AND email = ?", s.tenantID, strings.ToLower(email)).
-		First(&user).Error
-	if err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found")
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Preload("Roles").Preload("Roles.Permissions").
+			Where("tenant_id = ? AND email = ?", s.tenantID, strings.ToLower(email)).
+			First(&user).Error
+		if err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("user not found")
+			}
+			return fmt.Errorf("failed to get user: %v", err)
 		}
-		return nil, fmt.Errorf("failed to get user: %v", err)
+		return s.dropInactiveRoles(tx, &user)
+	})
+	if err != nil {
+		return nil, err
 	}
 	return &user, nil
 }
 
 // ListUsers retrieves users with filtering and pagination
 func (s *UserService) ListUsers(filter UserFilter, offset, limit int) ([]*models.TenantUser, int64, error) {
-	query := s.db.Model(&models.TenantUser{}).
-		Preload("Roles").
-		Where("tenant_id = ?", s.tenantID)
-
-	// Apply filters
-	if filter.Status != "" {
-		query = query.Where("status = ?", filter.Status)
-	}
-	if filter.Search != "" {
-		search := "%" + filter.Search + "%"
-		query = query.Where("first_name ILIKE ? OR last_name ILIKE ? OR email ILIKE ?", search, search, search)
-	}
-	if filter.Role != "" {
-		query = query.Joins("JOIN user_roles ON users.id = user_roles.user_id").
-			Joins("JOIN roles ON user_roles.role_id = roles.id").
-			Where("roles.name = ?", filter.Role)
-	}
-
-	// Get total count
+	var users []*models.TenantUser
 	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, fmt.Errorf("failed to count users: %v", err)
-	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		query := tx.Model(&models.TenantUser{}).
+			Preload("Roles").
+			Where("tenant_id = ?", s.tenantID)
+
+		// Apply filters
+		if filter.Status != "" {
+			query = query.Where("status = ?", filter.Status)
+		}
+		if filter.Search != "" {
+			search := "%" + filter.Search + "%"
+			query = query.Where("first_name ILIKE ? OR last_name ILIKE ? OR email ILIKE ?", search, search, search)
+		}
+		if filter.Role != "" {
+			now := time.Now()
+			query = query.Joins("JOIN user_roles ON users.id = user_roles.user_id").
+				Joins("JOIN roles ON user_roles.role_id = roles.id").
+				Where("roles.name = ?", filter.Role).
+				Where("(user_roles.valid_from IS NULL OR user_roles.valid_from <= ?) AND (user_roles.valid_until IS NULL OR user_roles.valid_until > ?)", now, now)
+		}
 
-	// Get paginated results
-	var users []*models.TenantUser
-	err := query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&users).Error
+		// Get total count
+		if err := query.Count(&total).Error; err != nil {
+			return fmt.Errorf("failed to count users: %v", err)
+		}
+
+		// Get paginated results
+		if err := query.Offset(offset).Limit(limit).Order("created_at DESC").Find(&users).Error; err != nil {
+			return fmt.Errorf("failed to list users: %v", err)
+		}
+		return s.dropInactiveRoles(tx, users...)
+	})
 	if err != nil {
-		return nil, 0, fmt.Errorf("failed to list users: %v", err)
+		return nil, 0, err
 	}
 
 	return users, total, nil
@@ -170,182 +214,336 @@ func (s *UserService) ListUsers(filter UserFilter, offset, limit int) ([]*models
 // UpdateUser updates a user
 func (s *UserService) UpdateUser(id uuid.UUID, input UpdateUserInput) (*models.TenantUser, error) {
 	var user models.TenantUser
-	if err := s.db.Where("tenant_id = ?", s.tenantID).First(&user, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found")
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("tenant_id = ?", s.tenantID).First(&user, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("user not found")
+			}
+			return fmt.Errorf("failed to find user: %v", err)
 		}
-		return nil, fmt.Errorf("failed to find user: %v", err)
-	}
 
-	// Update fields
-	if input.Email != nil {
-		email := strings.ToLower(strings.TrimSpace(*input.Email))
-		// Check if email is unique within tenant (excluding current user)
-		var existingCount int64
-		if err := s.db.Model(&models.TenantUser{}).
-			Where("tenant_id = ? AND email = ? AND id != ?", s.tenantID, email, id).
-			Count(&existingCount).Error; err != nil {
-			return nil, fmt.Errorf("failed to check email uniqueness: %v", err)
+		// Update fields
+		if input.Email != nil {
+			email := strings.ToLower(strings.TrimSpace(*input.Email))
+			// Check if email is unique within tenant (excluding current user)
+			var existingCount int64
+			if err := tx.Model(&models.TenantUser{}).
+				Where("tenant_id = ? AND email = ? AND id != ?", s.tenantID, email, id).
+				Count(&existingCount).Error; err != nil {
+				return fmt.Errorf("failed to check email uniqueness: %v", err)
+			}
+			if existingCount > 0 {
+				return fmt.Errorf("user with email '%s' already exists in this tenant", email)
+			}
+			user.Email = email
 		}
-		if existingCount > 0 {
-			return nil, fmt.Errorf("user with email '%s' already exists in this tenant", email)
+		if input.FirstName != nil {
+			user.FirstName = *input.FirstName
+		}
+		if input.LastName != nil {
+			user.LastName = *input.LastName
+		}
+		if input.Avatar != nil {
+			user.Avatar = input.Avatar
+		}
+		if input.Status != nil {
+			user.Status = *input.Status
 		}
-		user.Email = email
-	}
-	if input.FirstName != nil {
-		user.FirstName = *input.FirstName
-	}
-	if input.LastName != nil {
-		user.LastName = *input.LastName
-	}
-	if input.Avatar != nil {
-		user.Avatar = input.Avatar
-	}
-	if input.Status != nil {
-		user.Status = *input.Status
-	}
 
-	if err := s.db.Save(&user).Error; err != nil {
-		return nil, fmt.Errorf("failed to update user: %v", err)
-	}
+		if err := tx.Save(&user).Error; err != nil {
+			return fmt.Errorf("failed to update user: %v", err)
+		}
 
-	// Load user with roles
-	if err := s.db.Preload("Roles").Preload("Roles.Permissions").First(&user, user.ID).Error; err != nil {
-		return nil, fmt.Errorf("failed to load user details: %v", err)
+		// Load user with roles
+		if err := tx.Preload("Roles").Preload("Roles.Permissions").First(&user, user.ID).Error; err != nil {
+			return fmt.Errorf("failed to load user details: %v", err)
+		}
+		return s.dropInactiveRoles(tx, &user)
+	})
+	if err != nil {
+		return nil, err
 	}
+	s.publish(EventUserChanged, user.ID)
 
 	return &user, nil
 }
 
 // DeleteUser soft deletes a user
 func (s *UserService) DeleteUser(id uuid.UUID) error {
-	var user models.TenantUser
-	if err := s.db.Where("tenant_id = ?", s.tenantID).First(&user, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("user not found")
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		var user models.TenantUser
+		if err := tx.Where("tenant_id = ?", s.tenantID).First(&user, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("user not found")
+			}
+			return fmt.Errorf("failed to find user: %v", err)
 		}
-		return fmt.Errorf("failed to find user: %v", err)
-	}
 
-	// Soft delete the user
-	if err := s.db.Delete(&user).Error; err != nil {
-		return fmt.Errorf("failed to delete user: %v", err)
+		// Soft delete the user
+		if err := tx.Delete(&user).Error; err != nil {
+			return fmt.Errorf("failed to delete user: %v", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
+	s.publish(EventUserChanged, id)
 
 	return nil
 }
 
 // ChangePassword changes a user's password
 func (s *UserService) ChangePassword(id uuid.UUID, oldPassword, newPassword string) error {
-	var user models.TenantUser
-	if err := s.db.Where("tenant_id = ?", s.tenantID).First(&user, id).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("user not found")
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		var user models.TenantUser
+		if err := tx.Where("tenant_id = ?", s.tenantID).First(&user, id).Error; err != nil {
+			if err == gorm.ErrRecordNotFound {
+				return fmt.Errorf("user not found")
+			}
+			return fmt.Errorf("failed to find user: %v", err)
 		}
-		return fmt.Errorf("failed to find user: %v", err)
-	}
 
-	// Verify old password
-	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(oldPassword)); err != nil {
-		return fmt.Errorf("current password is incorrect")
-	}
+		// Verify old password
+		if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(oldPassword)); err != nil {
+			return fmt.Errorf("current password is incorrect")
+		}
+
+		// Hash new password
+		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("failed to hash new password: %v", err)
+		}
 
-	// Hash new password
+		// Update password
+		if err := tx.Model(&user).Update("password_hash", string(hashedPassword)).Error; err != nil {
+			return fmt.Errorf("failed to update password: %v", err)
+		}
+
+		return nil
+	})
+}
+
+// SetPassword replaces a user's password without checking the current one, e.g. after a password reset
+func (s *UserService) SetPassword(id uuid.UUID, newPassword string) error {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
 	if err != nil {
 		return fmt.Errorf("failed to hash new password: %v", err)
 	}
 
-	// Update password
-	if err := s.db.Model(&user).Update("password_hash", string(hashedPassword)).Error; err != nil {
-		return fmt.Errorf("failed to update password: %v", err)
-	}
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.TenantUser{}).
+			Where("tenant_id = ? AND id = ?", s.tenantID, id).
+			Update("password_hash", string(hashedPassword))
+		if result.Error != nil {
+			return fmt.Errorf("failed to update password: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("user not found")
+		}
 
-	return nil
+		return nil
+	})
 }
 
-// AssignRoles assigns roles to a user
-func (s *UserService) AssignRoles(userID uuid.UUID, roleIDs []uuid.UUID) error {
-	// Verify user exists and belongs to tenant
-	var user models.TenantUser
-	if err := s.db.Where("tenant_id = ?", s.tenantID).First(&user, userID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
-			return fmt.Errorf("user not found")
+// AssignRoles changes the roles of a user according to mode in a single transaction.
+// A window limits added assignments to a period; nil assigns them permanently.
+func (s *UserService) AssignRoles(userID uuid.UUID, mode RoleAssignmentMode, roleIDs []uuid.UUID, window *RoleWindow) error {
+	return s.BulkAssignRoles([]uuid.UUID{userID}, mode, roleIDs, window)
+}
+
+// BulkAssignRoles applies the same role change to several users of the tenant. Either
+// every user is changed or, on any error, none is.
+func (s *UserService) BulkAssignRoles(userIDs []uuid.UUID, mode RoleAssignmentMode, roleIDs []uuid.UUID, window *RoleWindow) error {
+	if !mode.Valid() {
+		return ErrInvalidRoleMode
+	}
+	if mode != RoleModeRemove {
+		if err := window.Validate(); err != nil {
+			return err
 		}
-		return fmt.Errorf("failed to find user: %v", err)
+	}
+	userIDs, roleIDs = distinctIDs(userIDs), distinctIDs(roleIDs)
+	if len(userIDs) == 0 {
+		return fmt.Errorf("no users given")
 	}
 
-	return s.assignRolesToUser(userID, roleIDs)
-}
-
-// RemoveRoles removes roles from a user
-func (s *UserService) RemoveRoles(userID uuid.UUID, roleIDs []uuid.UUID) error {
-	// Verify user exists and belongs to tenant
-	var user models.TenantUser
-	if err := s.db.Where("tenant_id = ?", s.tenantID).First(&user, userID).Error; err != nil {
-		if err == gorm.ErrRecordNotFound {
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		// Verify users exist and belong to tenant
+		var count int64
+		if err := tx.Model(&models.TenantUser{}).
+			Where("tenant_id = ? AND id IN ?", s.tenantID, userIDs).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to find users: %v", err)
+		}
+		if int(count) != len(userIDs) {
 			return fmt.Errorf("user not found")
 		}
-		return fmt.Errorf("failed to find user: %v", err)
-	}
 
-	// Remove role assignments
-	if err := s.db.Where("user_id = ? AND role_id IN ?", userID, roleIDs).
-		Delete(&models.UserRole{}).Error; err != nil {
-		return fmt.Errorf("failed to remove roles: %v", err)
+		return s.applyRoleChange(tx, userIDs, mode, roleIDs, window)
+	})
+	if err != nil {
+		return err
 	}
 
+	for _, userID := range userIDs {
+		s.publish(EventRolesChanged, userID)
+	}
 	return nil
 }
 
+// AssignRole assigns a role to a user in addition to their other roles. Assigning a
+// role the user already has replaces the window of that assignment, unless the user
+// holds it permanently.
+func (s *UserService) AssignRole(userID, roleID uuid.UUID, window *RoleWindow) error {
+	return s.AssignRoles(userID, RoleModeAdd, []uuid.UUID{roleID}, window)
+}
+
+// RemoveRoles removes roles from a user
+func (s *UserService) RemoveRoles(userID uuid.UUID, roleIDs []uuid.UUID) error {
+	return s.AssignRoles(userID, RoleModeRemove, roleIDs, nil)
+}
+
 // UpdateLastLogin updates the user's last login timestamp
 func (s *UserService) UpdateLastLogin(id uuid.UUID) error {
 	now := time.Now()
-	result := s.db.Model(&models.TenantUser{}).
-		Where("tenant_id = ? AND id = ?", s.tenantID, id).
-		Update("last_login_at", now)
-	
-	if result.Error != nil {
-		return fmt.Errorf("failed to update last login: %v", result.Error)
-	}
-	if result.RowsAffected == 0 {
-		return fmt.Errorf("user not found")
-	}
-	
-	return nil
+	return s.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.TenantUser{}).
+			Where("tenant_id = ? AND id = ?", s.tenantID, id).
+			Update("last_login_at", now)
+
+		if result.Error != nil {
+			return fmt.Errorf("failed to update last login: %v", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("user not found")
+		}
+
+		return nil
+	})
 }
 
 // Helper methods
 
-func (s *UserService) assignRolesToUser(userID uuid.UUID, roleIDs []uuid.UUID) error {
-	// Verify roles exist and belong to tenant
-	var validRoles []models.Role
-	if err := s.db.Where("tenant_id = ? AND id IN ?", s.tenantID, roleIDs).
-		Find(&validRoles).Error; err != nil {
-		return fmt.Errorf("failed to verify roles: %v", err)
+// publish announces a change of a user of the tenant
+func (s *UserService) publish(eventType EventType, userID uuid.UUID) {
+	s.events.Publish(Event{Type: eventType, TenantID: s.tenantID, UserID: userID})
+}
+
+// applyRoleChange changes the role assignments of users that are known to belong to the
+// tenant, with a mode and window that were validated. It must run inside a transaction.
+func (s *UserService) applyRoleChange(tx *gorm.DB, userIDs []uuid.UUID, mode RoleAssignmentMode, roleIDs []uuid.UUID, window *RoleWindow) error {
+	if mode == RoleModeRemove {
+		if len(roleIDs) == 0 {
+			return nil
+		}
+		if err := tx.Where("user_id IN ? AND role_id IN ?", userIDs, roleIDs).
+			Delete(&models.UserRole{}).Error; err != nil {
+			return fmt.Errorf("failed to remove roles: %v", err)
+		}
+		return nil
 	}
 
-	if len(validRoles) != len(roleIDs) {
-		return fmt.Errorf("some roles were not found or don't belong to this tenant")
+	// Verify roles exist and belong to tenant
+	if len(roleIDs) > 0 {
+		var count int64
+		if err := tx.Model(&models.Role{}).
+			Where("tenant_id = ? AND id IN ?", s.tenantID, roleIDs).
+			Count(&count).Error; err != nil {
+			return fmt.Errorf("failed to verify roles: %v", err)
+		}
+		if int(count) != len(roleIDs) {
+			return fmt.Errorf("some roles were not found or don't belong to this tenant")
+		}
 	}
 
-	// Remove existing role assignments
-	if err := s.db.Where("user_id = ?", userID).Delete(&models.UserRole{}).Error; err != nil {
+	// Replacing drops every current assignment. Adding only replaces the time-bound
+	// assignments of the roles being added, so that a window never shortens a permanent grant.
+	query := tx.Where("user_id IN ?", userIDs)
+	permanent := make(map[[2]uuid.UUID]bool)
+	if mode == RoleModeAdd {
+		if len(roleIDs) == 0 {
+			return nil
+		}
+		var current []models.UserRole
+		if err := tx.Where("user_id IN ? AND role_id IN ? AND valid_from IS NULL AND valid_until IS NULL", userIDs, roleIDs).
+			Find(&current).Error; err != nil {
+			return fmt.Errorf("failed to check existing roles: %v", err)
+		}
+		for _, assignment := range current {
+			permanent[[2]uuid.UUID{assignment.UserID, assignment.RoleID}] = true
+		}
+		query = query.Where("role_id IN ? AND (valid_from IS NOT NULL OR valid_until IS NOT NULL)", roleIDs)
+	}
+	if err := query.Delete(&models.UserRole{}).Error; err != nil {
 		return fmt.Errorf("failed to remove existing roles: %v", err)
 	}
 
 	// Create new role assignments
 	now := time.Now()
-	for _, roleID := range roleIDs {
-		userRole := models.UserRole{
-			UserID:     userID,
-			RoleID:     roleID,
-			AssignedAt: now,
-		}
-		if err := s.db.Create(&userRole).Error; err != nil {
-			return fmt.Errorf("failed to assign role: %v", err)
+	for _, userID := range userIDs {
+		for _, roleID := range roleIDs {
+			if permanent[[2]uuid.UUID{userID, roleID}] {
+				continue
+			}
+			if err := tx.Create(window.assignment(userID, roleID, now)).Error; err != nil {
+				return fmt.Errorf("failed to assign role: %v", err)
+			}
 		}
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+// dropInactiveRoles removes the roles whose assignment is outside its window from loaded
+// users, so that permission checks only see the roles granted now, and records when
+// their roles change next
+func (s *UserService) dropInactiveRoles(tx *gorm.DB, users ...*models.TenantUser) error {
+	if len(users) == 0 {
+		return nil
+	}
+	userIDs := make([]uuid.UUID, 0, len(users))
+	for _, user := range users {
+		userIDs = append(userIDs, user.ID)
+	}
+
+	var bounded []models.UserRole
+	if err := tx.Where("user_id IN ? AND (valid_from IS NOT NULL OR valid_until IS NOT NULL)", userIDs).
+		Find(&bounded).Error; err != nil {
+		return fmt.Errorf("failed to check role assignments: %v", err)
+	}
+
+	now := time.Now()
+	excluded := make(map[uuid.UUID]map[uuid.UUID]bool)
+	changes := make(map[uuid.UUID]time.Time)
+	for _, assignment := range bounded {
+		for _, bound := range []*time.Time{assignment.ValidFrom, assignment.ValidUntil} {
+			if bound == nil || !bound.After(now) {
+				continue
+			}
+			if next, exists := changes[assignment.UserID]; !exists || bound.Before(next) {
+				changes[assignment.UserID] = *bound
+			}
+		}
+		if assignment.ActiveAt(now) {
+			continue
+		}
+		if excluded[assignment.UserID] == nil {
+			excluded[assignment.UserID] = make(map[uuid.UUID]bool)
+		}
+		excluded[assignment.UserID][assignment.RoleID] = true
+	}
+	for _, user := range users {
+		roles := user.Roles[:0]
+		for _, role := range user.Roles {
+			if !excluded[user.ID][role.ID] {
+				roles = append(roles, role)
+			}
+		}
+		user.Roles = roles
+		if next, exists := changes[user.ID]; exists {
+			user.RolesChangeAt = &next
+		}
+	}
+	return nil
+}
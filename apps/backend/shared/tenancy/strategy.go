@@ -0,0 +1,315 @@
+package tenancy
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/migrations"
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/models"
+	"github.com/ilmsadmin/Zplus-SaaS/pkg/database"
+)
+
+// Isolation names how the data of a tenant is kept apart from other tenants
+type Isolation string
+
+const (
+	// IsolationShared keeps the tenant's rows in tables shared with other tenants, where
+	// every query is scoped by the tenant_id column
+	IsolationShared Isolation = "shared"
+	// IsolationSchema keeps the tenant's tables in a schema of its own
+	IsolationSchema Isolation = "schema"
+	// IsolationDatabase keeps the tenant's tables in a database of its own
+	IsolationDatabase Isolation = "database"
+)
+
+// SharedSchema is the schema holding the tables of the tenants with shared isolation
+const SharedSchema = "tenant_shared"
+
+// pluginName is the name the Router is registered under with gorm
+const pluginName = "tenancy"
+
+// ParseIsolation returns the isolation with the given name. An empty name is schema
+// isolation, which tenants had before isolation was configurable.
+func ParseIsolation(name string) (Isolation, error) {
+	switch isolation := Isolation(name); isolation {
+	case IsolationShared, IsolationSchema, IsolationDatabase:
+		return isolation, nil
+	case "":
+		return IsolationSchema, nil
+	default:
+		return "", fmt.Errorf("unknown tenant isolation: %s", name)
+	}
+}
+
+// SchemaOf returns the schema holding the tables of a tenant with the given isolation
+func SchemaOf(isolation Isolation, tenantID uuid.UUID) string {
+	if isolation == IsolationShared {
+		return SharedSchema
+	}
+	return migrations.TenantSchema(tenantID)
+}
+
+// Strategy places the tables of a tenant in a database and a schema of it
+type Strategy interface {
+	// Isolation returns the isolation the strategy provides
+	Isolation() Isolation
+
+	// Schema returns the schema holding the tables of the tenant
+	Schema(tenantID uuid.UUID) string
+
+	// Database returns the database holding the tenant schema, or nil for the system
+	// database. db is the caller's system database handle, which may be in a transaction.
+	Database(db *gorm.DB, tenantID uuid.UUID) (*gorm.DB, error)
+}
+
+// SharedTables keeps every tenant in the shared tenant schema of the system database
+type SharedTables struct{}
+
+// Isolation returns IsolationShared
+func (SharedTables) Isolation() Isolation {
+	return IsolationShared
+}
+
+// Schema returns the shared tenant schema
+func (SharedTables) Schema(tenantID uuid.UUID) string {
+	return SchemaOf(IsolationShared, tenantID)
+}
+
+// Database returns nil, as the shared schema lives in the system database
+func (SharedTables) Database(*gorm.DB, uuid.UUID) (*gorm.DB, error) {
+	return nil, nil
+}
+
+// SchemaPerTenant keeps each tenant in a schema of its own in the system database
+type SchemaPerTenant struct{}
+
+// Isolation returns IsolationSchema
+func (SchemaPerTenant) Isolation() Isolation {
+	return IsolationSchema
+}
+
+// Schema returns the tenant's own schema
+func (SchemaPerTenant) Schema(tenantID uuid.UUID) string {
+	return SchemaOf(IsolationSchema, tenantID)
+}
+
+// Database returns nil, as tenant schemas live in the system database
+func (SchemaPerTenant) Database(*gorm.DB, uuid.UUID) (*gorm.DB, error) {
+	return nil, nil
+}
+
+// DatabasePerTenant keeps each tenant in a database of its own, configured in
+// system.tenant_databases. Within it the tables live in the tenant's schema, so the
+// same migrations apply. Connections are opened on first use and kept open.
+type DatabasePerTenant struct {
+	connect func(config database.Config) (*gorm.DB, error)
+	mutex   sync.Mutex
+	pools   map[uuid.UUID]*tenantPool
+}
+
+// tenantPool is the connection pool of a tenant database, ready once it is connected
+type tenantPool struct {
+	ready chan struct{}
+	db    *gorm.DB
+	err   error
+}
+
+// NewDatabasePerTenant creates a strategy that opens tenant databases with connect
+func NewDatabasePerTenant(connect func(config database.Config) (*gorm.DB, error)) *DatabasePerTenant {
+	return &DatabasePerTenant{
+		connect: connect,
+		pools:   make(map[uuid.UUID]*tenantPool),
+	}
+}
+
+// Isolation returns IsolationDatabase
+func (s *DatabasePerTenant) Isolation() Isolation {
+	return IsolationDatabase
+}
+
+// Schema returns the tenant's own schema within its database
+func (s *DatabasePerTenant) Schema(tenantID uuid.UUID) string {
+	return SchemaOf(IsolationDatabase, tenantID)
+}
+
+// Database returns the connection pool of the tenant's database. A tenant's database is
+// connected once; other tenants are not held up while it is being connected.
+func (s *DatabasePerTenant) Database(db *gorm.DB, tenantID uuid.UUID) (*gorm.DB, error) {
+	s.mutex.Lock()
+	pool, ok := s.pools[tenantID]
+	if !ok {
+		pool = &tenantPool{ready: make(chan struct{})}
+		s.pools[tenantID] = pool
+	}
+	s.mutex.Unlock()
+
+	if ok {
+		<-pool.ready
+		return pool.db, pool.err
+	}
+
+	pool.db, pool.err = s.open(db, tenantID)
+	if pool.err != nil {
+		// A failed connect is not kept, so the next request tries again
+		s.mutex.Lock()
+		delete(s.pools, tenantID)
+		s.mutex.Unlock()
+	}
+	close(pool.ready)
+	return pool.db, pool.err
+}
+
+// open connects to the tenant's database with its settings from system.tenant_databases
+func (s *DatabasePerTenant) open(db *gorm.DB, tenantID uuid.UUID) (*gorm.DB, error) {
+	var settings models.TenantDatabase
+	if err := db.Session(&gorm.Session{NewDB: true}).First(&settings, "tenant_id = ?", tenantID).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fmt.Errorf("tenant %s has no database configured", tenantID)
+		}
+		return nil, fmt.Errorf("failed to get tenant database: %v", err)
+	}
+	pool, err := s.connect(database.Config{
+		Host:     settings.Host,
+		Port:     settings.Port,
+		Username: settings.Username,
+		Password: settings.Password,
+		Database: settings.Database,
+		SSLMode:  settings.SSLMode,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to tenant database: %v", err)
+	}
+	return pool, nil
+}
+
+// Close closes the connection pool of a tenant's database, if one is open
+func (s *DatabasePerTenant) Close(tenantID uuid.UUID) error {
+	s.mutex.Lock()
+	pool, ok := s.pools[tenantID]
+	delete(s.pools, tenantID)
+	s.mutex.Unlock()
+
+	if !ok {
+		return nil
+	}
+	<-pool.ready
+	if pool.err != nil {
+		return nil
+	}
+	sqlDB, err := pool.db.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.Close()
+}
+
+// Router is a gorm plugin that picks the strategy of each tenant from the isolation
+// recorded in system.tenants. Register it with db.Use; on a database without it every
+// tenant has a schema of its own.
+type Router struct {
+	strategies map[Isolation]Strategy
+	mutex      sync.RWMutex
+	isolations map[uuid.UUID]Isolation
+}
+
+// NewRouter creates a router over the given strategies. Shared and schema isolation are
+// always available; database isolation needs a DatabasePerTenant strategy.
+func NewRouter(strategies ...Strategy) *Router {
+	r := &Router{
+		strategies: map[Isolation]Strategy{
+			IsolationShared: SharedTables{},
+			IsolationSchema: SchemaPerTenant{},
+		},
+		isolations: make(map[uuid.UUID]Isolation),
+	}
+	for _, strategy := range strategies {
+		r.strategies[strategy.Isolation()] = strategy
+	}
+	return r
+}
+
+// Name returns the name of the plugin
+func (r *Router) Name() string {
+	return pluginName
+}
+
+// Initialize is called by gorm when the plugin is registered
+func (r *Router) Initialize(*gorm.DB) error {
+	return nil
+}
+
+// Strategy returns the strategy of a tenant. A tenant's isolation never changes once it
+// is created, so it is looked up once. db is used for the lookup, so a tenant created
+// in an open transaction is found.
+func (r *Router) Strategy(db *gorm.DB, tenantID uuid.UUID) (Strategy, error) {
+	r.mutex.RLock()
+	isolation, ok := r.isolations[tenantID]
+	r.mutex.RUnlock()
+
+	if !ok {
+		var names []string
+		err := db.Session(&gorm.Session{NewDB: true}).Model(&models.Tenant{}).
+			Unscoped().
+			Where("id = ?", tenantID).
+			Pluck("isolation", &names).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to get tenant isolation: %v", err)
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("tenant not found")
+		}
+		if isolation, err = ParseIsolation(names[0]); err != nil {
+			return nil, err
+		}
+
+		r.mutex.Lock()
+		r.isolations[tenantID] = isolation
+		r.mutex.Unlock()
+	}
+
+	strategy, ok := r.strategies[isolation]
+	if !ok {
+		return nil, fmt.Errorf("tenant isolation %s is not enabled", isolation)
+	}
+	return strategy, nil
+}
+
+// Forget drops what the router knows of a tenant and closes its dedicated database
+func (r *Router) Forget(tenantID uuid.UUID) error {
+	r.mutex.Lock()
+	delete(r.isolations, tenantID)
+	r.mutex.Unlock()
+
+	if strategy, ok := r.strategies[IsolationDatabase].(*DatabasePerTenant); ok {
+		return strategy.Close(tenantID)
+	}
+	return nil
+}
+
+// StrategyOf returns the strategy of a tenant on a database, which is schema per tenant
+// unless a Router is registered with it
+func StrategyOf(db *gorm.DB, tenantID uuid.UUID) (Strategy, error) {
+	if router := RouterOf(db); router != nil {
+		return router.Strategy(db, tenantID)
+	}
+	return SchemaPerTenant{}, nil
+}
+
+// RouterOf returns the Router registered with a database, if any
+func RouterOf(db *gorm.DB) *Router {
+	router, _ := db.Config.Plugins[pluginName].(*Router)
+	return router
+}
+
+// Supports reports whether tenants of a database can have the given isolation
+func Supports(db *gorm.DB, isolation Isolation) bool {
+	router := RouterOf(db)
+	if router == nil {
+		return isolation == IsolationSchema
+	}
+	_, ok := router.strategies[isolation]
+	return ok
+}
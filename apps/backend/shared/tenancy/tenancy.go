@@ -0,0 +1,154 @@
+// Package tenancy gives services access to the tables of a single tenant. Where those
+// tables live depends on the tenant's isolation strategy: the shared tenant schema, a
+// schema of its own, or a schema in a database of its own. Tables are reached through
+// search_path, which is only ever set with SET LOCAL semantics inside a transaction. A
+// pooled connection therefore never carries one tenant's schema into another request.
+package tenancy
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/ilmsadmin/Zplus-SaaS/apps/backend/shared/migrations"
+)
+
+// DB runs queries against the tables of one tenant
+type DB struct {
+	db       *gorm.DB
+	tenantID uuid.UUID
+	schema   string
+	quoted   string
+	conn     *gorm.DB // the tenant's own database, if it has one
+	resolved bool
+}
+
+// New returns the database handle of a tenant. Its strategy is looked up on first use.
+func New(db *gorm.DB, tenantID uuid.UUID) *DB {
+	return &DB{
+		db:       db,
+		tenantID: tenantID,
+	}
+}
+
+// ForSchema returns the database handle of a named schema, which must be a valid
+// lowercase identifier
+func ForSchema(db *gorm.DB, schema string) (*DB, error) {
+	quoted, err := migrations.QuoteIdentifier(schema)
+	if err != nil {
+		return nil, err
+	}
+	return &DB{
+		db:       db,
+		schema:   schema,
+		quoted:   quoted,
+		resolved: true,
+	}, nil
+}
+
+// Schema returns the name of the schema queries run against
+func (t *DB) Schema() (string, error) {
+	if err := t.resolve(); err != nil {
+		return "", err
+	}
+	return t.schema, nil
+}
+
+// Database returns the database holding the schema: the tenant's own database or the
+// handle the DB was created with
+func (t *DB) Database() (*gorm.DB, error) {
+	if err := t.resolve(); err != nil {
+		return nil, err
+	}
+	if t.conn != nil {
+		return t.conn, nil
+	}
+	return t.db, nil
+}
+
+// Transaction runs fn in a transaction whose search_path is the tenant schema. Called
+// with a handle that is already in a transaction, fn runs in a savepoint of it and the
+// outer search_path is restored afterwards. A tenant with a database of its own always
+// gets a transaction of that database.
+func (t *DB) Transaction(fn func(tx *gorm.DB) error) error {
+	if err := t.resolve(); err != nil {
+		return err
+	}
+	if t.conn != nil {
+		return transaction(t.conn, t.quoted, fn)
+	}
+	if inTransaction(t.db) {
+		return t.nested(fn)
+	}
+	return transaction(t.db, t.quoted, fn)
+}
+
+// WithTx returns a handle for the same tenant whose transactions run inside tx
+func (t *DB) WithTx(tx *gorm.DB) *DB {
+	copied := *t
+	copied.db = tx
+	return &copied
+}
+
+// resolve looks up where the tenant's tables live
+func (t *DB) resolve() error {
+	if t.resolved {
+		return nil
+	}
+	strategy, err := StrategyOf(t.db, t.tenantID)
+	if err != nil {
+		return err
+	}
+	conn, err := strategy.Database(t.db, t.tenantID)
+	if err != nil {
+		return err
+	}
+	t.schema = strategy.Schema(t.tenantID)
+	t.quoted = `"` + t.schema + `"` // Strategies only return valid identifiers
+	t.conn = conn
+	t.resolved = true
+	return nil
+}
+
+// nested runs fn in a savepoint of the current transaction with the tenant search_path
+func (t *DB) nested(fn func(tx *gorm.DB) error) error {
+	var previous string
+	if err := t.db.Raw("SELECT current_setting('search_path')").Scan(&previous).Error; err != nil {
+		return fmt.Errorf("failed to read search path: %v", err)
+	}
+	return t.db.Transaction(func(tx *gorm.DB) error {
+		if err := setSearchPath(tx, t.quoted); err != nil {
+			return err
+		}
+		if err := fn(tx); err != nil {
+			return err
+		}
+		return setSearchPath(tx, previous)
+	})
+}
+
+// transaction runs fn in a new transaction of db with the given search_path
+func transaction(db *gorm.DB, searchPath string, fn func(tx *gorm.DB) error) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := setSearchPath(tx, searchPath); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+
+// setSearchPath sets the search_path until the end of the current transaction. The value
+// is passed as a parameter, never interpolated into the statement.
+func setSearchPath(tx *gorm.DB, searchPath string) error {
+	if err := tx.Exec("SELECT set_config('search_path', ?, true)", searchPath).Error; err != nil {
+		return fmt.Errorf("failed to set search path: %v", err)
+	}
+	return nil
+}
+
+// inTransaction reports whether a handle runs its statements in a transaction
+func inTransaction(db *gorm.DB) bool {
+	_, ok := db.Statement.ConnPool.(gorm.TxCommitter)
+	return ok
+}
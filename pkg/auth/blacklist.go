@@ -1,59 +1,42 @@
 package auth
 
 import (
-	"sync"
 	"time"
 )
 
+// blacklistKeyPrefix prefixes the store keys of invalidated tokens
+const blacklistKeyPrefix = "blacklist:"
+
 // TokenBlacklist manages invalidated tokens
 type TokenBlacklist struct {
-	blacklisted map[string]time.Time
-	mutex       sync.RWMutex
+	store Store
 }
 
-// NewTokenBlacklist creates a new token blacklist
-func NewTokenBlacklist() *TokenBlacklist {
+// NewTokenBlacklist creates a new token blacklist backed by the given store
+func NewTokenBlacklist(store Store) *TokenBlacklist {
 	return &TokenBlacklist{
-		blacklisted: make(map[string]time.Time),
+		store: store,
 	}
 }
 
-// BlacklistToken adds a token to the blacklist with its expiration time
-func (tb *TokenBlacklist) BlacklistToken(tokenID string, expiresAt time.Time) {
-	tb.mutex.Lock()
-	defer tb.mutex.Unlock()
-	tb.blacklisted[tokenID] = expiresAt
+// BlacklistToken adds a token to the blacklist until its expiration time
+func (tb *TokenBlacklist) BlacklistToken(tokenID string, expiresAt time.Time) error {
+	ttl := time.Until(expiresAt)
+	if ttl <= 0 {
+		// Token already expired, nothing to revoke
+		return nil
+	}
+	return tb.store.Set(blacklistKeyPrefix+tokenID, []byte("1"), ttl)
 }
 
 // IsBlacklisted checks if a token is blacklisted
-func (tb *TokenBlacklist) IsBlacklisted(tokenID string) bool {
-	tb.mutex.RLock()
-	defer tb.mutex.RUnlock()
-	_, exists := tb.blacklisted[tokenID]
-	return exists
-}
-
-// CleanupExpired removes expired tokens from the blacklist
-func (tb *TokenBlacklist) CleanupExpired() {
-	tb.mutex.Lock()
-	defer tb.mutex.Unlock()
-	
-	now := time.Now()
-	for tokenID, expiresAt := range tb.blacklisted {
-		if now.After(expiresAt) {
-			delete(tb.blacklisted, tokenID)
-		}
+func (tb *TokenBlacklist) IsBlacklisted(tokenID string) (bool, error) {
+	_, err := tb.store.Get(blacklistKeyPrefix + tokenID)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
 	}
+	return true, nil
 }
-
-// StartCleanupRoutine starts a goroutine that periodically cleans up expired tokens
-func (tb *TokenBlacklist) StartCleanupRoutine(interval time.Duration) {
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
-		
-		for range ticker.C {
-			tb.CleanupExpired()
-		}
-	}()
-}
\ No newline at end of file
@@ -0,0 +1,183 @@
+package auth
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// JSONWebKey is a public key in JWK format (RFC 7517)
+type JSONWebKey struct {
+	KeyType   string `json:"kty"`
+	KeyID     string `json:"kid"`
+	Use       string `json:"use,omitempty"`
+	Algorithm string `json:"alg,omitempty"`
+	N         string `json:"n,omitempty"`   // RSA modulus
+	E         string `json:"e,omitempty"`   // RSA exponent
+	Curve     string `json:"crv,omitempty"` // OKP curve
+	X         string `json:"x,omitempty"`   // OKP public key
+}
+
+// JSONWebKeySet is the document served at /.well-known/jwks.json
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+// NewJSONWebKey converts a verification key into its JWK representation
+func NewJSONWebKey(key *VerificationKey) (*JSONWebKey, error) {
+	jwk := &JSONWebKey{
+		KeyID:     key.ID,
+		Use:       "sig",
+		Algorithm: key.Algorithm,
+	}
+
+	switch publicKey := key.PublicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.KeyType = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.KeyType = "OKP"
+		jwk.Curve = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(publicKey)
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+
+	return jwk, nil
+}
+
+// Thumbprint returns the RFC 7638 thumbprint of the key, used as kid
+func (k *JSONWebKey) Thumbprint() string {
+	switch k.KeyType {
+	case "RSA":
+		return thumbprint(fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, k.E, k.N))
+	case "OKP":
+		return thumbprint(fmt.Sprintf(`{"crv":"%s","kty":"OKP","x":"%s"}`, k.Curve, k.X))
+	default:
+		return ""
+	}
+}
+
+// VerificationKey converts the JWK back into a public key
+func (k *JSONWebKey) VerificationKey() (*VerificationKey, error) {
+	key := &VerificationKey{ID: k.KeyID}
+
+	switch k.KeyType {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA modulus: %v", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("invalid RSA exponent: %v", err)
+		}
+		key.Algorithm = AlgorithmRS256
+		key.PublicKey = &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}
+	case "OKP":
+		if k.Curve != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve: %s", k.Curve)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid Ed25519 public key")
+		}
+		key.Algorithm = AlgorithmEdDSA
+		key.PublicKey = ed25519.PublicKey(x)
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+
+	return key, nil
+}
+
+// RemoteKeySet verifies tokens with public keys fetched from a JWKS endpoint.
+// Keys are cached and refetched when the cache expires or an unknown kid shows up.
+type RemoteKeySet struct {
+	url         string
+	client      *http.Client
+	cacheTTL    time.Duration
+	minRefresh  time.Duration
+	keys        map[string]*VerificationKey // key: kid
+	fetchedAt   time.Time
+	lastAttempt time.Time
+	mutex       sync.Mutex
+}
+
+// NewRemoteKeySet creates a key set backed by the JWKS document at url
+func NewRemoteKeySet(url string, cacheTTL time.Duration) *RemoteKeySet {
+	return &RemoteKeySet{
+		url:        url,
+		client:     &http.Client{Timeout: 5 * time.Second},
+		cacheTTL:   cacheTTL,
+		minRefresh: 10 * time.Second,
+		keys:       make(map[string]*VerificationKey),
+	}
+}
+
+// VerificationKey returns the public key for a kid, fetching the JWKS if needed
+func (rks *RemoteKeySet) VerificationKey(kid string) (*VerificationKey, error) {
+	rks.mutex.Lock()
+	defer rks.mutex.Unlock()
+
+	key, exists := rks.keys[kid]
+	expired := time.Since(rks.fetchedAt) > rks.cacheTTL
+	if exists && !expired {
+		return key, nil
+	}
+
+	// Avoid hammering the auth service with tokens signed by unknown keys
+	if time.Since(rks.lastAttempt) >= rks.minRefresh {
+		if err := rks.refresh(); err != nil && !exists {
+			return nil, err
+		}
+	}
+
+	if key, exists := rks.keys[kid]; exists {
+		return key, nil
+	}
+	return nil, ErrUnknownKeyID
+}
+
+// refresh downloads the JWKS document and replaces the cached keys
+func (rks *RemoteKeySet) refresh() error {
+	rks.lastAttempt = time.Now()
+
+	resp, err := rks.client.Get(rks.url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch JWKS: status %d", resp.StatusCode)
+	}
+
+	var set JSONWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %v", err)
+	}
+
+	keys := make(map[string]*VerificationKey, len(set.Keys))
+	for i := range set.Keys {
+		key, err := set.Keys[i].VerificationKey()
+		if err != nil {
+			continue
+		}
+		keys[key.ID] = key
+	}
+
+	rks.keys = keys
+	rks.fetchedAt = time.Now()
+	return nil
+}
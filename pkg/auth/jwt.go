@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"fmt"
 	"time"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
@@ -8,78 +9,323 @@ import (
 
 type TokenManager struct {
 	secretKey      []byte
+	keys           *KeyManager // Asymmetric signing keys, nil for HMAC or verify-only managers
+	keySet         KeySet      // Public keys accepted for verification
 	issuer         string
+	accessTTL      time.Duration
+	refreshTTL     time.Duration
+	store          Store
 	blacklist      *TokenBlacklist
 	sessionManager *SessionManager
+	families       *TokenFamilies
+	oneTimeTokens  *OneTimeTokens
 }
 
+// NewTokenManager creates a token manager that signs tokens with a shared HS256 secret
 func NewTokenManager(secret, issuer string) *TokenManager {
+	tokenManager := newTokenManager(issuer)
+	tokenManager.secretKey = []byte(secret)
+	return tokenManager
+}
+
+// NewKeyedTokenManager creates a token manager that signs tokens with the active key
+// of the key manager (RS256 or EdDSA) and verifies them with any of its keys
+func NewKeyedTokenManager(keys *KeyManager, issuer string) *TokenManager {
+	tokenManager := newTokenManager(issuer)
+	tokenManager.keys = keys
+	tokenManager.keySet = keys
+	return tokenManager
+}
+
+// NewVerifyingTokenManager creates a token manager that only verifies tokens,
+// e.g. with keys fetched from the auth service JWKS endpoint
+func NewVerifyingTokenManager(keySet KeySet, issuer string) *TokenManager {
+	tokenManager := newTokenManager(issuer)
+	tokenManager.keySet = keySet
+	return tokenManager
+}
+
+func newTokenManager(issuer string) *TokenManager {
 	tokenManager := &TokenManager{
-		secretKey:      []byte(secret),
-		issuer:         issuer,
-		blacklist:      NewTokenBlacklist(),
-		sessionManager: NewSessionManager(),
+		issuer:     issuer,
+		accessTTL:  DefaultAccessTokenTTL,
+		refreshTTL: DefaultRefreshTokenTTL,
 	}
 	
-	// Start cleanup routines
-	tokenManager.blacklist.StartCleanupRoutine(1 * time.Hour)
-	tokenManager.sessionManager.StartCleanupRoutine(1 * time.Hour, 24 * time.Hour) // Clean sessions idle for 24 hours
+	// Per-process store until a shared store is configured
+	store := NewMemoryStore()
+	store.StartCleanupRoutine(1 * time.Hour)
+	tokenManager.SetStore(store)
 	
 	return tokenManager
 }
 
+// SetStore sets the storage for the blacklist, sessions and refresh token families.
+// Services validating tokens must share a store for logouts to take effect everywhere.
+func (tm *TokenManager) SetStore(store Store) {
+	tm.store = store
+	tm.blacklist = NewTokenBlacklist(store)
+	tm.sessionManager = NewSessionManager(store, DefaultSessionIdleTimeout)
+	tm.families = NewTokenFamilies(store)
+	tm.oneTimeTokens = NewOneTimeTokens(store)
+}
+
+// SetTokenLifetimes configures the lifetime of access and refresh tokens
+func (tm *TokenManager) SetTokenLifetimes(accessTTL, refreshTTL time.Duration) {
+	if accessTTL > 0 {
+		tm.accessTTL = accessTTL
+	}
+	if refreshTTL > 0 {
+		tm.refreshTTL = refreshTTL
+	}
+}
+
+// AccessTokenTTL returns the lifetime of access tokens
+func (tm *TokenManager) AccessTokenTTL() time.Duration {
+	return tm.accessTTL
+}
+
 type Claims struct {
-	UserID   string `json:"user_id"`
-	TenantID string `json:"tenant_id"`
-	Role     string `json:"role"`
-	TokenID  string `json:"token_id"` // Add unique token ID for blacklisting
+	UserID    string `json:"user_id"`
+	TenantID  string `json:"tenant_id"`
+	Role      string `json:"role"`
+	TokenID   string `json:"token_id"` // Add unique token ID for blacklisting
+	TokenType string `json:"token_type,omitempty"`
+	FamilyID  string `json:"family_id,omitempty"` // Login the token was issued from
+	// ImpersonatorID is the system user acting as UserID in impersonation tokens
+	ImpersonatorID string `json:"impersonator_id,omitempty"`
 	jwt.RegisteredClaims
 }
 
+// IsImpersonation reports whether the token was issued to a system user impersonating UserID
+func (c *Claims) IsImpersonation() bool {
+	return c.ImpersonatorID != ""
+}
+
+// GenerateToken creates a standalone access token
 func (tm *TokenManager) GenerateToken(userID, tenantID, role string) (string, error) {
-	tokenID := uuid.New().String()
-	expiresAt := time.Now().Add(24 * time.Hour)
+	token, _, err := tm.generateToken(userID, tenantID, role, TokenTypeAccess, "", tm.accessTTL)
+	return token, err
+}
+
+// GenerateTokenPair creates an access token and a refresh token starting a new token family
+func (tm *TokenManager) GenerateTokenPair(userID, tenantID, role string) (*TokenPair, error) {
+	familyID := uuid.New().String()
+
+	pair, refreshClaims, err := tm.generateTokenPair(userID, tenantID, role, familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tm.families.Create(familyID, userID, refreshClaims.TokenID, pair.AccessClaims.TokenID, refreshClaims.ExpiresAt.Time); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// RoleResolver returns the role of the user a refresh token was issued to, read from
+// the account as it is now. An error stops the refresh and is returned by RefreshTokens.
+type RoleResolver func(claims *Claims) (string, error)
+
+// RefreshTokens exchanges a refresh token for a new token pair in the same family.
+// Each refresh token can be used once; reusing one revokes the whole family. The
+// new tokens carry the role returned by currentRole rather than the role of the login.
+func (tm *TokenManager) RefreshTokens(refreshToken string, currentRole RoleResolver) (*TokenPair, error) {
+	claims, err := tm.parseToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeRefresh || claims.FamilyID == "" {
+		return nil, ErrInvalidTokenType
+	}
+
+	role, err := currentRole(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, refreshClaims, err := tm.generateTokenPair(claims.UserID, claims.TenantID, role, claims.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+
+	previousAccessID, err := tm.families.Rotate(claims.FamilyID, claims.TokenID, refreshClaims.TokenID, pair.AccessClaims.TokenID, refreshClaims.ExpiresAt.Time)
+	if err != nil {
+		if err == ErrRefreshTokenReused {
+			if removeErr := tm.sessionManager.RemoveSession(previousAccessID); removeErr != nil {
+				return nil, removeErr
+			}
+		}
+		return nil, err
+	}
+
+	// Keep the session alive under the new access token
+	if err := tm.sessionManager.ReplaceToken(previousAccessID, pair.AccessClaims.TokenID); err != nil {
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+// RevokeTokenFamily revokes every token issued from the same login
+func (tm *TokenManager) RevokeTokenFamily(familyID string) error {
+	accessTokenID, err := tm.families.Revoke(familyID)
+	if err != nil {
+		return err
+	}
+	if accessTokenID == "" {
+		return nil
+	}
+	return tm.sessionManager.RemoveSession(accessTokenID)
+}
+
+// ValidateToken validates an access token
+func (tm *TokenManager) ValidateToken(tokenString string) (*Claims, error) {
+	claims, err := tm.parseToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refresh tokens cannot be used to access resources
+	if claims.TokenType == TokenTypeRefresh {
+		return nil, ErrInvalidTokenType
+	}
+
+	// Check if token is blacklisted
+	blacklisted, err := tm.blacklist.IsBlacklisted(claims.TokenID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check token blacklist: %v", err)
+	}
+	if blacklisted {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	// Check if the login the token belongs to was revoked
+	if claims.FamilyID != "" {
+		revoked, err := tm.families.IsRevoked(claims.FamilyID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check token family: %v", err)
+		}
+		if revoked {
+			return nil, ErrTokenFamilyRevoked
+		}
+	}
 	
-	claims := Claims{
-		UserID:   userID,
-		TenantID: tenantID,
-		Role:     role,
-		TokenID:  tokenID,
+	// Update session activity
+	if err := tm.sessionManager.UpdateLastSeen(claims.TokenID); err != nil {
+		return nil, fmt.Errorf("failed to update session: %v", err)
+	}
+	
+	return claims, nil
+}
+
+// generateTokenPair signs an access and a refresh token for the given family
+func (tm *TokenManager) generateTokenPair(userID, tenantID, role, familyID string) (*TokenPair, *Claims, error) {
+	accessToken, accessClaims, err := tm.generateToken(userID, tenantID, role, TokenTypeAccess, familyID, tm.accessTTL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refreshToken, refreshClaims, err := tm.generateToken(userID, tenantID, role, TokenTypeRefresh, familyID, tm.refreshTTL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+		AccessClaims: accessClaims,
+		FamilyID:     familyID,
+		ExpiresIn:    int(tm.accessTTL.Seconds()),
+	}, refreshClaims, nil
+}
+
+// generateToken signs a token of the given type
+func (tm *TokenManager) generateToken(userID, tenantID, role, tokenType, familyID string, ttl time.Duration) (string, *Claims, error) {
+	now := time.Now()
+	
+	claims := &Claims{
+		UserID:    userID,
+		TenantID:  tenantID,
+		Role:      role,
+		TokenID:   uuid.New().String(),
+		TokenType: tokenType,
+		FamilyID:  familyID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(expiresAt),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			IssuedAt:  jwt.NewNumericDate(now),
 			Issuer:    tm.issuer,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(tm.secretKey)
+	signed, err := tm.signClaims(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	return signed, claims, nil
+}
+
+// signClaims signs claims with the active key, or the shared secret without keys
+func (tm *TokenManager) signClaims(claims *Claims) (string, error) {
+	var signed string
+	var err error
+	if tm.keys != nil {
+		key, keyErr := tm.keys.ActiveKey()
+		if keyErr != nil {
+			return "", keyErr
+		}
+		token := jwt.NewWithClaims(key.SigningMethod(), claims)
+		token.Header["kid"] = key.ID
+		signed, err = token.SignedString(key.PrivateKey)
+	} else if tm.secretKey != nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		signed, err = token.SignedString(tm.secretKey)
+	} else {
+		return "", ErrNoSigningKey
+	}
+	return signed, err
 }
 
-func (tm *TokenManager) ValidateToken(tokenString string) (*Claims, error) {
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		return tm.secretKey, nil
-	})
+// parseToken verifies the signature and expiry of a token
+func (tm *TokenManager) parseToken(tokenString string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, tm.verificationKey)
 
 	if err != nil {
 		return nil, err
 	}
 
 	if claims, ok := token.Claims.(*Claims); ok && token.Valid {
-		// Check if token is blacklisted
-		if tm.blacklist.IsBlacklisted(claims.TokenID) {
-			return nil, jwt.ErrTokenInvalidClaims
-		}
-		
-		// Update session activity
-		tm.sessionManager.UpdateLastSeen(claims.TokenID)
-		
 		return claims, nil
 	}
 
 	return nil, jwt.ErrTokenInvalidClaims
 }
 
+// verificationKey selects the key for a token. The algorithm must match the key
+// configured for it so an HMAC token can never be verified with a public key.
+func (tm *TokenManager) verificationKey(token *jwt.Token) (interface{}, error) {
+	if _, ok := token.Method.(*jwt.SigningMethodHMAC); ok {
+		if tm.secretKey == nil {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return tm.secretKey, nil
+	}
+
+	if tm.keySet == nil {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, err := tm.keySet.VerificationKey(kid)
+	if err != nil {
+		return nil, err
+	}
+	if key.SigningMethod() == nil || key.SigningMethod().Alg() != token.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return key.PublicKey, nil
+}
+
 // InvalidateToken adds a token to the blacklist and removes associated session
 func (tm *TokenManager) InvalidateToken(tokenString string) error {
 	claims, err := tm.ValidateToken(tokenString)
@@ -88,35 +334,145 @@ func (tm *TokenManager) InvalidateToken(tokenString string) error {
 	}
 	
 	// Add token to blacklist with its expiration time
-	tm.blacklist.BlacklistToken(claims.TokenID, claims.ExpiresAt.Time)
+	if err := tm.blacklist.BlacklistToken(claims.TokenID, claims.ExpiresAt.Time); err != nil {
+		return fmt.Errorf("failed to blacklist token: %v", err)
+	}
 	
 	// Remove associated session
-	tm.sessionManager.RemoveSession(claims.TokenID)
+	if err := tm.sessionManager.RemoveSession(claims.TokenID); err != nil {
+		return fmt.Errorf("failed to remove session: %v", err)
+	}
+	
+	// Revoke the refresh tokens issued from the same login
+	if claims.FamilyID != "" {
+		if _, err := tm.families.Revoke(claims.FamilyID); err != nil {
+			return fmt.Errorf("failed to revoke token family: %v", err)
+		}
+	}
 	
 	return nil
 }
 
-// CreateSession creates a new session for a token
-func (tm *TokenManager) CreateSession(tokenID, userID, tenantID, email, ipAddress, userAgent string) *Session {
-	return tm.sessionManager.CreateSession(tokenID, userID, tenantID, email, ipAddress, userAgent)
+// CreateSession creates a new session for a token of the given refresh token family
+func (tm *TokenManager) CreateSession(tokenID, familyID, userID, tenantID, email, ipAddress, userAgent string) (*Session, error) {
+	return tm.sessionManager.CreateSession(tokenID, familyID, userID, tenantID, email, ipAddress, userAgent)
 }
 
-// GetSession retrieves a session by token ID
-func (tm *TokenManager) GetSession(tokenID string) *Session {
+// GetSession retrieves a session by token ID, returning ErrNotFound if it does not exist
+func (tm *TokenManager) GetSession(tokenID string) (*Session, error) {
 	return tm.sessionManager.GetSession(tokenID)
 }
 
 // UpdateSessionActivity updates the last seen time for a session
-func (tm *TokenManager) UpdateSessionActivity(tokenID string) {
-	tm.sessionManager.UpdateLastSeen(tokenID)
+func (tm *TokenManager) UpdateSessionActivity(tokenID string) error {
+	return tm.sessionManager.UpdateLastSeen(tokenID)
 }
 
 // GetUserSessions retrieves all active sessions for a user
-func (tm *TokenManager) GetUserSessions(userID string) []*Session {
+func (tm *TokenManager) GetUserSessions(userID string) ([]*Session, error) {
 	return tm.sessionManager.GetUserSessions(userID)
 }
 
 // GetAllSessions retrieves all active sessions
-func (tm *TokenManager) GetAllSessions() []*Session {
+func (tm *TokenManager) GetAllSessions() ([]*Session, error) {
 	return tm.sessionManager.GetAllSessions()
-}
\ No newline at end of file
+}
+
+// GetUserSession returns a session of a user by session ID
+func (tm *TokenManager) GetUserSession(userID, sessionID string) (*Session, error) {
+	sessions, err := tm.sessionManager.GetUserSessions(userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, session := range sessions {
+		if session.ID == sessionID {
+			return session, nil
+		}
+	}
+	return nil, ErrSessionNotFound
+}
+
+// RevokeSession logs out a session: its current access token is blacklisted,
+// its refresh tokens are revoked and the session is removed
+func (tm *TokenManager) RevokeSession(session *Session) error {
+	// The session does not know when its access token expires, so blacklist it for the longest possible lifetime
+	if err := tm.blacklist.BlacklistToken(session.TokenID, time.Now().Add(tm.accessTTL)); err != nil {
+		return fmt.Errorf("failed to blacklist token: %v", err)
+	}
+
+	if session.FamilyID != "" {
+		if _, err := tm.families.Revoke(session.FamilyID); err != nil {
+			return fmt.Errorf("failed to revoke token family: %v", err)
+		}
+	}
+
+	if err := tm.sessionManager.RemoveSession(session.TokenID); err != nil {
+		return fmt.Errorf("failed to remove session: %v", err)
+	}
+	return nil
+}
+
+// RevokeSessions revokes several sessions and returns how many were revoked
+func (tm *TokenManager) RevokeSessions(sessions []*Session) (int, error) {
+	revoked := 0
+	for _, session := range sessions {
+		if err := tm.RevokeSession(session); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// RevokeUserSessions revokes every session and refresh token family of a user and
+// returns how many logins were revoked
+func (tm *TokenManager) RevokeUserSessions(userID string) (int, error) {
+	sessions, err := tm.sessionManager.GetUserSessions(userID)
+	if err != nil {
+		return 0, err
+	}
+	revoked, err := tm.RevokeSessions(sessions)
+	if err != nil {
+		return revoked, err
+	}
+
+	// A session that expired from being idle no longer shows up in the index,
+	// but the refresh token of its login can still be exchanged
+	accessTokenIDs, err := tm.families.RevokeUser(userID)
+	if err != nil {
+		return revoked, fmt.Errorf("failed to revoke token families: %v", err)
+	}
+	for _, tokenID := range accessTokenIDs {
+		if err := tm.blacklist.BlacklistToken(tokenID, time.Now().Add(tm.accessTTL)); err != nil {
+			return revoked, fmt.Errorf("failed to blacklist token: %v", err)
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+// IssueOneTimeToken creates a single-use token carrying payload, e.g. for email verification links
+func (tm *TokenManager) IssueOneTimeToken(purpose string, payload interface{}, ttl time.Duration) (string, error) {
+	return tm.oneTimeTokens.Issue(purpose, payload, ttl)
+}
+
+// IssueOneTimeTokenFor creates a single-use token for a subject, e.g. a user ID, that can
+// be invalidated together with the subject's other tokens by RevokeOneTimeTokens
+func (tm *TokenManager) IssueOneTimeTokenFor(purpose, subject string, payload interface{}, ttl time.Duration) (string, error) {
+	return tm.oneTimeTokens.IssueFor(purpose, subject, payload, ttl)
+}
+
+// RevokeOneTimeTokens invalidates the single-use tokens issued for a subject
+func (tm *TokenManager) RevokeOneTimeTokens(purpose, subject string) error {
+	return tm.oneTimeTokens.RevokeAll(purpose, subject)
+}
+
+// ConsumeOneTimeToken decodes the payload of a single-use token and invalidates it
+func (tm *TokenManager) ConsumeOneTimeToken(purpose, token string, payload interface{}) error {
+	return tm.oneTimeTokens.Consume(purpose, token, payload)
+}
+
+// PeekOneTimeToken decodes the payload of a single-use token without invalidating it
+func (tm *TokenManager) PeekOneTimeToken(purpose, token string, payload interface{}) error {
+	return tm.oneTimeTokens.Peek(purpose, token, payload)
+}
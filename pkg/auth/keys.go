@@ -0,0 +1,511 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+// Supported asymmetric signing algorithms
+const (
+	AlgorithmRS256 = "RS256"
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// rsaKeyBits is the size of generated RSA keys
+const rsaKeyBits = 2048
+
+// Key errors
+var (
+	ErrNoSigningKey       = errors.New("no signing key configured")
+	ErrUnknownKeyID       = errors.New("unknown key id")
+	ErrUnsupportedKeyType = errors.New("unsupported key type")
+	ErrKeyRingLocked      = errors.New("timed out waiting for the signing key lock")
+)
+
+// Store keys of the key ring shared by the replicas of the auth service
+const (
+	keyRingKey     = "signing_keys"
+	keyRingLockKey = "signing_keys:lock"
+)
+
+// keyRingLockTTL bounds how long a replica that crashed mid-rotation blocks the others
+const keyRingLockTTL = 30 * time.Second
+
+// keyRingSyncInterval is how often replicas load keys rotated by another replica
+const keyRingSyncInterval = time.Minute
+
+// KeySet provides public keys to verify token signatures by key ID
+type KeySet interface {
+	VerificationKey(kid string) (*VerificationKey, error)
+}
+
+// VerificationKey is a public key that can verify tokens with the given kid
+type VerificationKey struct {
+	ID        string
+	Algorithm string
+	PublicKey crypto.PublicKey
+}
+
+// SigningMethod returns the JWT signing method of the key
+func (k *VerificationKey) SigningMethod() jwt.SigningMethod {
+	return signingMethod(k.Algorithm)
+}
+
+// SigningKey is a private key used to sign tokens
+type SigningKey struct {
+	VerificationKey
+	PrivateKey crypto.Signer
+	CreatedAt  time.Time
+	RetiredAt  *time.Time // When the key stopped being used for signing
+}
+
+// KeyManager holds the active signing key and the keys still accepted for verification.
+// The next key is published one rotation ahead so verifiers know it before it signs,
+// and rotated keys are kept until every token they signed has expired.
+type KeyManager struct {
+	keys      map[string]*SigningKey // key: kid
+	activeKID string
+	nextKID   string
+	rotatedAt time.Time
+	retention time.Duration
+	store     Store // Shares the keys between replicas, optional
+	mutex     sync.RWMutex
+}
+
+// storedKeyRing is the key ring as kept in the store
+type storedKeyRing struct {
+	ActiveKID string             `json:"active_kid"`
+	NextKID   string             `json:"next_kid"`
+	RotatedAt time.Time          `json:"rotated_at"`
+	Keys      []storedSigningKey `json:"keys"`
+}
+
+// storedSigningKey is a signing key as kept in the store
+type storedSigningKey struct {
+	PrivateKey string     `json:"private_key"` // PKCS#8 PEM
+	CreatedAt  time.Time  `json:"created_at"`
+	RetiredAt  *time.Time `json:"retired_at,omitempty"`
+}
+
+// NewKeyManager creates a key manager that keeps retired keys for the given retention period
+func NewKeyManager(retention time.Duration) *KeyManager {
+	return &KeyManager{
+		keys:      make(map[string]*SigningKey),
+		retention: retention,
+	}
+}
+
+// GenerateSigningKey creates a new RS256 or EdDSA signing key
+func GenerateSigningKey(algorithm string) (*SigningKey, error) {
+	var privateKey crypto.Signer
+	var err error
+
+	switch algorithm {
+	case AlgorithmRS256:
+		privateKey, err = rsa.GenerateKey(rand.Reader, rsaKeyBits)
+	case AlgorithmEdDSA:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm: %s", algorithm)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %v", err)
+	}
+
+	return newSigningKey(privateKey)
+}
+
+// ParseSigningKeyPEM parses a PKCS#8 or PKCS#1 encoded RSA or Ed25519 private key
+func ParseSigningKeyPEM(data []byte) (*SigningKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode PEM block")
+	}
+
+	var privateKey interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		privateKey, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type: %s", block.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		return nil, ErrUnsupportedKeyType
+	}
+	return newSigningKey(signer)
+}
+
+// SetStore shares generated keys through a store, e.g. Redis, so every replica of the
+// auth service signs and verifies with the same keys and only one of them rotates.
+// The store then holds the private keys and must be protected like key files.
+func (km *KeyManager) SetStore(store Store) {
+	km.store = store
+}
+
+// AddKey adds a signing key. The first key added becomes the active key.
+func (km *KeyManager) AddKey(key *SigningKey) {
+	km.mutex.Lock()
+	defer km.mutex.Unlock()
+
+	km.keys[key.ID] = key
+	if km.activeKID == "" {
+		km.activeKID = key.ID
+	}
+}
+
+// Activate makes an existing key the signing key and retires the previous one
+func (km *KeyManager) Activate(kid string) error {
+	km.mutex.Lock()
+	defer km.mutex.Unlock()
+
+	key, exists := km.keys[kid]
+	if !exists {
+		return ErrUnknownKeyID
+	}
+
+	if previous, exists := km.keys[km.activeKID]; exists && previous.ID != kid {
+		now := time.Now()
+		previous.RetiredAt = &now
+	}
+	key.RetiredAt = nil
+	km.activeKID = kid
+	if km.nextKID == kid {
+		km.nextKID = ""
+	}
+	return nil
+}
+
+// Rotate activates the pre-published next key (or a new key on first use), publishes a
+// freshly generated next key and drops keys retired longer than the retention period.
+// Tokens signed by the previous key stay valid until they expire.
+func (km *KeyManager) Rotate(algorithm string) (*SigningKey, error) {
+	err := km.update(func() (bool, error) {
+		return true, km.rotate(algorithm)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return km.ActiveKey()
+}
+
+// RotateIfDue rotates when there is no signing key yet, or when the active key has
+// signed for the interval; an interval of zero never rotates an existing key. With a
+// store this is decided on the shared key ring, so of all replicas only the first one
+// rotates and the others load its keys.
+func (km *KeyManager) RotateIfDue(algorithm string, interval time.Duration) error {
+	return km.update(func() (bool, error) {
+		km.mutex.RLock()
+		_, active := km.keys[km.activeKID]
+		rotatedAt := km.rotatedAt
+		km.mutex.RUnlock()
+
+		if active && (interval <= 0 || time.Since(rotatedAt) < interval) {
+			return false, nil
+		}
+		return true, km.rotate(algorithm)
+	})
+}
+
+// Load replaces the keys with the key ring shared in the store. It reports false when
+// there is no store or no key ring has been stored yet.
+func (km *KeyManager) Load() (bool, error) {
+	if km.store == nil {
+		return false, nil
+	}
+
+	data, err := km.store.Get(keyRingKey)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	var ring storedKeyRing
+	if err := json.Unmarshal(data, &ring); err != nil {
+		return false, fmt.Errorf("failed to decode signing keys: %v", err)
+	}
+	keys := make(map[string]*SigningKey, len(ring.Keys))
+	for _, stored := range ring.Keys {
+		key, err := ParseSigningKeyPEM([]byte(stored.PrivateKey))
+		if err != nil {
+			return false, err
+		}
+		key.CreatedAt = stored.CreatedAt
+		key.RetiredAt = stored.RetiredAt
+		keys[key.ID] = key
+	}
+
+	km.mutex.Lock()
+	defer km.mutex.Unlock()
+
+	km.keys = keys
+	km.activeKID = ring.ActiveKID
+	km.nextKID = ring.NextKID
+	km.rotatedAt = ring.RotatedAt
+	return true, nil
+}
+
+// update applies a change to the keys. With a store the change is made to the shared
+// key ring while holding its lock, and saved if the change reports it changed the keys.
+func (km *KeyManager) update(change func() (bool, error)) error {
+	if km.store == nil {
+		_, err := change()
+		return err
+	}
+
+	unlock, err := km.lockKeyRing()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if _, err := km.Load(); err != nil {
+		return err
+	}
+	changed, err := change()
+	if err != nil || !changed {
+		return err
+	}
+	return km.save()
+}
+
+// lockKeyRing waits for the key ring lock and returns the function releasing it. The
+// lock holds a random token, so a replica whose lock expired does not release the lock
+// another replica took since.
+func (km *KeyManager) lockKeyRing() (func(), error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return nil, fmt.Errorf("failed to generate lock token: %v", err)
+	}
+	token := []byte(base64.RawURLEncoding.EncodeToString(random))
+
+	deadline := time.Now().Add(keyRingLockTTL)
+	for {
+		locked, err := km.store.SetNX(keyRingLockKey, token, keyRingLockTTL)
+		if err != nil {
+			return nil, err
+		}
+		if locked {
+			return func() { km.store.CompareAndDelete(keyRingLockKey, token) }, nil
+		}
+		if time.Now().After(deadline) {
+			return nil, ErrKeyRingLocked
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+// save writes the keys to the store as the shared key ring
+func (km *KeyManager) save() error {
+	km.mutex.RLock()
+	ring := storedKeyRing{
+		ActiveKID: km.activeKID,
+		NextKID:   km.nextKID,
+		RotatedAt: km.rotatedAt,
+		Keys:      make([]storedSigningKey, 0, len(km.keys)),
+	}
+	for _, key := range km.keys {
+		der, err := x509.MarshalPKCS8PrivateKey(key.PrivateKey)
+		if err != nil {
+			km.mutex.RUnlock()
+			return fmt.Errorf("failed to encode signing key: %v", err)
+		}
+		ring.Keys = append(ring.Keys, storedSigningKey{
+			PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+			CreatedAt:  key.CreatedAt,
+			RetiredAt:  key.RetiredAt,
+		})
+	}
+	km.mutex.RUnlock()
+
+	data, err := json.Marshal(ring)
+	if err != nil {
+		return err
+	}
+	return km.store.Set(keyRingKey, data, 0)
+}
+
+// rotate activates the next key and generates the one after it
+func (km *KeyManager) rotate(algorithm string) error {
+	km.mutex.RLock()
+	nextKID := km.nextKID
+	km.mutex.RUnlock()
+
+	if nextKID == "" {
+		key, err := GenerateSigningKey(algorithm)
+		if err != nil {
+			return err
+		}
+		km.AddKey(key)
+		nextKID = key.ID
+	}
+	if err := km.Activate(nextKID); err != nil {
+		return err
+	}
+
+	next, err := GenerateSigningKey(algorithm)
+	if err != nil {
+		return err
+	}
+	km.mutex.Lock()
+	km.keys[next.ID] = next
+	km.nextKID = next.ID
+	km.rotatedAt = time.Now()
+	km.mutex.Unlock()
+
+	km.PruneRetiredKeys()
+
+	return nil
+}
+
+// PruneRetiredKeys removes retired keys that can no longer have valid tokens
+func (km *KeyManager) PruneRetiredKeys() {
+	km.mutex.Lock()
+	defer km.mutex.Unlock()
+
+	cutoff := time.Now().Add(-km.retention)
+	for kid, key := range km.keys {
+		if key.RetiredAt != nil && key.RetiredAt.Before(cutoff) {
+			delete(km.keys, kid)
+		}
+	}
+}
+
+// ActiveKey returns the key used to sign new tokens
+func (km *KeyManager) ActiveKey() (*SigningKey, error) {
+	km.mutex.RLock()
+	defer km.mutex.RUnlock()
+
+	key, exists := km.keys[km.activeKID]
+	if !exists {
+		return nil, ErrNoSigningKey
+	}
+	return key, nil
+}
+
+// VerificationKey returns the public key for a kid
+func (km *KeyManager) VerificationKey(kid string) (*VerificationKey, error) {
+	km.mutex.RLock()
+	defer km.mutex.RUnlock()
+
+	key, exists := km.keys[kid]
+	if !exists {
+		return nil, ErrUnknownKeyID
+	}
+	return &key.VerificationKey, nil
+}
+
+// JWKS returns the public keys accepted for verification as a JSON Web Key Set
+func (km *KeyManager) JWKS() *JSONWebKeySet {
+	km.mutex.RLock()
+	defer km.mutex.RUnlock()
+
+	keys := make([]*SigningKey, 0, len(km.keys))
+	for _, key := range km.keys {
+		keys = append(keys, key)
+	}
+	// Newest key first
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+
+	set := &JSONWebKeySet{Keys: make([]JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		if jwk, err := NewJSONWebKey(&key.VerificationKey); err == nil {
+			set.Keys = append(set.Keys, *jwk)
+		}
+	}
+	return set
+}
+
+// StartRotationRoutine starts a goroutine that rotates the signing key at a fixed interval.
+// With a store it also loads the keys rotated by other replicas every minute.
+func (km *KeyManager) StartRotationRoutine(interval time.Duration, algorithm string) {
+	tick := interval
+	if km.store != nil && (tick <= 0 || tick > keyRingSyncInterval) {
+		tick = keyRingSyncInterval
+	}
+	if tick <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(tick)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			km.RotateIfDue(algorithm, interval)
+		}
+	}()
+}
+
+// newSigningKey wraps a private key and derives its kid from the public key thumbprint
+func newSigningKey(privateKey crypto.Signer) (*SigningKey, error) {
+	var algorithm string
+	switch privateKey.(type) {
+	case *rsa.PrivateKey:
+		algorithm = AlgorithmRS256
+	case ed25519.PrivateKey:
+		algorithm = AlgorithmEdDSA
+	default:
+		return nil, ErrUnsupportedKeyType
+	}
+
+	key := &SigningKey{
+		VerificationKey: VerificationKey{
+			Algorithm: algorithm,
+			PublicKey: privateKey.Public(),
+		},
+		PrivateKey: privateKey,
+		CreatedAt:  time.Now(),
+	}
+
+	jwk, err := NewJSONWebKey(&key.VerificationKey)
+	if err != nil {
+		return nil, err
+	}
+	key.ID = jwk.Thumbprint()
+
+	return key, nil
+}
+
+// signingMethod maps an algorithm name to its JWT signing method
+func signingMethod(algorithm string) jwt.SigningMethod {
+	switch algorithm {
+	case AlgorithmRS256:
+		return jwt.SigningMethodRS256
+	case AlgorithmEdDSA:
+		return jwt.SigningMethodEdDSA
+	default:
+		return nil
+	}
+}
+
+// thumbprint computes a base64url encoded SHA-256 digest (RFC 7638)
+func thumbprint(canonical string) string {
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
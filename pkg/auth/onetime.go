@@ -0,0 +1,132 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// ErrInvalidOneTimeToken is returned for unknown, expired or already used one-time tokens
+var ErrInvalidOneTimeToken = errors.New("invalid or expired token")
+
+// Store keys used for one-time tokens
+const (
+	oneTimeKeyPrefix        = "onetime:"
+	usedOneTimeKeyPrefix    = "onetime_used:"
+	subjectOneTimeKeyPrefix = "onetime_subject:"
+)
+
+// OneTimeTokens issues random single-use tokens for links sent by email, such as
+// email verification and invitations. Only a hash of each token is stored.
+type OneTimeTokens struct {
+	store Store
+}
+
+// NewOneTimeTokens creates a new one-time token registry backed by the given store
+func NewOneTimeTokens(store Store) *OneTimeTokens {
+	return &OneTimeTokens{
+		store: store,
+	}
+}
+
+// Issue creates a token for the given purpose that carries payload until ttl elapses
+func (ot *OneTimeTokens) Issue(purpose string, payload interface{}, ttl time.Duration) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(secret)
+
+	if err := ot.store.Set(oneTimeKeyPrefix+ot.hash(purpose, token), data, ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// IssueFor creates a token like Issue and records it for subject, e.g. a user ID,
+// so that RevokeAll can invalidate every outstanding token of the subject
+func (ot *OneTimeTokens) IssueFor(purpose, subject string, payload interface{}, ttl time.Duration) (string, error) {
+	token, err := ot.Issue(purpose, payload, ttl)
+	if err != nil {
+		return "", err
+	}
+
+	indexKey := subjectOneTimeKeyPrefix + purpose + ":" + subject
+	if err := ot.store.SetAdd(indexKey, ot.hash(purpose, token)); err != nil {
+		return "", err
+	}
+	if err := ot.store.Expire(indexKey, ttl); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// RevokeAll invalidates the tokens issued for subject with the given purpose
+func (ot *OneTimeTokens) RevokeAll(purpose, subject string) error {
+	indexKey := subjectOneTimeKeyPrefix + purpose + ":" + subject
+	keys, err := ot.store.SetMembers(indexKey)
+	if err != nil {
+		return err
+	}
+
+	tokenKeys := make([]string, 0, len(keys)+1)
+	for _, key := range keys {
+		tokenKeys = append(tokenKeys, oneTimeKeyPrefix+key)
+	}
+	return ot.store.Delete(append(tokenKeys, indexKey)...)
+}
+
+// Consume decodes the payload of a token into payload and invalidates the token.
+// A token issued for another purpose is rejected.
+func (ot *OneTimeTokens) Consume(purpose, token string, payload interface{}) error {
+	key := ot.hash(purpose, token)
+
+	data, err := ot.store.Get(oneTimeKeyPrefix + key)
+	if err == ErrNotFound {
+		return ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return err
+	}
+
+	// Marking the token as used is atomic, so a token cannot be consumed twice concurrently
+	firstUse, err := ot.store.SetNX(usedOneTimeKeyPrefix+key, []byte("1"), time.Hour)
+	if err != nil {
+		return err
+	}
+	if !firstUse {
+		return ErrInvalidOneTimeToken
+	}
+
+	if err := ot.store.Delete(oneTimeKeyPrefix + key); err != nil {
+		return err
+	}
+	return json.Unmarshal(data, payload)
+}
+
+// Peek decodes the payload of a token into payload without invalidating it
+func (ot *OneTimeTokens) Peek(purpose, token string, payload interface{}) error {
+	data, err := ot.store.Get(oneTimeKeyPrefix + ot.hash(purpose, token))
+	if err == ErrNotFound {
+		return ErrInvalidOneTimeToken
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, payload)
+}
+
+// hash derives the store key of a token so leaked store contents cannot be used as links
+func (ot *OneTimeTokens) hash(purpose, token string) string {
+	sum := sha256.Sum256([]byte(purpose + ":" + token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,472 @@
+package auth
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RedisOptions configures the connection to a Redis-compatible server
+type RedisOptions struct {
+	Addr        string // host:port
+	Password    string
+	DB          int
+	PoolSize    int
+	DialTimeout time.Duration
+	Timeout     time.Duration // Deadline for sending a command and reading its reply
+	KeyPrefix   string        // Prepended to every key, e.g. "zplus:auth:"
+}
+
+// RedisStore is a Store backed by any server speaking the Redis protocol (RESP)
+type RedisStore struct {
+	options RedisOptions
+	pool    chan *redisConn
+}
+
+// redisConn is a single connection with buffered reader and writer
+type redisConn struct {
+	conn    net.Conn
+	reader  *bufio.Reader
+	writer  *bufio.Writer
+	timeout time.Duration
+}
+
+// redisError is an error reply sent by the server
+type redisError string
+
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// NewRedisStore creates a Redis store and verifies the connection
+func NewRedisStore(options RedisOptions) (*RedisStore, error) {
+	if options.PoolSize <= 0 {
+		options.PoolSize = 10
+	}
+	if options.DialTimeout <= 0 {
+		options.DialTimeout = 5 * time.Second
+	}
+	if options.Timeout <= 0 {
+		options.Timeout = 3 * time.Second
+	}
+
+	store := &RedisStore{
+		options: options,
+		pool:    make(chan *redisConn, options.PoolSize),
+	}
+
+	reply, err := store.do("PING")
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to redis: %v", err)
+	}
+	if reply != "PONG" {
+		return nil, fmt.Errorf("unexpected PING reply: %v", reply)
+	}
+
+	return store, nil
+}
+
+// Set stores a value
+func (rs *RedisStore) Set(key string, value []byte, ttl time.Duration) error {
+	args := []string{"SET", rs.key(key), string(value)}
+	if ttl > 0 {
+		args = append(args, "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+	_, err := rs.do(args...)
+	return err
+}
+
+// SetNX stores a value only if the key does not exist
+func (rs *RedisStore) SetNX(key string, value []byte, ttl time.Duration) (bool, error) {
+	args := []string{"SET", rs.key(key), string(value), "NX"}
+	if ttl > 0 {
+		args = append(args, "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	}
+	reply, err := rs.do(args...)
+	if err != nil {
+		return false, err
+	}
+	return reply == "OK", nil
+}
+
+// compareAndSwapScript sets KEYS[1] to ARGV[2] if it holds ARGV[1], with a ttl of
+// ARGV[3] milliseconds when positive
+const compareAndSwapScript = `-- compare_and_swap
+if redis.call('GET', KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+if tonumber(ARGV[3]) > 0 then
+	redis.call('SET', KEYS[1], ARGV[2], 'PX', ARGV[3])
+else
+	redis.call('SET', KEYS[1], ARGV[2])
+end
+return 1`
+
+// CompareAndSwap replaces a value only if it still equals old. The comparison and
+// the write run as one script, so no other client can write in between.
+func (rs *RedisStore) CompareAndSwap(key string, old, value []byte, ttl time.Duration) (bool, error) {
+	reply, err := rs.do("EVAL", compareAndSwapScript, "1", rs.key(key), string(old), string(value), strconv.FormatInt(ttl.Milliseconds(), 10))
+	if err != nil {
+		return false, err
+	}
+	return reply == int64(1), nil
+}
+
+// compareAndDeleteScript deletes KEYS[1] if it holds ARGV[1]
+const compareAndDeleteScript = `-- compare_and_delete
+if redis.call('GET', KEYS[1]) ~= ARGV[1] then
+	return 0
+end
+return redis.call('DEL', KEYS[1])`
+
+// CompareAndDelete removes a key only if its value still equals value. The comparison
+// and the delete run as one script, so no other client can write in between.
+func (rs *RedisStore) CompareAndDelete(key string, value []byte) (bool, error) {
+	reply, err := rs.do("EVAL", compareAndDeleteScript, "1", rs.key(key), string(value))
+	if err != nil {
+		return false, err
+	}
+	return reply == int64(1), nil
+}
+
+// Get returns a value
+func (rs *RedisStore) Get(key string) ([]byte, error) {
+	reply, err := rs.do("GET", rs.key(key))
+	if err != nil {
+		return nil, err
+	}
+	if reply == nil {
+		return nil, ErrNotFound
+	}
+	value, ok := reply.(string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected GET reply: %v", reply)
+	}
+	return []byte(value), nil
+}
+
+// Delete removes keys
+func (rs *RedisStore) Delete(keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	args := []string{"DEL"}
+	for _, key := range keys {
+		args = append(args, rs.key(key))
+	}
+	_, err := rs.do(args...)
+	return err
+}
+
+// Expire updates the time to live of a key
+func (rs *RedisStore) Expire(key string, ttl time.Duration) error {
+	if ttl <= 0 {
+		_, err := rs.do("PERSIST", rs.key(key))
+		return err
+	}
+	_, err := rs.do("PEXPIRE", rs.key(key), strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+// incrExpireScript increments KEYS[1] and, when it was created, expires it after
+// ARGV[1] milliseconds when positive
+const incrExpireScript = `-- incr_expire
+local count = redis.call('INCR', KEYS[1])
+if count == 1 and tonumber(ARGV[1]) > 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return count`
+
+// Incr increments a counter and sets its expiry when it was created. Both run as one
+// script, so a counter never outlives its window when the connection drops in between.
+func (rs *RedisStore) Incr(key string, ttl time.Duration) (int64, error) {
+	reply, err := rs.do("EVAL", incrExpireScript, "1", rs.key(key), strconv.FormatInt(ttl.Milliseconds(), 10))
+	if err != nil {
+		return 0, err
+	}
+	count, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected INCR reply: %v", reply)
+	}
+	return count, nil
+}
+
+// SetAdd adds members to a set
+func (rs *RedisStore) SetAdd(key string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	_, err := rs.do(append([]string{"SADD", rs.key(key)}, members...)...)
+	return err
+}
+
+// SetRemove removes members from a set
+func (rs *RedisStore) SetRemove(key string, members ...string) error {
+	if len(members) == 0 {
+		return nil
+	}
+	_, err := rs.do(append([]string{"SREM", rs.key(key)}, members...)...)
+	return err
+}
+
+// SetMembers returns all members of a set
+func (rs *RedisStore) SetMembers(key string) ([]string, error) {
+	reply, err := rs.do("SMEMBERS", rs.key(key))
+	if err != nil {
+		return nil, err
+	}
+	items, ok := reply.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected SMEMBERS reply: %v", reply)
+	}
+	members := make([]string, 0, len(items))
+	for _, item := range items {
+		if member, ok := item.(string); ok {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+// Publish sends a message to the subscribers of a channel
+func (rs *RedisStore) Publish(channel string, message []byte) error {
+	_, err := rs.do("PUBLISH", rs.key(channel), string(message))
+	return err
+}
+
+// Subscribe passes the messages published on a channel to handler until stop is called.
+// The subscription has its own connection, which is reopened when it drops; messages
+// published while it is down are lost.
+func (rs *RedisStore) Subscribe(channel string, handler func(message []byte)) (stop func(), err error) {
+	conn, err := rs.subscribe(channel)
+	if err != nil {
+		return nil, err
+	}
+
+	var mutex sync.Mutex
+	stopped := false
+	go func() {
+		for {
+			conn.receive(handler)
+			conn.conn.Close()
+
+			// Reconnect until stopped
+			for {
+				time.Sleep(time.Second)
+				mutex.Lock()
+				if stopped {
+					mutex.Unlock()
+					return
+				}
+				next, err := rs.subscribe(channel)
+				if err == nil {
+					conn = next
+				}
+				mutex.Unlock()
+				if err == nil {
+					break
+				}
+			}
+		}
+	}()
+
+	return func() {
+		mutex.Lock()
+		defer mutex.Unlock()
+		stopped = true
+		conn.conn.Close()
+	}, nil
+}
+
+// Close closes all pooled connections
+func (rs *RedisStore) Close() error {
+	for {
+		select {
+		case conn := <-rs.pool:
+			conn.conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// Helper methods
+
+func (rs *RedisStore) key(key string) string {
+	return rs.options.KeyPrefix + key
+}
+
+// do sends a command and reads its reply. Broken connections are discarded.
+func (rs *RedisStore) do(args ...string) (interface{}, error) {
+	conn, err := rs.getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	reply, err := conn.command(args...)
+	if err != nil {
+		var serverErr redisError
+		if errors.As(err, &serverErr) {
+			rs.putConn(conn)
+		} else {
+			conn.conn.Close()
+		}
+		return nil, err
+	}
+
+	rs.putConn(conn)
+	return reply, nil
+}
+
+func (rs *RedisStore) getConn() (*redisConn, error) {
+	select {
+	case conn := <-rs.pool:
+		return conn, nil
+	default:
+	}
+	return rs.dial()
+}
+
+// subscribe opens a new connection subscribed to a channel
+func (rs *RedisStore) subscribe(channel string) (*redisConn, error) {
+	conn, err := rs.dial()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := conn.command("SUBSCRIBE", rs.key(channel)); err != nil {
+		conn.conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// dial opens a new connection, authenticated and on the configured database
+func (rs *RedisStore) dial() (*redisConn, error) {
+	netConn, err := net.DialTimeout("tcp", rs.options.Addr, rs.options.DialTimeout)
+	if err != nil {
+		return nil, err
+	}
+	conn := &redisConn{
+		conn:    netConn,
+		reader:  bufio.NewReader(netConn),
+		writer:  bufio.NewWriter(netConn),
+		timeout: rs.options.Timeout,
+	}
+
+	if rs.options.Password != "" {
+		if _, err := conn.command("AUTH", rs.options.Password); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("redis authentication failed: %v", err)
+		}
+	}
+	if rs.options.DB != 0 {
+		if _, err := conn.command("SELECT", strconv.Itoa(rs.options.DB)); err != nil {
+			netConn.Close()
+			return nil, fmt.Errorf("failed to select redis db: %v", err)
+		}
+	}
+
+	return conn, nil
+}
+
+func (rs *RedisStore) putConn(conn *redisConn) {
+	select {
+	case rs.pool <- conn:
+	default:
+		conn.conn.Close()
+	}
+}
+
+// command writes a RESP array of bulk strings and reads one reply. A server that does
+// not answer within the timeout fails the command instead of blocking the caller.
+func (c *redisConn) command(args ...string) (interface{}, error) {
+	if err := c.conn.SetDeadline(time.Now().Add(c.timeout)); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(c.writer, "*%d\r\n", len(args))
+	for _, arg := range args {
+		fmt.Fprintf(c.writer, "$%d\r\n%s\r\n", len(arg), arg)
+	}
+	if err := c.writer.Flush(); err != nil {
+		return nil, err
+	}
+	return readRESP(c.reader)
+}
+
+// receive waits for the messages of the channels subscribed on the connection and
+// passes them to handler until the connection fails
+func (c *redisConn) receive(handler func(message []byte)) error {
+	if err := c.conn.SetDeadline(time.Time{}); err != nil {
+		return err
+	}
+	for {
+		reply, err := readRESP(c.reader)
+		if err != nil {
+			return err
+		}
+		// Messages arrive as ["message", channel, payload]
+		items, ok := reply.([]interface{})
+		if !ok || len(items) != 3 || items[0] != "message" {
+			continue
+		}
+		if message, ok := items[2].(string); ok {
+			handler([]byte(message))
+		}
+	}
+}
+
+// readRESP reads a single RESP value. Nil bulk strings and arrays are returned as nil.
+func readRESP(reader *bufio.Reader) (interface{}, error) {
+	line, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("invalid RESP line: %q", line)
+	}
+	prefix, payload := line[0], line[1:len(line)-2]
+
+	switch prefix {
+	case '+':
+		return payload, nil
+	case '-':
+		return nil, redisError(payload)
+	case ':':
+		return strconv.ParseInt(payload, 10, 64)
+	case '$':
+		size, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, err
+		}
+		if size < 0 {
+			return nil, nil
+		}
+		data := make([]byte, size+2)
+		if _, err := io.ReadFull(reader, data); err != nil {
+			return nil, err
+		}
+		return string(data[:size]), nil
+	case '*':
+		count, err := strconv.Atoi(payload)
+		if err != nil {
+			return nil, err
+		}
+		if count < 0 {
+			return nil, nil
+		}
+		items := make([]interface{}, count)
+		for i := range items {
+			if items[i], err = readRESP(reader); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("unknown RESP type: %q", prefix)
+	}
+}
@@ -0,0 +1,247 @@
+package auth
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Token types carried in the token_type claim
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+// Default token lifetimes
+const (
+	DefaultAccessTokenTTL  = 15 * time.Minute
+	DefaultRefreshTokenTTL = 7 * 24 * time.Hour
+)
+
+// Refresh token errors
+var (
+	ErrInvalidTokenType   = errors.New("invalid token type")
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+	ErrTokenFamilyRevoked = errors.New("token family revoked")
+)
+
+// TokenPair is an access token together with the refresh token that renews it
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	AccessClaims *Claims
+	FamilyID     string
+	ExpiresIn    int // access token lifetime in seconds
+}
+
+// Store keys used for refresh token families
+const (
+	familyKeyPrefix       = "token_family:"
+	userFamiliesKeyPrefix = "user_families:"
+)
+
+// tokenFamily tracks the chain of refresh tokens issued from a single login.
+// Only the most recently issued refresh token may be exchanged.
+type tokenFamily struct {
+	UserID           string    `json:"user_id"`
+	CurrentRefreshID string    `json:"current_refresh_id"`
+	AccessTokenID    string    `json:"access_token_id"`
+	ExpiresAt        time.Time `json:"expires_at"`
+	Revoked          bool      `json:"revoked"`
+}
+
+// TokenFamilies keeps the state of every refresh token family
+type TokenFamilies struct {
+	store Store
+}
+
+// NewTokenFamilies creates a new token family registry backed by the given store
+func NewTokenFamilies(store Store) *TokenFamilies {
+	return &TokenFamilies{
+		store: store,
+	}
+}
+
+// Create registers a new family with its first refresh and access token
+func (tf *TokenFamilies) Create(familyID, userID, refreshTokenID, accessTokenID string, expiresAt time.Time) error {
+	if err := tf.save(familyID, &tokenFamily{
+		UserID:           userID,
+		CurrentRefreshID: refreshTokenID,
+		AccessTokenID:    accessTokenID,
+		ExpiresAt:        expiresAt,
+	}); err != nil {
+		return err
+	}
+
+	// The user's families are indexed so every login can be revoked, including
+	// logins whose session has already expired
+	if err := tf.store.SetAdd(userFamiliesKeyPrefix+userID, familyID); err != nil {
+		return err
+	}
+	return tf.store.Expire(userFamiliesKeyPrefix+userID, time.Until(expiresAt))
+}
+
+// Rotate replaces the current refresh token of a family. Presenting a refresh token
+// that was already exchanged revokes the family and returns ErrRefreshTokenReused.
+// It returns the access token ID that was bound to the previous refresh token.
+func (tf *TokenFamilies) Rotate(familyID, presentedRefreshID, newRefreshID, newAccessID string, expiresAt time.Time) (string, error) {
+	var previousAccessID string
+	reused := false
+
+	// Two concurrent exchanges of the same token both see it as current, but only one
+	// update applies; the other is retried, sees the rotated family and revokes it
+	family, err := tf.update(familyID, func(family *tokenFamily) error {
+		if family.Revoked {
+			return ErrTokenFamilyRevoked
+		}
+
+		previousAccessID = family.AccessTokenID
+		reused = family.CurrentRefreshID != presentedRefreshID
+		if reused {
+			family.Revoked = true
+			return nil
+		}
+
+		family.CurrentRefreshID = newRefreshID
+		family.AccessTokenID = newAccessID
+		family.ExpiresAt = expiresAt
+		return nil
+	})
+	if err == ErrNotFound {
+		return "", ErrTokenFamilyRevoked
+	}
+	if err != nil {
+		return "", err
+	}
+	if reused {
+		return previousAccessID, ErrRefreshTokenReused
+	}
+
+	// Every family of the user expires no later than the one rotated last
+	if err := tf.store.Expire(userFamiliesKeyPrefix+family.UserID, time.Until(expiresAt)); err != nil {
+		return "", err
+	}
+
+	return previousAccessID, nil
+}
+
+// Revoke marks a family as revoked and returns its current access token ID
+func (tf *TokenFamilies) Revoke(familyID string) (string, error) {
+	family, err := tf.update(familyID, func(family *tokenFamily) error {
+		family.Revoked = true
+		return nil
+	})
+	if err == ErrNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return family.AccessTokenID, nil
+}
+
+// RevokeUser revokes every family of a user and returns the current access token IDs
+// of the families that were still active
+func (tf *TokenFamilies) RevokeUser(userID string) ([]string, error) {
+	familyIDs, err := tf.store.SetMembers(userFamiliesKeyPrefix + userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessTokenIDs := make([]string, 0)
+	for _, familyID := range familyIDs {
+		active := false
+		family, err := tf.update(familyID, func(family *tokenFamily) error {
+			active = !family.Revoked
+			family.Revoked = true
+			return nil
+		})
+		if err == ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if active {
+			accessTokenIDs = append(accessTokenIDs, family.AccessTokenID)
+		}
+	}
+
+	return accessTokenIDs, tf.store.Delete(userFamiliesKeyPrefix + userID)
+}
+
+// IsRevoked checks if a family has been revoked
+func (tf *TokenFamilies) IsRevoked(familyID string) (bool, error) {
+	family, err := tf.get(familyID)
+	if err == ErrNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return family.Revoked, nil
+}
+
+func (tf *TokenFamilies) get(familyID string) (*tokenFamily, error) {
+	data, err := tf.store.Get(familyKeyPrefix + familyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var family tokenFamily
+	if err := json.Unmarshal(data, &family); err != nil {
+		return nil, err
+	}
+	return &family, nil
+}
+
+// update applies change to a family and writes it only if nobody else wrote the family
+// in the meantime, retrying with the latest state otherwise. An error returned by change
+// leaves the family as it is.
+func (tf *TokenFamilies) update(familyID string, change func(family *tokenFamily) error) (*tokenFamily, error) {
+	for {
+		data, err := tf.store.Get(familyKeyPrefix + familyID)
+		if err != nil {
+			return nil, err
+		}
+
+		var family tokenFamily
+		if err := json.Unmarshal(data, &family); err != nil {
+			return nil, err
+		}
+		if err := change(&family); err != nil {
+			return nil, err
+		}
+
+		ttl := time.Until(family.ExpiresAt)
+		if ttl <= 0 {
+			return &family, tf.store.Delete(familyKeyPrefix + familyID)
+		}
+
+		updated, err := json.Marshal(&family)
+		if err != nil {
+			return nil, err
+		}
+		swapped, err := tf.store.CompareAndSwap(familyKeyPrefix+familyID, data, updated, ttl)
+		if err != nil {
+			return nil, err
+		}
+		if swapped {
+			return &family, nil
+		}
+	}
+}
+
+// save writes a family until its last refresh token expires
+func (tf *TokenFamilies) save(familyID string, family *tokenFamily) error {
+	ttl := time.Until(family.ExpiresAt)
+	if ttl <= 0 {
+		return tf.store.Delete(familyKeyPrefix + familyID)
+	}
+
+	data, err := json.Marshal(family)
+	if err != nil {
+		return err
+	}
+	return tf.store.Set(familyKeyPrefix+familyID, data, ttl)
+}
@@ -1,16 +1,34 @@
 package auth
 
 import (
-	"sync"
+	"encoding/json"
+	"errors"
 	"time"
 )
 
+// Store keys used by the session manager
+const (
+	sessionKeyPrefix      = "session:"
+	userSessionsKeyPrefix = "user_sessions:"
+	allSessionsKey        = "sessions"
+)
+
+// ErrSessionNotFound is returned when a session does not exist or belongs to another user
+var ErrSessionNotFound = errors.New("session not found")
+
+// DefaultSessionIdleTimeout is how long a session survives without activity
+const DefaultSessionIdleTimeout = 24 * time.Hour
+
+// lastSeenResolution limits how often session activity is written to the store
+const lastSeenResolution = time.Minute
+
 // Session represents an active user session
 type Session struct {
 	ID        string    `json:"id"`
 	UserID    string    `json:"user_id"`
 	TenantID  string    `json:"tenant_id"`
 	TokenID   string    `json:"token_id"`
+	FamilyID  string    `json:"family_id,omitempty"` // Refresh token family of the login
 	Email     string    `json:"email"`
 	IPAddress string    `json:"ip_address"`
 	UserAgent string    `json:"user_agent"`
@@ -18,176 +36,184 @@ type Session struct {
 	LastSeen  time.Time `json:"last_seen"`
 }
 
-// SessionManager manages active user sessions
+// SessionManager manages active user sessions. Sessions are keyed by the ID of the
+// access token currently bound to them and expire after the idle timeout.
 type SessionManager struct {
-	sessions map[string]*Session // key: tokenID
-	userSessions map[string][]string // key: userID, value: slice of tokenIDs
-	mutex    sync.RWMutex
+	store       Store
+	idleTimeout time.Duration
 }
 
-// NewSessionManager creates a new session manager
-func NewSessionManager() *SessionManager {
+// NewSessionManager creates a new session manager backed by the given store
+func NewSessionManager(store Store, idleTimeout time.Duration) *SessionManager {
 	return &SessionManager{
-		sessions:     make(map[string]*Session),
-		userSessions: make(map[string][]string),
+		store:       store,
+		idleTimeout: idleTimeout,
 	}
 }
 
 // CreateSession creates a new session for a user
-func (sm *SessionManager) CreateSession(tokenID, userID, tenantID, email, ipAddress, userAgent string) *Session {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
-	
+func (sm *SessionManager) CreateSession(tokenID, familyID, userID, tenantID, email, ipAddress, userAgent string) (*Session, error) {
 	session := &Session{
 		ID:        tokenID, // Use tokenID as session ID for simplicity
 		UserID:    userID,
 		TenantID:  tenantID,
 		TokenID:   tokenID,
+		FamilyID:  familyID,
 		Email:     email,
 		IPAddress: ipAddress,
 		UserAgent: userAgent,
 		CreatedAt: time.Now(),
 		LastSeen:  time.Now(),
 	}
-	
-	sm.sessions[tokenID] = session
-	
-	// Add to user sessions
-	if sm.userSessions[userID] == nil {
-		sm.userSessions[userID] = make([]string, 0)
+
+	if err := sm.save(session); err != nil {
+		return nil, err
+	}
+	if err := sm.index(userID, tokenID); err != nil {
+		return nil, err
 	}
-	sm.userSessions[userID] = append(sm.userSessions[userID], tokenID)
-	
-	return session
+
+	return session, nil
 }
 
 // GetSession retrieves a session by token ID
-func (sm *SessionManager) GetSession(tokenID string) *Session {
-	sm.mutex.RLock()
-	defer sm.mutex.RUnlock()
-	
-	session, exists := sm.sessions[tokenID]
-	if !exists {
-		return nil
+func (sm *SessionManager) GetSession(tokenID string) (*Session, error) {
+	data, err := sm.store.Get(sessionKeyPrefix + tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
 	}
-	
-	return session
+	return &session, nil
 }
 
-// UpdateLastSeen updates the last seen time for a session
-func (sm *SessionManager) UpdateLastSeen(tokenID string) {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
-	
-	if session, exists := sm.sessions[tokenID]; exists {
-		session.LastSeen = time.Now()
+// UpdateLastSeen updates the last seen time for a session and extends its idle timeout
+func (sm *SessionManager) UpdateLastSeen(tokenID string) error {
+	session, err := sm.GetSession(tokenID)
+	if err == ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if time.Since(session.LastSeen) < lastSeenResolution {
+		return nil
+	}
+	session.LastSeen = time.Now()
+	if err := sm.save(session); err != nil {
+		return err
 	}
+	return sm.index(session.UserID, tokenID)
 }
 
 // RemoveSession removes a session
-func (sm *SessionManager) RemoveSession(tokenID string) {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
-	
-	session, exists := sm.sessions[tokenID]
-	if !exists {
-		return
-	}
-	
-	// Remove from sessions
-	delete(sm.sessions, tokenID)
-	
-	// Remove from user sessions
-	userSessions := sm.userSessions[session.UserID]
-	for i, sessionID := range userSessions {
-		if sessionID == tokenID {
-			sm.userSessions[session.UserID] = append(userSessions[:i], userSessions[i+1:]...)
-			break
-		}
+func (sm *SessionManager) RemoveSession(tokenID string) error {
+	session, err := sm.GetSession(tokenID)
+	if err == ErrNotFound {
+		return nil
 	}
-	
-	// Clean up empty user session slice
-	if len(sm.userSessions[session.UserID]) == 0 {
-		delete(sm.userSessions, session.UserID)
+	if err != nil {
+		return err
 	}
+
+	if err := sm.store.Delete(sessionKeyPrefix + tokenID); err != nil {
+		return err
+	}
+	if err := sm.store.SetRemove(userSessionsKeyPrefix+session.UserID, tokenID); err != nil {
+		return err
+	}
+	return sm.store.SetRemove(allSessionsKey, tokenID)
 }
 
-// GetUserSessions retrieves all active sessions for a user
-func (sm *SessionManager) GetUserSessions(userID string) []*Session {
-	sm.mutex.RLock()
-	defer sm.mutex.RUnlock()
-	
-	tokenIDs, exists := sm.userSessions[userID]
-	if !exists {
+// ReplaceToken moves a session to a new token ID, e.g. after a refresh token rotation
+func (sm *SessionManager) ReplaceToken(oldTokenID, newTokenID string) error {
+	session, err := sm.GetSession(oldTokenID)
+	if err == ErrNotFound {
 		return nil
 	}
-	
-	sessions := make([]*Session, 0, len(tokenIDs))
-	for _, tokenID := range tokenIDs {
-		if session, exists := sm.sessions[tokenID]; exists {
-			sessions = append(sessions, session)
-		}
+	if err != nil {
+		return err
 	}
-	
-	return sessions
+
+	session.TokenID = newTokenID
+	session.LastSeen = time.Now()
+	if err := sm.save(session); err != nil {
+		return err
+	}
+	if err := sm.index(session.UserID, newTokenID); err != nil {
+		return err
+	}
+
+	if err := sm.store.Delete(sessionKeyPrefix + oldTokenID); err != nil {
+		return err
+	}
+	if err := sm.store.SetRemove(userSessionsKeyPrefix+session.UserID, oldTokenID); err != nil {
+		return err
+	}
+	return sm.store.SetRemove(allSessionsKey, oldTokenID)
+}
+
+// GetUserSessions retrieves all active sessions for a user
+func (sm *SessionManager) GetUserSessions(userID string) ([]*Session, error) {
+	return sm.loadSessions(userSessionsKeyPrefix + userID)
 }
 
 // GetAllSessions retrieves all active sessions
-func (sm *SessionManager) GetAllSessions() []*Session {
-	sm.mutex.RLock()
-	defer sm.mutex.RUnlock()
-	
-	sessions := make([]*Session, 0, len(sm.sessions))
-	for _, session := range sm.sessions {
-		sessions = append(sessions, session)
-	}
-	
-	return sessions
+func (sm *SessionManager) GetAllSessions() ([]*Session, error) {
+	return sm.loadSessions(allSessionsKey)
 }
 
-// CleanupExpiredSessions removes sessions that haven't been seen for a specified duration
-func (sm *SessionManager) CleanupExpiredSessions(maxIdleTime time.Duration) {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
-	
-	cutoff := time.Now().Add(-maxIdleTime)
-	expiredTokens := make([]string, 0)
-	
-	for tokenID, session := range sm.sessions {
-		if session.LastSeen.Before(cutoff) {
-			expiredTokens = append(expiredTokens, tokenID)
-		}
+// save writes a session with a fresh idle timeout
+func (sm *SessionManager) save(session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
 	}
-	
-	// Remove expired sessions
-	for _, tokenID := range expiredTokens {
-		session := sm.sessions[tokenID]
-		delete(sm.sessions, tokenID)
-		
-		// Remove from user sessions
-		userSessions := sm.userSessions[session.UserID]
-		for i, sessionID := range userSessions {
-			if sessionID == tokenID {
-				sm.userSessions[session.UserID] = append(userSessions[:i], userSessions[i+1:]...)
-				break
-			}
+	return sm.store.Set(sessionKeyPrefix+session.TokenID, data, sm.idleTimeout)
+}
+
+// index lists a session in the index sets. The sets expire with the last session
+// listed in them, so the sets of users who stopped signing in do not pile up.
+func (sm *SessionManager) index(userID, tokenID string) error {
+	for _, indexKey := range []string{userSessionsKeyPrefix + userID, allSessionsKey} {
+		if err := sm.store.SetAdd(indexKey, tokenID); err != nil {
+			return err
 		}
-		
-		// Clean up empty user session slice
-		if len(sm.userSessions[session.UserID]) == 0 {
-			delete(sm.userSessions, session.UserID)
+		if err := sm.store.Expire(indexKey, sm.idleTimeout); err != nil {
+			return err
 		}
 	}
+	return nil
 }
 
-// StartCleanupRoutine starts a goroutine that periodically cleans up expired sessions
-func (sm *SessionManager) StartCleanupRoutine(interval time.Duration, maxIdleTime time.Duration) {
-	go func() {
-		ticker := time.NewTicker(interval)
-		defer ticker.Stop()
-		
-		for range ticker.C {
-			sm.CleanupExpiredSessions(maxIdleTime)
+// loadSessions loads the sessions listed in an index set and drops entries that expired
+func (sm *SessionManager) loadSessions(indexKey string) ([]*Session, error) {
+	tokenIDs, err := sm.store.SetMembers(indexKey)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*Session, 0, len(tokenIDs))
+	expired := make([]string, 0)
+	for _, tokenID := range tokenIDs {
+		session, err := sm.GetSession(tokenID)
+		if err == ErrNotFound {
+			expired = append(expired, tokenID)
+			continue
+		}
+		if err != nil {
+			return nil, err
 		}
-	}()
-}
\ No newline at end of file
+		sessions = append(sessions, session)
+	}
+
+	if len(expired) > 0 {
+		sm.store.SetRemove(indexKey, expired...)
+	}
+
+	return sessions, nil
+}
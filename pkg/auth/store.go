@@ -0,0 +1,298 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrNotFound is returned by a Store when a key does not exist or has expired
+var ErrNotFound = errors.New("key not found")
+
+// Store is the key-value storage behind the token blacklist, sessions and refresh
+// token families. Sharing one Store (e.g. Redis) between services makes revocation
+// and session listing consistent everywhere tokens are validated.
+type Store interface {
+	// Set stores a value. A zero ttl keeps the key until it is deleted.
+	Set(key string, value []byte, ttl time.Duration) error
+
+	// SetNX stores a value only if the key does not exist and reports whether it was stored
+	SetNX(key string, value []byte, ttl time.Duration) (bool, error)
+
+	// CompareAndSwap replaces a value only if it still equals old and reports whether
+	// it was replaced, so read-modify-write updates cannot overwrite each other
+	CompareAndSwap(key string, old, value []byte, ttl time.Duration) (bool, error)
+
+	// CompareAndDelete removes a key only if its value still equals value and reports
+	// whether it was removed, so a lock is only released by its holder
+	CompareAndDelete(key string, value []byte) (bool, error)
+
+	// Get returns a value or ErrNotFound
+	Get(key string) ([]byte, error)
+
+	// Delete removes keys
+	Delete(keys ...string) error
+
+	// Expire updates the time to live of a key
+	Expire(key string, ttl time.Duration) error
+
+	// Incr atomically increments a counter and returns its new value. The ttl is only
+	// applied when the counter is created, so it counts within a fixed window.
+	Incr(key string, ttl time.Duration) (int64, error)
+
+	// SetAdd adds members to a set
+	SetAdd(key string, members ...string) error
+
+	// SetRemove removes members from a set
+	SetRemove(key string, members ...string) error
+
+	// SetMembers returns all members of a set
+	SetMembers(key string) ([]string, error)
+}
+
+// memoryEntry is a value or set stored in memory with its expiry time
+type memoryEntry struct {
+	value     []byte
+	members   map[string]struct{}
+	expiresAt time.Time // zero means no expiry
+}
+
+func (e *memoryEntry) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && now.After(e.expiresAt)
+}
+
+// MemoryStore is a per-process Store, used when no shared store is configured
+type MemoryStore struct {
+	entries map[string]*memoryEntry
+	mutex   sync.Mutex
+}
+
+// NewMemoryStore creates a new in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		entries: make(map[string]*memoryEntry),
+	}
+}
+
+// Set stores a value
+func (ms *MemoryStore) Set(key string, value []byte, ttl time.Duration) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	ms.entries[key] = &memoryEntry{value: value, expiresAt: expiryTime(ttl)}
+	return nil
+}
+
+// SetNX stores a value only if the key does not exist
+func (ms *MemoryStore) SetNX(key string, value []byte, ttl time.Duration) (bool, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	if ms.lookup(key) != nil {
+		return false, nil
+	}
+	ms.entries[key] = &memoryEntry{value: value, expiresAt: expiryTime(ttl)}
+	return true, nil
+}
+
+// CompareAndSwap replaces a value only if it still equals old
+func (ms *MemoryStore) CompareAndSwap(key string, old, value []byte, ttl time.Duration) (bool, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	entry := ms.lookup(key)
+	if entry == nil || entry.value == nil || !bytes.Equal(entry.value, old) {
+		return false, nil
+	}
+	ms.entries[key] = &memoryEntry{value: value, expiresAt: expiryTime(ttl)}
+	return true, nil
+}
+
+// CompareAndDelete removes a key only if its value still equals value
+func (ms *MemoryStore) CompareAndDelete(key string, value []byte) (bool, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	entry := ms.lookup(key)
+	if entry == nil || entry.value == nil || !bytes.Equal(entry.value, value) {
+		return false, nil
+	}
+	delete(ms.entries, key)
+	return true, nil
+}
+
+// Get returns a value
+func (ms *MemoryStore) Get(key string) ([]byte, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	entry := ms.lookup(key)
+	if entry == nil || entry.value == nil {
+		return nil, ErrNotFound
+	}
+	return entry.value, nil
+}
+
+// Delete removes keys
+func (ms *MemoryStore) Delete(keys ...string) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	for _, key := range keys {
+		delete(ms.entries, key)
+	}
+	return nil
+}
+
+// Expire updates the time to live of a key
+func (ms *MemoryStore) Expire(key string, ttl time.Duration) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	if entry := ms.lookup(key); entry != nil {
+		entry.expiresAt = expiryTime(ttl)
+	}
+	return nil
+}
+
+// Incr increments a counter
+func (ms *MemoryStore) Incr(key string, ttl time.Duration) (int64, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	entry := ms.lookup(key)
+	if entry == nil {
+		entry = &memoryEntry{value: []byte("0"), expiresAt: expiryTime(ttl)}
+		ms.entries[key] = entry
+	}
+	if entry.value == nil {
+		return 0, errors.New("key does not hold a counter")
+	}
+
+	count, err := strconv.ParseInt(string(entry.value), 10, 64)
+	if err != nil {
+		return 0, errors.New("key does not hold a counter")
+	}
+	count++
+	entry.value = []byte(strconv.FormatInt(count, 10))
+	return count, nil
+}
+
+// SetAdd adds members to a set
+func (ms *MemoryStore) SetAdd(key string, members ...string) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	entry := ms.lookup(key)
+	if entry == nil {
+		entry = &memoryEntry{members: make(map[string]struct{})}
+		ms.entries[key] = entry
+	}
+	if entry.members == nil {
+		return errors.New("key does not hold a set")
+	}
+	for _, member := range members {
+		entry.members[member] = struct{}{}
+	}
+	return nil
+}
+
+// SetRemove removes members from a set
+func (ms *MemoryStore) SetRemove(key string, members ...string) error {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	entry := ms.lookup(key)
+	if entry == nil || entry.members == nil {
+		return nil
+	}
+	for _, member := range members {
+		delete(entry.members, member)
+	}
+	if len(entry.members) == 0 {
+		delete(ms.entries, key)
+	}
+	return nil
+}
+
+// SetMembers returns all members of a set
+func (ms *MemoryStore) SetMembers(key string) ([]string, error) {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	entry := ms.lookup(key)
+	if entry == nil || entry.members == nil {
+		return []string{}, nil
+	}
+	members := make([]string, 0, len(entry.members))
+	for member := range entry.members {
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+// Keys returns the keys matching a glob pattern (for debugging and tests)
+func (ms *MemoryStore) Keys(pattern string) []string {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	keys := make([]string, 0)
+	for key := range ms.entries {
+		if ms.lookup(key) == nil {
+			continue
+		}
+		if matched, _ := path.Match(pattern, key); matched {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// CleanupExpired removes expired entries
+func (ms *MemoryStore) CleanupExpired() {
+	ms.mutex.Lock()
+	defer ms.mutex.Unlock()
+
+	now := time.Now()
+	for key, entry := range ms.entries {
+		if entry.expired(now) {
+			delete(ms.entries, key)
+		}
+	}
+}
+
+// StartCleanupRoutine starts a goroutine that periodically removes expired entries
+func (ms *MemoryStore) StartCleanupRoutine(interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			ms.CleanupExpired()
+		}
+	}()
+}
+
+// lookup returns a live entry, dropping it if it has expired. The caller must hold the mutex.
+func (ms *MemoryStore) lookup(key string) *memoryEntry {
+	entry, exists := ms.entries[key]
+	if !exists {
+		return nil
+	}
+	if entry.expired(time.Now()) {
+		delete(ms.entries, key)
+		return nil
+	}
+	return entry
+}
+
+// expiryTime converts a ttl into an absolute expiry time
+func expiryTime(ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(ttl)
+}
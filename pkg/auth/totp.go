@@ -0,0 +1,172 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// TOTP parameters (RFC 6238), the defaults understood by common authenticator apps
+const (
+	totpPeriod = 30 // seconds
+	totpDigits = 6
+	totpSkew   = 1 // steps accepted before and after the current one for clock drift
+)
+
+// usedTOTPKeyPrefix prefixes the store keys of TOTP codes that were already used
+const usedTOTPKeyPrefix = "totp_used:"
+
+// recoveryCodeAlphabet avoids characters that are easily confused when typed
+const recoveryCodeAlphabet = "abcdefghjkmnpqrstuvwxyz23456789"
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret creates a random base32 encoded TOTP secret
+func GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, 20)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPProvisioningURI returns the otpauth:// URI that authenticator apps import, usually shown as a QR code
+func TOTPProvisioningURI(secret, issuer, account string) string {
+	params := url.Values{}
+	params.Set("secret", secret)
+	params.Set("issuer", issuer)
+	params.Set("algorithm", "SHA1")
+	params.Set("digits", fmt.Sprint(totpDigits))
+	params.Set("period", fmt.Sprint(totpPeriod))
+
+	label := url.PathEscape(issuer + ":" + account)
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, params.Encode())
+}
+
+// TOTPCode returns the code of a secret at the given time
+func TOTPCode(secret string, at time.Time) (string, error) {
+	return totpCode(secret, at.Unix()/totpPeriod)
+}
+
+// ValidateTOTP checks a code against the current time step and its neighbours.
+// It returns the matching time step so callers can reject replays.
+func ValidateTOTP(secret, code string, at time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := at.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		expected, err := totpCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// GenerateRecoveryCodes creates single-use recovery codes and the hashes to store for them
+func GenerateRecoveryCodes(count int) ([]string, []string, error) {
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		chars, err := randomRecoveryChars(10)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		var code strings.Builder
+		for j, char := range chars {
+			if j == 5 {
+				code.WriteByte('-')
+			}
+			code.WriteByte(char)
+		}
+
+		codes = append(codes, code.String())
+		hashes = append(hashes, HashRecoveryCode(code.String()))
+	}
+
+	return codes, hashes, nil
+}
+
+// randomRecoveryChars draws n characters from the recovery code alphabet. Bytes past the
+// largest multiple of the alphabet size are drawn again, so every character is equally likely.
+func randomRecoveryChars(n int) ([]byte, error) {
+	limit := 256 - 256%len(recoveryCodeAlphabet)
+	chars := make([]byte, 0, n)
+	random := make([]byte, n)
+
+	for len(chars) < n {
+		if _, err := rand.Read(random); err != nil {
+			return nil, err
+		}
+		for _, b := range random {
+			if int(b) < limit && len(chars) < n {
+				chars = append(chars, recoveryCodeAlphabet[int(b)%len(recoveryCodeAlphabet)])
+			}
+		}
+	}
+	return chars, nil
+}
+
+// HashRecoveryCode hashes a recovery code for storage. Codes are random, so a fast hash is sufficient.
+func HashRecoveryCode(code string) string {
+	normalized := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), " ", ""))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
+
+// VerifyTOTP validates a user's code and records it so the same code cannot be used twice
+func (tm *TokenManager) VerifyTOTP(userID, secret, code string) (bool, error) {
+	step, ok := ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	// A code stays acceptable for the whole skew window
+	ttl := time.Duration((2*totpSkew+1)*totpPeriod) * time.Second
+	firstUse, err := tm.store.SetNX(fmt.Sprintf("%s%s:%d", usedTOTPKeyPrefix, userID, step), []byte("1"), ttl)
+	if err != nil {
+		return false, err
+	}
+	return firstUse, nil
+}
+
+// totpCode computes the HOTP value (RFC 4226) of a time step
+func totpCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return "", fmt.Errorf("invalid TOTP secret: %v", err)
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		modulo *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%modulo), nil
+}
@@ -26,8 +26,3 @@ func Connect(config Config) (*gorm.DB, error) {
 
 	return db, nil
 }
-
-func SetTenantSchema(db *gorm.DB, tenantID string) error {
-	schemaName := fmt.Sprintf("tenant_%s", tenantID)
-	return db.Exec(fmt.Sprintf("SET search_path TO %s", schemaName)).Error
-}
\ No newline at end of file